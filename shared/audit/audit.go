@@ -0,0 +1,29 @@
+// Package audit holds what the audit logs of both services share: the
+// operator an action is recorded for and the filter entries are listed by.
+// Each service records and lists the entries of its own audit log table.
+package audit
+
+import (
+	"context"
+	"errors"
+	"shared/auth"
+)
+
+var ErrNoActor = errors.New("privileged action without an authenticated operator")
+
+// Filter selects audit log entries; empty fields match every entry.
+type Filter struct {
+	Actor      string
+	Action     string
+	TargetType string
+	TargetID   string
+}
+
+// Actor returns the operator performing the action of ctx.
+func Actor(ctx context.Context) (*auth.Principal, error) {
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, ErrNoActor
+	}
+	return p, nil
+}
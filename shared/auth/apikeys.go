@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"shared/messages"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// APIKeyHeader is the header server-to-server callers send their API key in.
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyPrefix starts every API key, so leaked keys are easy to recognize.
+// A key reads wk_<id>_<secret>, where wk_<id> is its public prefix.
+const APIKeyPrefix = "wk_"
+
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// APIKeyResolver authenticates API keys.
+type APIKeyResolver interface {
+	// ResolveAPIKey returns the principal of a valid API key, or ErrInvalidAPIKey.
+	ResolveAPIKey(ctx context.Context, key string) (*Principal, error)
+}
+
+// ParseAPIKey returns the public prefix of an API key.
+func ParseAPIKey(key string) (string, bool) {
+	rest, ok := strings.CutPrefix(key, APIKeyPrefix)
+	if !ok {
+		return "", false
+	}
+	id, secret, ok := strings.Cut(rest, "_")
+	if !ok || id == "" || secret == "" {
+		return "", false
+	}
+	return APIKeyPrefix + id, true
+}
+
+// HashAPIKey returns the hash an API key is stored and compared by. Keys are
+// long random strings, so a fast hash is enough.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// APIKeyPrincipal builds the principal of a verified API key.
+func APIKeyPrincipal(identity messages.APIKeyIdentity) *Principal {
+	return &Principal{
+		UserID:   identity.UserID,
+		Subject:  "apikey:" + identity.Prefix,
+		Email:    identity.Email,
+		Scopes:   identity.Scopes,
+		APIKeyID: identity.ID,
+	}
+}
+
+const (
+	apiKeyRequestTimeout = 5 * time.Second
+	// apiKeyCacheTTL bounds how long a revoked key keeps working on services
+	// that verify keys through user-service.
+	apiKeyCacheTTL = 30 * time.Second
+	// apiKeyCacheSize is the number of cached keys above which expired entries are dropped.
+	apiKeyCacheSize = 1024
+)
+
+// RemoteAPIKeys is an APIKeyResolver that asks user-service to verify keys
+// over NATS and caches valid keys for a short while.
+type RemoteAPIKeys struct {
+	natsConn *nats.Conn
+
+	mu    sync.Mutex
+	cache map[string]cachedAPIKey
+}
+
+type cachedAPIKey struct {
+	principal *Principal
+	until     time.Time
+}
+
+func NewRemoteAPIKeys(natsConn *nats.Conn) *RemoteAPIKeys {
+	return &RemoteAPIKeys{natsConn: natsConn, cache: make(map[string]cachedAPIKey)}
+}
+
+// ResolveAPIKey implements APIKeyResolver.
+func (r *RemoteAPIKeys) ResolveAPIKey(ctx context.Context, key string) (*Principal, error) {
+	prefix, ok := ParseAPIKey(key)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+	hash := HashAPIKey(key)
+
+	r.mu.Lock()
+	cached, ok := r.cache[hash]
+	r.mu.Unlock()
+	if ok && time.Now().Before(cached.until) {
+		return cached.principal, nil
+	}
+
+	identity, err := r.verify(ctx, prefix, hash)
+	if err != nil {
+		return nil, err
+	}
+	principal := APIKeyPrincipal(*identity)
+
+	until := time.Now().Add(apiKeyCacheTTL)
+	if identity.ExpiresAt != nil && identity.ExpiresAt.Before(until) {
+		until = *identity.ExpiresAt
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.cache) >= apiKeyCacheSize {
+		now := time.Now()
+		for h, c := range r.cache {
+			if now.After(c.until) {
+				delete(r.cache, h)
+			}
+		}
+	}
+	r.cache[hash] = cachedAPIKey{principal: principal, until: until}
+	return principal, nil
+}
+
+func (r *RemoteAPIKeys) verify(ctx context.Context, prefix, hash string) (*messages.APIKeyIdentity, error) {
+	data, err := json.Marshal(messages.VerifyAPIKey{Prefix: prefix, Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, apiKeyRequestTimeout)
+	defer cancel()
+	msg, err := r.natsConn.RequestWithContext(ctx, messages.SubjectVerifyAPIKey, data)
+	if err != nil {
+		return nil, fmt.Errorf("verifying API key: %w", err)
+	}
+
+	var reply messages.APIKeyVerification
+	if err := json.Unmarshal(msg.Data, &reply); err != nil {
+		return nil, fmt.Errorf("decoding API key verification: %w", err)
+	}
+	if reply.ErrorCode == messages.ErrorCodeUnauthorized {
+		return nil, ErrInvalidAPIKey
+	}
+	if !reply.IsSuccess() || reply.Key == nil {
+		return nil, fmt.Errorf("verifying API key: %s", reply.Message)
+	}
+	return reply.Key, nil
+}
@@ -0,0 +1,29 @@
+package auth
+
+import "testing"
+
+func TestParseAPIKey(t *testing.T) {
+	tests := []struct {
+		key        string
+		wantPrefix string
+		wantOK     bool
+	}{
+		{key: "wk_ab12cd_s3cr3t", wantPrefix: "wk_ab12cd", wantOK: true},
+		// The secret may contain the separator; only the first one counts.
+		{key: "wk_ab12cd_s3cr_3t", wantPrefix: "wk_ab12cd", wantOK: true},
+		{key: "wk_ab12cd_", wantOK: false},
+		{key: "wk__s3cr3t", wantOK: false},
+		{key: "wk_ab12cd", wantOK: false},
+		{key: "xk_ab12cd_s3cr3t", wantOK: false},
+		{key: "WK_ab12cd_s3cr3t", wantOK: false},
+		{key: "", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			prefix, ok := ParseAPIKey(tt.key)
+			if prefix != tt.wantPrefix || ok != tt.wantOK {
+				t.Errorf("ParseAPIKey(%q) = %q, %v; want %q, %v", tt.key, prefix, ok, tt.wantPrefix, tt.wantOK)
+			}
+		})
+	}
+}
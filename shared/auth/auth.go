@@ -0,0 +1,151 @@
+// Package auth verifies the access tokens issued by user-service and
+// authorizes the callers they identify. It is shared by user-service and
+// transactions-service.
+package auth
+
+import (
+	"context"
+	"shared/problems"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Issuer and Audience of every token issued by user-service.
+const (
+	Issuer   = "user-service"
+	Audience = "digital-wallet"
+)
+
+// Token types carried in the typ claim.
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+	// TokenTypeEmailVerification tokens prove that the user owns the email they carry.
+	TokenTypeEmailVerification = "email-verification"
+	// TokenTypePasswordReset tokens allow setting the password of the user once.
+	TokenTypePasswordReset = "password-reset"
+	// TokenTypeStepUp tokens prove that the user just confirmed a second factor.
+	TokenTypeStepUp = "step-up"
+)
+
+// Scopes granted to tokens.
+const (
+	ScopeWalletRead    = "wallet:read"
+	ScopeTransferWrite = "transfer:write"
+	// ScopeAdmin grants access to every account and to the admin endpoints.
+	ScopeAdmin = "admin"
+)
+
+// UserScopes are the scopes of the tokens issued to a user for their own account.
+var UserScopes = []string{ScopeWalletRead, ScopeTransferWrite}
+
+// Claims are the claims of the tokens issued by user-service. The subject is
+// the ID of the user, or "client:<id>" for tokens issued to service clients.
+type Claims struct {
+	jwt.RegisteredClaims
+	Type  string `json:"typ"`
+	Scope string `json:"scope"`
+	Email string `json:"email,omitempty"`
+	// Roles are the operator roles of the user.
+	Roles []string `json:"roles,omitempty"`
+	// SessionID is the session access tokens were issued to, so that they
+	// stop working when it is revoked.
+	SessionID string `json:"sid,omitempty"`
+}
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	// UserID is the ID of the user the caller acts as, or 0 for service clients.
+	UserID  int
+	Subject string
+	Email   string
+	Scopes  []string
+	Roles   []string
+	// APIKeyID is the ID of the API key the caller authenticated with, or 0.
+	APIKeyID int
+	// SteppedUpAt is when the user confirmed a second factor, if the request
+	// carries a step-up token.
+	SteppedUpAt *time.Time
+	// SessionID is the session of the access token the caller presented, if any.
+	SessionID string
+}
+
+// PrincipalFromClaims builds the principal identified by verified claims.
+func PrincipalFromClaims(claims *Claims) *Principal {
+	p := &Principal{
+		Subject:   claims.Subject,
+		Email:     claims.Email,
+		Scopes:    strings.Fields(claims.Scope),
+		Roles:     claims.Roles,
+		SessionID: claims.SessionID,
+	}
+	if id, err := strconv.Atoi(claims.Subject); err == nil {
+		p.UserID = id
+	}
+	return p
+}
+
+// HasScope reports whether the principal was granted scope. Admins hold every scope.
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope) || slices.Contains(p.Scopes, ScopeAdmin)
+}
+
+// IsAdmin reports whether the principal has the admin scope.
+func (p *Principal) IsAdmin() bool {
+	return slices.Contains(p.Scopes, ScopeAdmin)
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying the authenticated principal.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of the request, if it was authenticated.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// AuthorizeUser checks that the caller may act on the account of userID: it
+// must be that user or an admin.
+func AuthorizeUser(ctx context.Context, userID int) error {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return problems.New(problems.CodeUnauthorized, "")
+	}
+	if p.IsAdmin() || (p.UserID != 0 && p.UserID == userID) {
+		return nil
+	}
+	return problems.New(problems.CodeForbidden, "the account belongs to another user")
+}
+
+// AuthorizeEmail checks that the caller may act on the account with the given
+// email: it must be that user or an admin.
+func AuthorizeEmail(ctx context.Context, email string) error {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return problems.New(problems.CodeUnauthorized, "")
+	}
+	if p.IsAdmin() || (p.Email != "" && strings.EqualFold(p.Email, email)) {
+		return nil
+	}
+	return problems.New(problems.CodeForbidden, "the account belongs to another user")
+}
+
+// RequireScope checks that the caller was granted scope.
+func RequireScope(ctx context.Context, scope string) error {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return problems.New(problems.CodeUnauthorized, "")
+	}
+	if !p.HasScope(scope) {
+		return problems.New(problems.CodeForbidden, "missing scope "+scope)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"shared/problems"
+	"testing"
+	"time"
+)
+
+func TestAuthorizeUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *Principal
+		userID    int
+		wantCode  problems.Code
+	}{
+		{name: "no principal", userID: 1, wantCode: problems.CodeUnauthorized},
+		{name: "owner", principal: &Principal{UserID: 1, Scopes: UserScopes}, userID: 1},
+		{name: "other user", principal: &Principal{UserID: 2, Scopes: UserScopes}, userID: 1, wantCode: problems.CodeForbidden},
+		{name: "owner's API key", principal: &Principal{UserID: 1, APIKeyID: 7, Scopes: []string{ScopeWalletRead}}, userID: 1},
+		{name: "other user's API key", principal: &Principal{UserID: 2, APIKeyID: 7, Scopes: []string{ScopeWalletRead}}, userID: 1, wantCode: problems.CodeForbidden},
+		{name: "service client", principal: &Principal{Subject: "client:reports", Scopes: []string{ScopeWalletRead}}, userID: 1, wantCode: problems.CodeForbidden},
+		{name: "admin", principal: &Principal{UserID: 2, Scopes: []string{ScopeAdmin}}, userID: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertCode(t, AuthorizeUser(contextWith(tt.principal), tt.userID), tt.wantCode)
+		})
+	}
+}
+
+func TestAuthorizeEmail(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *Principal
+		email     string
+		wantCode  problems.Code
+	}{
+		{name: "no principal", email: "jane@example.com", wantCode: problems.CodeUnauthorized},
+		{name: "owner", principal: &Principal{UserID: 1, Email: "jane@example.com"}, email: "jane@example.com"},
+		{name: "owner in another case", principal: &Principal{UserID: 1, Email: "Jane@Example.com"}, email: "jane@example.com"},
+		{name: "other user", principal: &Principal{UserID: 2, Email: "john@example.com"}, email: "jane@example.com", wantCode: problems.CodeForbidden},
+		{name: "caller without email", principal: &Principal{Subject: "client:reports"}, email: "", wantCode: problems.CodeForbidden},
+		{name: "admin", principal: &Principal{Scopes: []string{ScopeAdmin}}, email: "jane@example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertCode(t, AuthorizeEmail(contextWith(tt.principal), tt.email), tt.wantCode)
+		})
+	}
+}
+
+func TestRequireStepUp(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		principal *Principal
+		wantCode  problems.Code
+	}{
+		{name: "no principal", wantCode: problems.CodeUnauthorized},
+		{name: "user", principal: &Principal{UserID: 1, Scopes: UserScopes}, wantCode: problems.CodeStepUpRequired},
+		{name: "stepped-up user", principal: &Principal{UserID: 1, Scopes: UserScopes, SteppedUpAt: &now}},
+		{name: "API key", principal: &Principal{UserID: 1, APIKeyID: 7, Scopes: UserScopes}, wantCode: problems.CodeStepUpRequired},
+		{name: "stepped-up API key", principal: &Principal{UserID: 1, APIKeyID: 7, Scopes: UserScopes, SteppedUpAt: &now}},
+		{name: "service client", principal: &Principal{Subject: "client:payouts", Scopes: []string{ScopeTransferWrite}}, wantCode: problems.CodeStepUpRequired},
+		{name: "admin", principal: &Principal{Subject: "client:ops", Scopes: []string{ScopeAdmin}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertCode(t, RequireStepUp(contextWith(tt.principal)), tt.wantCode)
+		})
+	}
+}
+
+func contextWith(p *Principal) context.Context {
+	if p == nil {
+		return context.Background()
+	}
+	return WithPrincipal(context.Background(), p)
+}
+
+// assertCode checks that err is a problem with the given code, or nil when
+// code is empty.
+func assertCode(t *testing.T, err error, code problems.Code) {
+	t.Helper()
+	if code == "" {
+		if err != nil {
+			t.Fatalf("got error %v, want none", err)
+		}
+		return
+	}
+	p, ok := problems.As(err)
+	if !ok || p.Code != code {
+		t.Fatalf("got error %v, want problem %s", err, code)
+	}
+}
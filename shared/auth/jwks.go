@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// JWKSPath is where user-service publishes its signing keys.
+const JWKSPath = "/.well-known/jwks.json"
+
+// JWK is a public Ed25519 signing key in JSON Web Key form (RFC 8037).
+type JWK struct {
+	Kty string `json:"kty" example:"OKP"`
+	Crv string `json:"crv" example:"Ed25519"`
+	X   string `json:"x"`
+	Kid string `json:"kid"`
+	Use string `json:"use" example:"sig"`
+	Alg string `json:"alg" example:"EdDSA"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// PublicJWK returns the JWK of an Ed25519 public key.
+func PublicJWK(kid string, key ed25519.PublicKey) JWK {
+	return JWK{
+		Kty: "OKP",
+		Crv: "Ed25519",
+		X:   base64.RawURLEncoding.EncodeToString(key),
+		Kid: kid,
+		Use: "sig",
+		Alg: "EdDSA",
+	}
+}
+
+// PublicKey decodes the key of a JWK.
+func (k JWK) PublicKey() (ed25519.PublicKey, error) {
+	if k.Kty != "OKP" || k.Crv != "Ed25519" {
+		return nil, fmt.Errorf("unsupported key type %s/%s", k.Kty, k.Crv)
+	}
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil || len(x) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("malformed key %s", k.Kid)
+	}
+	return ed25519.PublicKey(x), nil
+}
+
+const (
+	// jwksMaxAge is how long fetched keys are used before they are fetched again.
+	jwksMaxAge = 10 * time.Minute
+	// jwksMinRefresh limits how often an unknown key ID triggers a fetch.
+	jwksMinRefresh = 30 * time.Second
+)
+
+// RemoteKeySet is a KeySource backed by the JWKS published by user-service. An
+// unknown key ID triggers a refetch, so keys rotated in are picked up at once.
+type RemoteKeySet struct {
+	url        string
+	httpClient *http.Client
+	// fetches lets concurrent lookups share one fetch.
+	fetches singleflight.Group
+
+	mu        sync.Mutex
+	keys      map[string]ed25519.PublicKey
+	fetchedAt time.Time
+}
+
+func NewRemoteKeySet(url string) *RemoteKeySet {
+	return &RemoteKeySet{
+		url:        url,
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// PublicKey implements KeySource. The keys are fetched without holding s.mu,
+// so lookups of cached keys are not held up by a slow user-service.
+func (s *RemoteKeySet) PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	key, ok, fresh := s.cached(kid)
+	if fresh {
+		if !ok {
+			return nil, ErrUnknownKey
+		}
+		return key, nil
+	}
+
+	// The fetch outlives the caller that starts it, as the other callers
+	// waiting for it may not have given up.
+	_, err, _ := s.fetches.Do(s.url, func() (any, error) {
+		return nil, s.fetch(context.WithoutCancel(ctx), kid)
+	})
+	if err != nil {
+		if ok {
+			// Keep using a known key while user-service is unreachable.
+			return key, nil
+		}
+		return nil, err
+	}
+
+	key, ok, _ = s.cached(kid)
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// cached returns the cached key kid and whether the cache may answer for it
+// without a fetch.
+func (s *RemoteKeySet) cached(kid string) (key ed25519.PublicKey, ok, fresh bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok = s.keys[kid]
+	age := time.Since(s.fetchedAt)
+	return key, ok, (ok && age < jwksMaxAge) || (!ok && age < jwksMinRefresh)
+}
+
+// fetch replaces the cached keys with the published ones, unless a fetch that
+// completed since the caller looked made kid fresh.
+func (s *RemoteKeySet) fetch(ctx context.Context, kid string) error {
+	if _, _, fresh := s.cached(kid); fresh {
+		return nil
+	}
+	s.mu.Lock()
+	s.fetchedAt = time.Now()
+	s.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetching JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching JWKS: status %d", resp.StatusCode)
+	}
+
+	var set JWKS
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("decoding JWKS: %w", err)
+	}
+
+	keys := make(map[string]ed25519.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		key, err := k.PublicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = key
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.mu.Unlock()
+	return nil
+}
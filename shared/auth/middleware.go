@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"errors"
+	"shared/problems"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BearerToken extracts the token of an "Authorization: Bearer <token>" header.
+func BearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+// Middleware authenticates requests with the API key or bearer access token
+// they carry and stores the caller's principal in the request context,
+// together with the step-up of token callers. Requests without valid
+// credentials are rejected with 401.
+func Middleware(verifier *Verifier, apiKeys APIKeyResolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if key := c.GetHeader(APIKeyHeader); key != "" {
+			p, err := apiKeys.ResolveAPIKey(c.Request.Context(), key)
+			if errors.Is(err, ErrInvalidAPIKey) {
+				unauthorized(c, err.Error())
+				return
+			}
+			if err != nil {
+				problems.Write(c, problems.CodeServiceUnavailable, "the API key could not be verified")
+				return
+			}
+			c.Request = c.Request.WithContext(WithPrincipal(c.Request.Context(), p))
+			c.Next()
+			return
+		}
+
+		token, ok := BearerToken(c.GetHeader("Authorization"))
+		if !ok {
+			unauthorized(c, "missing bearer token")
+			return
+		}
+
+		claims, err := verifier.Verify(c.Request.Context(), token, TokenTypeAccess)
+		if err != nil {
+			unauthorized(c, err.Error())
+			return
+		}
+
+		p := PrincipalFromClaims(claims)
+		if stepUp := c.GetHeader(StepUpHeader); stepUp != "" {
+			if err := verifier.StepUp(c.Request.Context(), p, stepUp); err != nil {
+				unauthorized(c, err.Error())
+				return
+			}
+		}
+
+		c.Request = c.Request.WithContext(WithPrincipal(c.Request.Context(), p))
+		c.Next()
+	}
+}
+
+// Scope rejects requests whose caller was not granted scope with 403.
+func Scope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := RequireScope(c.Request.Context(), scope); err != nil {
+			p, _ := problems.As(err)
+			problems.WriteProblem(c, p)
+			return
+		}
+		c.Next()
+	}
+}
+
+// Permission rejects requests whose caller does not hold perm with 403.
+func Permission(perm string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := RequirePermission(c.Request.Context(), perm); err != nil {
+			p, _ := problems.As(err)
+			problems.WriteProblem(c, p)
+			return
+		}
+		c.Next()
+	}
+}
+
+func unauthorized(c *gin.Context, detail string) {
+	c.Header("WWW-Authenticate", `Bearer realm="digital-wallet"`)
+	problems.Write(c, problems.CodeUnauthorized, detail)
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"context"
+	"shared/problems"
+	"slices"
+)
+
+// Roles of back-office operators, granted to users on the admin API and
+// carried in their access tokens.
+const (
+	RoleSupport    = "support"
+	RoleFinance    = "finance"
+	RoleCompliance = "compliance"
+)
+
+// Permissions of the admin API.
+const (
+	PermUsersRead      = "users:read"
+	PermWalletsRead    = "wallets:read"
+	PermLedgerRead     = "ledger:read"
+	PermWalletsFreeze  = "wallets:freeze"
+	PermSessionsRevoke = "sessions:revoke"
+	PermAuditRead      = "audit:read"
+	// PermRolesManage is not granted by any role; only admins hold it.
+	PermRolesManage = "roles:manage"
+)
+
+// RolePermissions lists the permissions each role grants.
+var RolePermissions = map[string][]string{
+	RoleSupport:    {PermUsersRead, PermWalletsRead, PermSessionsRevoke},
+	RoleFinance:    {PermUsersRead, PermWalletsRead, PermLedgerRead},
+	RoleCompliance: {PermUsersRead, PermWalletsRead, PermLedgerRead, PermWalletsFreeze, PermAuditRead},
+}
+
+// IsRole reports whether role is a known operator role.
+func IsRole(role string) bool {
+	_, ok := RolePermissions[role]
+	return ok
+}
+
+// HasPermission reports whether one of the principal's roles grants perm.
+// Admins hold every permission.
+func (p *Principal) HasPermission(perm string) bool {
+	if p.IsAdmin() {
+		return true
+	}
+	for _, role := range p.Roles {
+		if slices.Contains(RolePermissions[role], perm) {
+			return true
+		}
+	}
+	return false
+}
+
+// RequirePermission checks that the caller holds perm.
+func RequirePermission(ctx context.Context, perm string) error {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return problems.New(problems.CodeUnauthorized, "")
+	}
+	if !p.HasPermission(perm) {
+		return problems.New(problems.CodeForbidden, "missing permission "+perm)
+	}
+	return nil
+}
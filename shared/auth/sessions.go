@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"shared/messages"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	revokedSessionsRequestTimeout = 5 * time.Second
+	// denyListSize is the number of denied sessions above which expired
+	// entries are dropped.
+	denyListSize = 1024
+)
+
+// DenyList holds the sessions revoked while access tokens issued to them may
+// still be valid. Verify rejects the access tokens of denied sessions, so a
+// revocation takes effect immediately rather than when the tokens expire.
+type DenyList struct {
+	mu    sync.Mutex
+	until map[string]time.Time
+}
+
+func NewDenyList() *DenyList {
+	return &DenyList{until: make(map[string]time.Time)}
+}
+
+// Deny rejects the access tokens of a session until the given time.
+func (d *DenyList) Deny(sessionID string, until time.Time) {
+	if !time.Now().Before(until) {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.until) >= denyListSize {
+		now := time.Now()
+		for id, u := range d.until {
+			if now.After(u) {
+				delete(d.until, id)
+			}
+		}
+	}
+	if until.After(d.until[sessionID]) {
+		d.until[sessionID] = until
+	}
+}
+
+// Denied reports whether the access tokens of a session are rejected. A nil
+// DenyList denies nothing.
+func (d *DenyList) Denied(sessionID string) bool {
+	if d == nil || sessionID == "" {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	until, ok := d.until[sessionID]
+	return ok && time.Now().Before(until)
+}
+
+// Subscribe keeps d up to date with the sessions revoked by any replica of
+// user-service. Every replica receives every revocation.
+func (d *DenyList) Subscribe(natsConn *nats.Conn) error {
+	_, err := natsConn.Subscribe(messages.SubjectSessionRevoked, func(m *nats.Msg) {
+		var revoked messages.SessionRevoked
+		if err := json.Unmarshal(m.Data, &revoked); err != nil {
+			log.Printf("error unmarshalling %s message: %v", m.Subject, err)
+			return
+		}
+		d.Deny(revoked.SessionID, revoked.Until)
+	})
+	return err
+}
+
+// Load asks user-service for the sessions revoked before Subscribe was
+// called, whose access tokens may still be presented.
+func (d *DenyList) Load(ctx context.Context, natsConn *nats.Conn) error {
+	ctx, cancel := context.WithTimeout(ctx, revokedSessionsRequestTimeout)
+	defer cancel()
+	msg, err := natsConn.RequestWithContext(ctx, messages.SubjectRevokedSessions, nil)
+	if err != nil {
+		return fmt.Errorf("requesting revoked sessions: %w", err)
+	}
+
+	var reply messages.RevokedSessions
+	if err := json.Unmarshal(msg.Data, &reply); err != nil {
+		return fmt.Errorf("decoding revoked sessions: %w", err)
+	}
+	if !reply.IsSuccess() {
+		return fmt.Errorf("requesting revoked sessions: %s", reply.Message)
+	}
+	for _, revoked := range reply.Sessions {
+		d.Deny(revoked.SessionID, revoked.Until)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"shared/problems"
+)
+
+// StepUpHeader carries the step-up token of a request; gRPC calls send it as
+// x-step-up-token metadata.
+const StepUpHeader = "X-Step-Up-Token"
+
+// StepUp records on p the step-up proved by token, which must have been
+// issued to the same user.
+func (v *Verifier) StepUp(ctx context.Context, p *Principal, token string) error {
+	claims, err := v.Verify(ctx, token, TokenTypeStepUp)
+	if err != nil {
+		return err
+	}
+	if claims.Subject != p.Subject {
+		return fmt.Errorf("%w: the step-up token was issued to another user", ErrInvalidToken)
+	}
+	at := claims.IssuedAt.Time
+	p.SteppedUpAt = &at
+	return nil
+}
+
+// RequireStepUp checks that the caller confirmed the request with a second
+// factor. Callers with the admin scope act for operators and services that
+// cannot, and are exempt; API keys are not, as they act for a single user.
+func RequireStepUp(ctx context.Context) error {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return problems.New(problems.CodeUnauthorized, "")
+	}
+	if p.IsAdmin() || p.SteppedUpAt != nil {
+		return nil
+	}
+	return problems.New(problems.CodeStepUpRequired, "confirm the request with a step-up token from POST /api/v2/auth/step-up in the "+StepUpHeader+" header")
+}
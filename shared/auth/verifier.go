@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrInvalidToken   = errors.New("invalid token")
+	ErrUnknownKey     = errors.New("unknown signing key")
+	ErrSessionRevoked = errors.New("the session was revoked")
+)
+
+// KeySource returns the public key a token was signed with.
+type KeySource interface {
+	PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// Verifier checks the signature and claims of tokens issued by user-service,
+// and rejects the access tokens of the sessions on its deny-list.
+type Verifier struct {
+	keys   KeySource
+	denied *DenyList
+	parser *jwt.Parser
+}
+
+func NewVerifier(keys KeySource, denied *DenyList) *Verifier {
+	return &Verifier{
+		keys:   keys,
+		denied: denied,
+		parser: jwt.NewParser(
+			jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}),
+			jwt.WithIssuer(Issuer),
+			jwt.WithAudience(Audience),
+			jwt.WithExpirationRequired(),
+		),
+	}
+}
+
+// Verify parses a token of the given type and returns its claims.
+func (v *Verifier) Verify(ctx context.Context, token string, tokenType string) (*Claims, error) {
+	var claims Claims
+	_, err := v.parser.ParseWithClaims(token, &claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		if kid == "" {
+			return nil, ErrUnknownKey
+		}
+		return v.keys.PublicKey(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if claims.Type != tokenType {
+		return nil, fmt.Errorf("%w: expected a %s token", ErrInvalidToken, tokenType)
+	}
+	if tokenType == TokenTypeAccess && v.denied.Denied(claims.SessionID) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, ErrSessionRevoked)
+	}
+	return &claims, nil
+}
@@ -0,0 +1,309 @@
+package messages
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Subjects used for request/reply between user-service and transactions-service.
+const (
+	SubjectUserCreated = "user-created"
+	SubjectUserUpdated = "user-updated"
+	SubjectGetBalance  = "get-balance"
+
+	// SubjectBalanceSnapshot returns every wallet's balance with the sequence of
+	// the last event applied to it.
+	SubjectBalanceSnapshot = "balance-snapshot"
+
+	// SubjectVerifyAPIKey is answered by user-service with the identity of a valid API key.
+	SubjectVerifyAPIKey = "verify-api-key"
+	// SubjectResolveAlias is answered by user-service with the user a payment
+	// alias belongs to.
+	SubjectResolveAlias = "resolve-alias"
+
+	// SubjectExportUserData returns a user's wallet with a page of their
+	// transactions, for data exports.
+	SubjectExportUserData = "export-user-data"
+	// SubjectEraseUser pseudonymizes a user's wallet, for erasure requests.
+	SubjectEraseUser = "erase-user"
+
+	// SubjectSessionRevoked is published by user-service to every replica of
+	// both services when a session is revoked.
+	SubjectSessionRevoked = "session-revoked"
+	// SubjectRevokedSessions is answered by user-service with the revoked
+	// sessions whose access tokens have not expired yet.
+	SubjectRevokedSessions = "revoked-sessions"
+
+	// SubjectPayReferralBonus pays the bonuses of a qualified referral from
+	// the promotions wallet. It may be sent again until it succeeds; each
+	// bonus is paid once.
+	SubjectPayReferralBonus = "pay-referral-bonus"
+	// SubjectListDeposits returns the top-ups of a wallet, so that referrals
+	// whose transaction-created event was missed still qualify.
+	SubjectListDeposits = "list-deposits"
+)
+
+// Event types, each published on the subject of the same name.
+const (
+	EventBalanceChanged     = "balance-changed"
+	EventTransactionCreated = "transaction-created"
+)
+
+// Reply statuses.
+const (
+	StatusSuccess = "success"
+	StatusError   = "error"
+)
+
+// Error codes carried in Reply.ErrorCode.
+const (
+	ErrorCodeInvalidRequest     = "INVALID_REQUEST"
+	ErrorCodeUnauthorized       = "UNAUTHORIZED"
+	ErrorCodeUserNotFound       = "USER_NOT_FOUND"
+	ErrorCodeUserAlreadyExists  = "USER_ALREADY_EXISTS"
+	ErrorCodeEmailTaken         = "EMAIL_TAKEN"
+	ErrorCodeAliasNotFound      = "ALIAS_NOT_FOUND"
+	ErrorCodeWalletNotEmpty     = "WALLET_NOT_EMPTY"
+	ErrorCodeServiceTimeout     = "SERVICE_TIMEOUT"
+	ErrorCodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+	ErrorCodeServiceBusy        = "SERVICE_BUSY"
+	ErrorCodeInternal           = "INTERNAL_ERROR"
+)
+
+// DefaultCurrency is the currency every wallet is held in.
+const DefaultCurrency = "USD"
+
+// Reply is the envelope every request/reply subject answers with.
+type Reply struct {
+	Status    string   `json:"status"`
+	ErrorCode string   `json:"error_code,omitempty"`
+	Message   string   `json:"message,omitempty"`
+	Balance   *float64 `json:"balance,omitempty"`
+	Currency  string   `json:"currency,omitempty"`
+}
+
+// UserCreated is the payload of the user-created subject.
+type UserCreated struct {
+	ID        int       `json:"id"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UserUpdated is the payload of the user-updated subject. UpdatedAt orders
+// the updates of a user, so a stale one is ignored. VerifiedAt is nil while
+// the email is unverified.
+type UserUpdated struct {
+	ID         int        `json:"id"`
+	Email      string     `json:"email"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// Event is the envelope of every event published by transactions-service.
+// Sequence increases with every event, so consumers can drop stale or
+// duplicate deliveries.
+type Event struct {
+	Sequence   int             `json:"sequence"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data" swaggertype:"object"`
+}
+
+// BalanceChanged is the data of a balance-changed event. PreviousSequence is
+// the sequence of the wallet's previous balance-changed event, or 0 for its
+// first, so consumers can tell that they missed one.
+type BalanceChanged struct {
+	UserID           int     `json:"user_id"`
+	Email            string  `json:"email"`
+	Balance          float64 `json:"balance"`
+	Currency         string  `json:"currency"`
+	PreviousSequence int     `json:"previous_sequence,omitempty"`
+}
+
+// TransactionCreated is the data of a transaction-created event. Debits have a
+// negative amount; both records of a transfer share its request ID. Deposit is
+// set on the credits of top-ups, as opposed to those of transfers.
+type TransactionCreated struct {
+	ID        int       `json:"id"`
+	UserID    int       `json:"user_id"`
+	Amount    float64   `json:"amount"`
+	Currency  string    `json:"currency"`
+	Type      string    `json:"type"`
+	Deposit   bool      `json:"deposit,omitempty"`
+	RequestID string    `json:"request_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BalanceSnapshotEntry is a wallet balance as of the event with the given sequence.
+type BalanceSnapshotEntry struct {
+	BalanceChanged
+	Sequence int `json:"sequence"`
+}
+
+// BalanceSnapshot is the payload of a balance-snapshot reply. LastSequence is
+// the newest event when the snapshot was taken at TakenAt; the balances include
+// every event up to it.
+type BalanceSnapshot struct {
+	Reply
+	LastSequence int                    `json:"last_sequence"`
+	TakenAt      time.Time              `json:"taken_at"`
+	Balances     []BalanceSnapshotEntry `json:"balances,omitempty"`
+}
+
+// VerifyAPIKey is the payload of the verify-api-key subject. Only the hash of
+// the key is sent, so the key itself never leaves the service it was presented to.
+type VerifyAPIKey struct {
+	Prefix string `json:"prefix"`
+	Hash   string `json:"hash"`
+}
+
+// APIKeyIdentity describes a valid API key and the user it acts for.
+type APIKeyIdentity struct {
+	ID        int        `json:"id"`
+	Prefix    string     `json:"prefix"`
+	UserID    int        `json:"user_id"`
+	Email     string     `json:"email"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// APIKeyVerification is the payload of a verify-api-key reply.
+type APIKeyVerification struct {
+	Reply
+	Key *APIKeyIdentity `json:"key,omitempty"`
+}
+
+// ResolveAlias is the payload of the resolve-alias subject: a handle, email
+// or phone number, as a client entered it.
+type ResolveAlias struct {
+	Alias string `json:"alias"`
+}
+
+// Recipient is the user a payment alias belongs to. MaskedName shows only
+// the first letter of each word of their name, so payers can check whom they
+// pay without learning it.
+type Recipient struct {
+	UserID     int    `json:"user_id"`
+	AliasType  string `json:"alias_type"`
+	Alias      string `json:"alias"`
+	MaskedName string `json:"masked_name,omitempty"`
+}
+
+// AliasResolution is the payload of a resolve-alias reply.
+type AliasResolution struct {
+	Reply
+	Recipient *Recipient `json:"recipient,omitempty"`
+}
+
+// ExportUserData is the payload of the export-user-data subject. Transactions
+// are paged by ID: a reply holds up to Limit transactions with IDs above AfterID.
+type ExportUserData struct {
+	UserID  int `json:"user_id"`
+	AfterID int `json:"after_id"`
+	Limit   int `json:"limit"`
+}
+
+// WalletData is a wallet as exported to its owner.
+type WalletData struct {
+	ID           int        `json:"id"`
+	Email        string     `json:"email"`
+	Balance      float64    `json:"balance"`
+	Currency     string     `json:"currency"`
+	CreatedAt    time.Time  `json:"created_at"`
+	VerifiedAt   *time.Time `json:"verified_at,omitempty"`
+	FrozenAt     *time.Time `json:"frozen_at,omitempty"`
+	FrozenReason string     `json:"frozen_reason,omitempty"`
+	ErasedAt     *time.Time `json:"erased_at,omitempty"`
+}
+
+// PayeeData is a payee saved in a contact book, as exported to its owner.
+type PayeeData struct {
+	WalletID      int        `json:"wallet_id"`
+	Alias         string     `json:"alias,omitempty"`
+	Nickname      string     `json:"nickname"`
+	DefaultAmount *float64   `json:"default_amount,omitempty"`
+	Memo          string     `json:"memo,omitempty"`
+	LastPaidAt    *time.Time `json:"last_paid_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// UserDataExport is the payload of an export-user-data reply. Wallet is nil
+// when the user has no wallet. Payees are only sent with the first page.
+type UserDataExport struct {
+	Reply
+	Wallet       *WalletData          `json:"wallet,omitempty"`
+	Payees       []PayeeData          `json:"payees,omitempty"`
+	Transactions []TransactionCreated `json:"transactions,omitempty"`
+}
+
+// EraseUser is the payload of the erase-user subject. Email is the pseudonym
+// replacing the email of the wallet.
+type EraseUser struct {
+	UserID   int       `json:"user_id"`
+	Email    string    `json:"email"`
+	ErasedAt time.Time `json:"erased_at"`
+}
+
+// SessionRevoked is the payload of the session-revoked subject. The access
+// tokens of the session are denied until Until, when the last of them expires.
+type SessionRevoked struct {
+	SessionID string    `json:"session_id"`
+	Until     time.Time `json:"until"`
+}
+
+// RevokedSessions is the payload of a revoked-sessions reply.
+type RevokedSessions struct {
+	Reply
+	Sessions []SessionRevoked `json:"sessions,omitempty"`
+}
+
+// PayReferralBonus is the payload of the pay-referral-bonus subject. A zero
+// bonus is not paid.
+type PayReferralBonus struct {
+	ReferralID    int     `json:"referral_id"`
+	ReferrerID    int     `json:"referrer_id"`
+	ReferredID    int     `json:"referred_id"`
+	ReferrerBonus float64 `json:"referrer_bonus"`
+	ReferredBonus float64 `json:"referred_bonus"`
+}
+
+// ListDeposits is the payload of the list-deposits subject: the deposits of
+// at least MinAmount made into a wallet since Since, oldest first.
+type ListDeposits struct {
+	UserID    int       `json:"user_id"`
+	Since     time.Time `json:"since"`
+	MinAmount float64   `json:"min_amount"`
+}
+
+// DepositList is the payload of a list-deposits reply.
+type DepositList struct {
+	Reply
+	Deposits []TransactionCreated `json:"deposits,omitempty"`
+}
+
+// Success builds a successful reply with the given message.
+func Success(message string) Reply {
+	return Reply{Status: StatusSuccess, Message: message}
+}
+
+// BalanceReply builds a successful get-balance reply.
+func BalanceReply(balance float64) Reply {
+	return Reply{Status: StatusSuccess, Balance: &balance, Currency: DefaultCurrency}
+}
+
+// Error builds a failed reply with the given error code and message.
+func Error(code string, message string) Reply {
+	return Reply{Status: StatusError, ErrorCode: code, Message: message}
+}
+
+// Decode parses a reply envelope.
+func Decode(data []byte) (Reply, error) {
+	var reply Reply
+	err := json.Unmarshal(data, &reply)
+	return reply, err
+}
+
+// IsSuccess reports whether the reply carries a successful result.
+func (r Reply) IsSuccess() bool {
+	return r.Status == StatusSuccess
+}
@@ -0,0 +1,160 @@
+// Package problems implements RFC 7807 problem details with stable error
+// codes. It defines the codes both services emit or carry in NATS reply
+// envelopes; each service registers the codes of its own with Register.
+package problems
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+)
+
+// ContentType is the media type of a problem details response.
+const ContentType = "application/problem+json"
+
+// TypeBaseURI prefixes the type URI of every problem.
+const TypeBaseURI = "https://github.com/Djunichi/golang-digital-wallet/problems/"
+
+// Code is a stable, machine-readable error code.
+type Code string
+
+const (
+	CodeInvalidRequest     Code = "INVALID_REQUEST"     // 400 Bad Request
+	CodeValidationFailed   Code = "VALIDATION_FAILED"   // 422 Unprocessable Entity
+	CodeUnauthorized       Code = "UNAUTHORIZED"        // 401 Unauthorized
+	CodeForbidden          Code = "FORBIDDEN"           // 403 Forbidden
+	CodeUserNotFound       Code = "USER_NOT_FOUND"      // 404 Not Found
+	CodeUserAlreadyExists  Code = "USER_ALREADY_EXISTS" // 409 Conflict
+	CodeEmailTaken         Code = "EMAIL_TAKEN"         // 409 Conflict
+	CodeEmailNotVerified   Code = "EMAIL_NOT_VERIFIED"  // 403 Forbidden
+	CodeStepUpRequired     Code = "STEP_UP_REQUIRED"    // 403 Forbidden
+	CodeWalletNotEmpty     Code = "WALLET_NOT_EMPTY"    // 409 Conflict
+	CodeUserErased         Code = "USER_ERASED"         // 410 Gone
+	CodeAliasNotFound      Code = "ALIAS_NOT_FOUND"     // 404 Not Found
+	CodeRateLimited        Code = "RATE_LIMITED"        // 429 Too Many Requests
+	CodeQuotaExceeded      Code = "QUOTA_EXCEEDED"      // 429 Too Many Requests
+	CodeServiceTimeout     Code = "SERVICE_TIMEOUT"     // 503 Service Unavailable
+	CodeServiceUnavailable Code = "SERVICE_UNAVAILABLE" // 503 Service Unavailable
+	CodeServiceBusy        Code = "SERVICE_BUSY"        // 503 Service Unavailable
+	CodeInternal           Code = "INTERNAL_ERROR"      // 500 Internal Server Error
+)
+
+type definition struct {
+	status int
+	grpc   codes.Code
+	title  string
+}
+
+var definitions = map[Code]definition{
+	CodeInvalidRequest:     {http.StatusBadRequest, codes.InvalidArgument, "The request is malformed"},
+	CodeValidationFailed:   {http.StatusUnprocessableEntity, codes.InvalidArgument, "The request failed validation"},
+	CodeUnauthorized:       {http.StatusUnauthorized, codes.Unauthenticated, "Authentication required"},
+	CodeForbidden:          {http.StatusForbidden, codes.PermissionDenied, "Not allowed"},
+	CodeUserNotFound:       {http.StatusNotFound, codes.NotFound, "User not found"},
+	CodeUserAlreadyExists:  {http.StatusConflict, codes.AlreadyExists, "User already exists"},
+	CodeEmailTaken:         {http.StatusConflict, codes.AlreadyExists, "Email already in use"},
+	CodeEmailNotVerified:   {http.StatusForbidden, codes.FailedPrecondition, "Email not verified"},
+	CodeStepUpRequired:     {http.StatusForbidden, codes.PermissionDenied, "Step-up verification required"},
+	CodeWalletNotEmpty:     {http.StatusConflict, codes.FailedPrecondition, "Wallet still holds money"},
+	CodeUserErased:         {http.StatusGone, codes.FailedPrecondition, "User was erased"},
+	CodeAliasNotFound:      {http.StatusNotFound, codes.NotFound, "Alias not found"},
+	CodeRateLimited:        {http.StatusTooManyRequests, codes.ResourceExhausted, "Too many requests"},
+	CodeQuotaExceeded:      {http.StatusTooManyRequests, codes.ResourceExhausted, "Quota exceeded"},
+	CodeServiceTimeout:     {http.StatusServiceUnavailable, codes.Unavailable, "Upstream service timed out"},
+	CodeServiceUnavailable: {http.StatusServiceUnavailable, codes.Unavailable, "Upstream service unavailable"},
+	CodeServiceBusy:        {http.StatusServiceUnavailable, codes.ResourceExhausted, "Upstream service is busy"},
+	CodeInternal:           {http.StatusInternalServerError, codes.Internal, "Internal server error"},
+}
+
+// Register defines code with the HTTP status, gRPC status and title it is
+// reported with. Services register their own codes from init, before any
+// problem is built; registering a code twice panics.
+func Register(code Code, status int, grpc codes.Code, title string) {
+	if _, ok := definitions[code]; ok {
+		panic("problems: code " + string(code) + " registered twice")
+	}
+	definitions[code] = definition{status: status, grpc: grpc, title: title}
+}
+
+// Status returns the HTTP status of the code. Unknown codes map to 500.
+func (c Code) Status() int {
+	if d, ok := definitions[c]; ok {
+		return d.status
+	}
+	return http.StatusInternalServerError
+}
+
+// GRPCCode returns the gRPC status code the code is reported with. Unknown
+// codes map to Internal.
+func (c Code) GRPCCode() codes.Code {
+	if d, ok := definitions[c]; ok {
+		return d.grpc
+	}
+	return codes.Internal
+}
+
+// Title returns the short human-readable summary of the code.
+func (c Code) Title() string {
+	if d, ok := definitions[c]; ok {
+		return d.title
+	}
+	return definitions[CodeInternal].title
+}
+
+// Type returns the URI identifying the problem type of the code.
+func (c Code) Type() string {
+	return TypeBaseURI + strings.ToLower(strings.ReplaceAll(string(c), "_", "-"))
+}
+
+// Problem is an RFC 7807 problem details object extended with a stable code.
+type Problem struct {
+	Type     string `json:"type" example:"https://github.com/Djunichi/golang-digital-wallet/problems/user-not-found"`
+	Title    string `json:"title" example:"User not found"`
+	Status   int    `json:"status" example:"404"`
+	Detail   string `json:"detail,omitempty" example:"no user with email jane@example.com"`
+	Instance string `json:"instance,omitempty" example:"/api/v1/balance/jane@example.com"`
+	Code     Code   `json:"code" swaggertype:"string" example:"USER_NOT_FOUND"`
+}
+
+// New builds the problem for code with the given detail.
+func New(code Code, detail string) *Problem {
+	return &Problem{
+		Type:   code.Type(),
+		Title:  code.Title(),
+		Status: code.Status(),
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// Error implements error so a problem can be returned through error values.
+func (p *Problem) Error() string {
+	if p.Detail == "" {
+		return p.Title
+	}
+	return p.Detail
+}
+
+// As returns the problem wrapped in err, if any.
+func As(err error) (*Problem, bool) {
+	var p *Problem
+	ok := errors.As(err, &p)
+	return p, ok
+}
+
+// Write aborts the request with the problem for code.
+func Write(c *gin.Context, code Code, detail string) {
+	WriteProblem(c, New(code, detail))
+}
+
+// WriteProblem aborts the request with p, using the request path as its instance.
+func WriteProblem(c *gin.Context, p *Problem) {
+	if p.Instance == "" {
+		p.Instance = c.Request.URL.Path
+	}
+	c.Header("Content-Type", ContentType)
+	c.AbortWithStatusJSON(p.Status, p)
+}
@@ -0,0 +1,217 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Stores the limits can be kept in.
+const (
+	StoreMemory   = "memory"
+	StoreDatabase = "database"
+)
+
+// Config holds the limits and quotas of a service and where their state is kept.
+type Config struct {
+	Limits []Limit
+	Quotas []Quota
+	Store  string
+	// TrustedProxies are the addresses and CIDR ranges of the proxies whose
+	// X-Forwarded-For header is believed. Without any, the IP subject is
+	// the address of the peer, which clients cannot forge.
+	TrustedProxies []string
+}
+
+// LoadConfig reads RATE_LIMITS, RATE_QUOTAS, RATE_LIMIT_STORE and the
+// comma-separated TRUSTED_PROXIES, keeping the defaults of unset variables;
+// setting a variable to an empty value removes its defaults. Limits and
+// quotas are lists of rules separated by semicolons:
+//
+//	RATE_LIMITS="POST /api/v1/transferMoney user 10/1m 20; * api_key 20/1s"
+//	RATE_QUOTAS="POST /api/v1/transferMoney user 1000/24h"
+//
+// A rule names its route ("METHOD /path", a gRPC method or * for every
+// route), its subject (api_key, user or ip) and its rate as calls/period. A
+// limit may end with its burst, which defaults to the rate.
+//
+// TRUSTED_PROXIES must be set when a rule applies per ip, empty if clients
+// connect directly: behind a load balancer that is not trusted, every client
+// would share the balancer's address and its limits.
+func LoadConfig(defaults Config) (Config, error) {
+	config := defaults
+	if config.Store == "" {
+		config.Store = StoreMemory
+	}
+
+	if v, ok := os.LookupEnv("RATE_LIMITS"); ok {
+		limits, err := ParseLimits(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RATE_LIMITS: %w", err)
+		}
+		config.Limits = limits
+	}
+	if v, ok := os.LookupEnv("RATE_QUOTAS"); ok {
+		quotas, err := ParseQuotas(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RATE_QUOTAS: %w", err)
+		}
+		config.Quotas = quotas
+	}
+	if v := os.Getenv("RATE_LIMIT_STORE"); v != "" {
+		config.Store = strings.ToLower(v)
+	}
+	if config.Store != StoreMemory && config.Store != StoreDatabase {
+		return Config{}, fmt.Errorf("RATE_LIMIT_STORE must be %q or %q", StoreMemory, StoreDatabase)
+	}
+	if v, ok := os.LookupEnv("TRUSTED_PROXIES"); ok {
+		proxies, err := ParseProxies(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid TRUSTED_PROXIES: %w", err)
+		}
+		config.TrustedProxies = proxies
+	} else if config.TrustedProxies == nil && config.limitsIP() {
+		return Config{}, fmt.Errorf("TRUSTED_PROXIES must be set when limits apply per %s; set it empty if clients connect directly", SubjectIP)
+	}
+
+	return config, nil
+}
+
+// limitsIP reports whether a limit or quota applies per IP address.
+func (c Config) limitsIP() bool {
+	for _, limit := range c.Limits {
+		if limit.Subject == SubjectIP {
+			return true
+		}
+	}
+	for _, quota := range c.Quotas {
+		if quota.Subject == SubjectIP {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseProxies parses a comma-separated list of IP addresses and CIDR ranges.
+func ParseProxies(s string) ([]string, error) {
+	var proxies []string
+	for _, proxy := range strings.Split(s, ",") {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				return nil, fmt.Errorf("%q is not an IP address or CIDR range", proxy)
+			}
+		}
+		proxies = append(proxies, proxy)
+	}
+	return proxies, nil
+}
+
+// ParseLimits parses a list of limit rules.
+func ParseLimits(s string) ([]Limit, error) {
+	var limits []Limit
+	for _, rule := range rules(s) {
+		route, fields, err := parseRoute(rule)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) != 2 && len(fields) != 3 {
+			return nil, fmt.Errorf("%q: want route, subject, calls/period and an optional burst", rule)
+		}
+
+		limit := Limit{Route: route, Subject: fields[0]}
+		if err := checkSubject(limit.Subject); err != nil {
+			return nil, fmt.Errorf("%q: %w", rule, err)
+		}
+		if limit.Rate, limit.Period, err = parseRate(fields[1]); err != nil {
+			return nil, fmt.Errorf("%q: %w", rule, err)
+		}
+		limit.Burst = limit.Rate
+		if len(fields) == 3 {
+			if limit.Burst, err = strconv.Atoi(fields[2]); err != nil || limit.Burst <= 0 {
+				return nil, fmt.Errorf("%q: burst must be a positive integer", rule)
+			}
+		}
+		limits = append(limits, limit)
+	}
+	return limits, nil
+}
+
+// ParseQuotas parses a list of quota rules.
+func ParseQuotas(s string) ([]Quota, error) {
+	var quotas []Quota
+	for _, rule := range rules(s) {
+		route, fields, err := parseRoute(rule)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%q: want route, subject and calls/window", rule)
+		}
+
+		quota := Quota{Route: route, Subject: fields[0]}
+		if err := checkSubject(quota.Subject); err != nil {
+			return nil, fmt.Errorf("%q: %w", rule, err)
+		}
+		if quota.Limit, quota.Window, err = parseRate(fields[1]); err != nil {
+			return nil, fmt.Errorf("%q: %w", rule, err)
+		}
+		quotas = append(quotas, quota)
+	}
+	return quotas, nil
+}
+
+func rules(s string) []string {
+	var rules []string
+	for _, rule := range strings.Split(s, ";") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// parseRoute splits the route off a rule. HTTP routes are a method and a path.
+func parseRoute(rule string) (string, []string, error) {
+	fields := strings.Fields(rule)
+	switch {
+	case fields[0] == AllRoutes || strings.HasPrefix(fields[0], "/"):
+		return fields[0], fields[1:], nil
+	case len(fields) > 1 && strings.HasPrefix(fields[1], "/"):
+		return strings.ToUpper(fields[0]) + " " + fields[1], fields[2:], nil
+	default:
+		return "", nil, fmt.Errorf("%q: route must be *, a gRPC method or METHOD /path", rule)
+	}
+}
+
+func checkSubject(subject string) error {
+	switch subject {
+	case SubjectAPIKey, SubjectUser, SubjectIP:
+		return nil
+	default:
+		return fmt.Errorf("subject must be %s, %s or %s", SubjectAPIKey, SubjectUser, SubjectIP)
+	}
+}
+
+// parseRate parses calls/period, e.g. 10/1m.
+func parseRate(s string) (int, time.Duration, error) {
+	calls, period, ok := strings.Cut(s, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("rate must be calls/period, e.g. 10/1m")
+	}
+	n, err := strconv.Atoi(calls)
+	if err != nil || n <= 0 {
+		return 0, 0, fmt.Errorf("calls must be a positive integer")
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return 0, 0, fmt.Errorf("period must be a positive duration")
+	}
+	return n, d, nil
+}
@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// maxAttempts bounds the retries of a DatabaseStore update that keeps
+// conflicting with concurrent ones.
+const maxAttempts = 5
+
+// Table holds the states of a DatabaseStore. Each service implements it on
+// its own rate_limit_states table.
+type Table interface {
+	// Get returns the state at key and its version, or found false when
+	// there is none.
+	Get(ctx context.Context, key string) (state State, version int, found bool, err error)
+	// Create inserts the state at key, reporting false when another replica
+	// created it first.
+	Create(ctx context.Context, key string, state State) (bool, error)
+	// Replace replaces the state at key and increments its version, reporting
+	// false when the state is no longer at version.
+	Replace(ctx context.Context, key string, version int, state State) (bool, error)
+	// DeleteExpired deletes the states that expired before now.
+	DeleteExpired(ctx context.Context, now time.Time) error
+}
+
+// DatabaseStore keeps the state in a database table, so that every replica
+// enforces the same limits. Updates are optimistic: each applies only to the
+// version of the state it was computed from and is retried when another
+// replica got there first, up to maxAttempts times.
+type DatabaseStore struct {
+	table Table
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+func NewDatabaseStore(table Table) *DatabaseStore {
+	return &DatabaseStore{table: table}
+}
+
+func (s *DatabaseStore) Update(ctx context.Context, key string, now time.Time, fn func(State, bool) State) error {
+	defer s.sweep(ctx, now)
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		current, version, found, err := s.table.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		var done bool
+		if !found {
+			// A concurrent create leaves a state to update instead.
+			done, err = s.table.Create(ctx, key, fn(State{}, false))
+		} else {
+			done, err = s.table.Replace(ctx, key, version, fn(current, now.Before(current.ExpiresAt)))
+		}
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+	return ErrContention
+}
+
+// sweep deletes the expired states, at most once per sweepInterval.
+func (s *DatabaseStore) sweep(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	if now.Sub(s.lastSweep) < sweepInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastSweep = now
+	s.mu.Unlock()
+
+	if err := s.table.DeleteExpired(ctx, now); err != nil {
+		log.Printf("error deleting expired rate limit states: %v", err)
+	}
+}
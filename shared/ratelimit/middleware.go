@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"shared/auth"
+	"shared/problems"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Middleware rejects the requests of callers over one of their limits with
+// 429 and reports the state of the most constraining limit in RateLimit-*
+// headers. It runs after authentication, so that it knows the caller. When
+// the store fails, requests are rejected like those over their limits.
+func Middleware(limiter *Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		route := c.Request.Method + " " + c.FullPath()
+
+		d, err := limiter.Allow(ctx, route, Subjects(ctx, c.ClientIP()))
+		if err != nil {
+			log.Printf("error applying rate limits to %s: %v", route, err)
+		}
+
+		for name, value := range d.Headers() {
+			c.Header(name, value)
+		}
+		if !d.Allowed {
+			problems.WriteProblem(c, d.Problem())
+			return
+		}
+		c.Next()
+	}
+}
+
+// Subjects identifies the caller of ctx, calling from ip, to the limits.
+func Subjects(ctx context.Context, ip string) map[string]string {
+	subjects := map[string]string{SubjectIP: ip}
+	if p, ok := auth.FromContext(ctx); ok {
+		subjects[SubjectUser] = p.Subject
+		if p.APIKeyID != 0 {
+			subjects[SubjectAPIKey] = strconv.Itoa(p.APIKeyID)
+		}
+	}
+	return subjects
+}
+
+// Headers returns the RateLimit-Limit, RateLimit-Remaining and
+// RateLimit-Reset headers of the decision, and Retry-After if it rejects the
+// call. There are none when no limit applies.
+func (d Decision) Headers() map[string]string {
+	if d.Limit == 0 {
+		return nil
+	}
+	headers := map[string]string{
+		"RateLimit-Limit":     strconv.Itoa(d.Limit),
+		"RateLimit-Remaining": strconv.Itoa(d.Remaining),
+		"RateLimit-Reset":     strconv.Itoa(ceilSeconds(d.Reset)),
+	}
+	if !d.Allowed {
+		headers["Retry-After"] = strconv.Itoa(max(ceilSeconds(d.RetryAfter), 1))
+	}
+	return headers
+}
+
+// Problem returns the problem a rejected call is answered with.
+func (d Decision) Problem() *problems.Problem {
+	detail := fmt.Sprintf("retry in %d seconds", max(ceilSeconds(d.RetryAfter), 1))
+	if d.Quota {
+		return problems.New(problems.CodeQuotaExceeded, "quota of "+strconv.Itoa(d.Limit)+" calls used up, "+detail)
+	}
+	return problems.New(problems.CodeRateLimited, detail)
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
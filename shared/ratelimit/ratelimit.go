@@ -0,0 +1,214 @@
+// Package ratelimit limits how often clients call the API. Token buckets
+// absorb short bursts and quotas cap the calls over longer windows. Both apply
+// per API key, user or IP address, on a single route or on every route.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+// Subjects a limit can apply to.
+const (
+	SubjectAPIKey = "api_key"
+	SubjectUser   = "user"
+	SubjectIP     = "ip"
+)
+
+// AllRoutes is the route of limits shared by every route.
+const AllRoutes = "*"
+
+// Limit is a token bucket: every call takes a token and Rate tokens are added
+// every Period, up to Burst.
+type Limit struct {
+	// Route is "METHOD /path" as registered in the router, a gRPC method such
+	// as "/user.v1.UserService/CreateUser", or AllRoutes.
+	Route   string
+	Subject string
+	Rate    int
+	Period  time.Duration
+	Burst   int
+}
+
+// Quota caps the calls made in consecutive windows of Window, aligned to UTC
+// midnight for windows dividing a day.
+type Quota struct {
+	Route   string
+	Subject string
+	Limit   int
+	Window  time.Duration
+}
+
+// Decision reports whether a call is allowed, along with the state of its
+// most constraining limit.
+type Decision struct {
+	Allowed bool
+	// Quota reports whether the constraining limit is a quota.
+	Quota     bool
+	Limit     int
+	Remaining int
+	// Reset is how long until the constraining limit is replenished.
+	Reset time.Duration
+	// RetryAfter is how long a rejected caller has to wait.
+	RetryAfter time.Duration
+}
+
+// Limiter applies the configured limits and quotas to calls, keeping their
+// state in a Store.
+type Limiter struct {
+	config Config
+	store  Store
+	now    func() time.Time
+}
+
+func New(config Config, store Store) *Limiter {
+	return &Limiter{config: config, store: store, now: time.Now}
+}
+
+// TrustedProxies returns the proxies whose forwarded client addresses the IP
+// subject may be read from.
+func (l *Limiter) TrustedProxies() []string {
+	return l.config.TrustedProxies
+}
+
+// Allow counts a call to route made by subjects, which maps each subject to
+// the caller's value, e.g. SubjectIP to its address. Subjects without a value
+// are not limited. Once a limit rejects the call, the remaining limits are
+// not charged for it. When the store fails, the call is rejected and the
+// failure returned along with the decision.
+func (l *Limiter) Allow(ctx context.Context, route string, subjects map[string]string) (Decision, error) {
+	now := l.now()
+	result := Decision{Allowed: true}
+
+	for _, limit := range l.config.Limits {
+		value, ok := applies(limit.Route, limit.Subject, route, subjects)
+		if !ok {
+			continue
+		}
+		d, err := l.take(ctx, limit, value, now)
+		if err != nil {
+			return d, err
+		}
+		if result = constraining(result, d); !d.Allowed {
+			return d, nil
+		}
+	}
+
+	for _, quota := range l.config.Quotas {
+		value, ok := applies(quota.Route, quota.Subject, route, subjects)
+		if !ok {
+			continue
+		}
+		d, err := l.count(ctx, quota, value, now)
+		if err != nil {
+			return d, err
+		}
+		if result = constraining(result, d); !d.Allowed {
+			return d, nil
+		}
+	}
+
+	return result, nil
+}
+
+// take removes a token from the bucket of limit for the subject value.
+func (l *Limiter) take(ctx context.Context, limit Limit, value string, now time.Time) (Decision, error) {
+	capacity := float64(limit.Burst)
+	perSecond := float64(limit.Rate) / limit.Period.Seconds()
+
+	var d Decision
+	err := l.store.Update(ctx, key("limit", limit.Route, limit.Subject, value), now, func(s State, ok bool) State {
+		tokens := capacity
+		if ok {
+			tokens = min(capacity, s.Value+now.Sub(s.UpdatedAt).Seconds()*perSecond)
+		}
+
+		d = Decision{Allowed: tokens >= 1, Limit: limit.Burst}
+		if d.Allowed {
+			tokens--
+		} else {
+			d.RetryAfter = seconds((1 - tokens) / perSecond)
+		}
+		d.Remaining = int(math.Floor(tokens))
+		d.Reset = seconds((capacity - tokens) / perSecond)
+
+		// A bucket that has refilled is the same as no bucket.
+		return State{Value: tokens, UpdatedAt: now, ExpiresAt: now.Add(d.Reset)}
+	})
+	if err != nil {
+		return unavailable(false, limit.Burst), failure(err)
+	}
+	return d, nil
+}
+
+// count adds the call to the current window of quota for the subject value.
+func (l *Limiter) count(ctx context.Context, quota Quota, value string, now time.Time) (Decision, error) {
+	start := now.Truncate(quota.Window)
+	end := start.Add(quota.Window)
+
+	var d Decision
+	err := l.store.Update(ctx, key("quota", quota.Route, quota.Subject, value), now, func(s State, ok bool) State {
+		calls := 0
+		if ok && !s.UpdatedAt.Before(start) {
+			calls = int(s.Value)
+		}
+
+		d = Decision{Allowed: calls < quota.Limit, Quota: true, Limit: quota.Limit, Reset: end.Sub(now)}
+		if d.Allowed {
+			calls++
+		} else {
+			d.RetryAfter = d.Reset
+		}
+		d.Remaining = quota.Limit - calls
+
+		return State{Value: float64(calls), UpdatedAt: start, ExpiresAt: end}
+	})
+	if err != nil {
+		return unavailable(true, quota.Limit), failure(err)
+	}
+	return d, nil
+}
+
+// unavailable rejects a call whose limit could not be updated, either for
+// concurrent calls of the same subject, which only a flood of them causes, or
+// because the store failed. Letting it through would let a flood past the
+// limit while the store cannot count it.
+func unavailable(quota bool, limit int) Decision {
+	return Decision{Quota: quota, Limit: limit, Reset: time.Second, RetryAfter: time.Second}
+}
+
+// failure returns the store error worth reporting; contention is expected
+// under a flood and is not one.
+func failure(err error) error {
+	if errors.Is(err, ErrContention) {
+		return nil
+	}
+	return err
+}
+
+func applies(limitRoute, subject, route string, subjects map[string]string) (string, bool) {
+	if limitRoute != AllRoutes && limitRoute != route {
+		return "", false
+	}
+	value := subjects[subject]
+	return value, value != ""
+}
+
+// constraining returns the decision whose limit leaves the fewest calls.
+func constraining(a, b Decision) Decision {
+	if a.Limit == 0 || b.Remaining < a.Remaining {
+		return b
+	}
+	return a
+}
+
+func key(kind, route, subject, value string) string {
+	return strings.Join([]string{kind, route, subject, value}, "|")
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Ceil(s * float64(time.Second)))
+}
@@ -0,0 +1,217 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestLimiterAllow(t *testing.T) {
+	start := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	user := map[string]string{SubjectIP: "203.0.113.7", SubjectUser: "1"}
+	config := Config{
+		Limits: []Limit{
+			{Route: "POST /transfers", Subject: SubjectUser, Rate: 1, Period: time.Second, Burst: 2},
+			{Route: AllRoutes, Subject: SubjectIP, Rate: 100, Period: time.Second, Burst: 100},
+		},
+		Quotas: []Quota{
+			{Route: "POST /exports", Subject: SubjectUser, Limit: 2, Window: 24 * time.Hour},
+		},
+	}
+
+	type call struct {
+		after     time.Duration
+		route     string
+		subjects  map[string]string
+		allowed   bool
+		remaining int
+		quota     bool
+	}
+	tests := []struct {
+		name  string
+		calls []call
+	}{
+		{
+			name: "burst then refill",
+			calls: []call{
+				{route: "POST /transfers", subjects: user, allowed: true, remaining: 1},
+				{route: "POST /transfers", subjects: user, allowed: true, remaining: 0},
+				{route: "POST /transfers", subjects: user, allowed: false, remaining: 0},
+				{after: time.Second, route: "POST /transfers", subjects: user, allowed: true, remaining: 0},
+			},
+		},
+		{
+			name: "subjects are limited apart",
+			calls: []call{
+				{route: "POST /transfers", subjects: user, allowed: true, remaining: 1},
+				{route: "POST /transfers", subjects: user, allowed: true, remaining: 0},
+				{route: "POST /transfers", subjects: map[string]string{SubjectIP: "203.0.113.7", SubjectUser: "2"}, allowed: true, remaining: 1},
+			},
+		},
+		{
+			name: "other routes only share the IP limit",
+			calls: []call{
+				{route: "GET /balance", subjects: user, allowed: true, remaining: 99},
+				{route: "GET /balance", subjects: user, allowed: true, remaining: 98},
+			},
+		},
+		{
+			name: "limits without their subject do not apply",
+			calls: []call{
+				{route: "POST /transfers", subjects: map[string]string{SubjectIP: "203.0.113.7"}, allowed: true, remaining: 99},
+			},
+		},
+		{
+			name: "quota until the next window",
+			calls: []call{
+				{route: "POST /exports", subjects: user, allowed: true, remaining: 1, quota: true},
+				{route: "POST /exports", subjects: user, allowed: true, remaining: 0, quota: true},
+				{after: time.Hour, route: "POST /exports", subjects: user, allowed: false, remaining: 0, quota: true},
+				{after: 12 * time.Hour, route: "POST /exports", subjects: user, allowed: true, remaining: 1, quota: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := start
+			limiter := New(config, NewMemoryStore())
+			limiter.now = func() time.Time { return now }
+
+			for i, c := range tt.calls {
+				now = now.Add(c.after)
+				d, err := limiter.Allow(context.Background(), c.route, c.subjects)
+				if err != nil {
+					t.Fatalf("call %d: %v", i, err)
+				}
+				if d.Allowed != c.allowed || d.Remaining != c.remaining || d.Quota != c.quota {
+					t.Fatalf("call %d = allowed %v, remaining %d, quota %v; want %v, %d, %v",
+						i, d.Allowed, d.Remaining, d.Quota, c.allowed, c.remaining, c.quota)
+				}
+				if !d.Allowed && d.RetryAfter <= 0 {
+					t.Fatalf("call %d was rejected without a retry delay", i)
+				}
+			}
+		})
+	}
+}
+
+// failingStore fails every update with err.
+type failingStore struct{ err error }
+
+func (s failingStore) Update(context.Context, string, time.Time, func(State, bool) State) error {
+	return s.err
+}
+
+func TestLimiterAllowStoreErrors(t *testing.T) {
+	config := Config{Limits: []Limit{{Route: AllRoutes, Subject: SubjectIP, Rate: 1, Period: time.Second, Burst: 1}}}
+	subjects := map[string]string{SubjectIP: "203.0.113.7"}
+	failure := errors.New("connection refused")
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr error
+	}{
+		{name: "contention rejects", err: ErrContention},
+		{name: "failures reject and are returned", err: failure, wantErr: failure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := New(config, failingStore{tt.err}).Allow(context.Background(), "GET /balance", subjects)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if d.Allowed || d.RetryAfter != time.Second || d.Headers()["Retry-After"] != "1" {
+				t.Fatalf("got %+v, want a rejection retried after a second", d)
+			}
+		})
+	}
+}
+
+// conflictingTable is a Table whose every write loses to a concurrent one.
+type conflictingTable struct {
+	found bool
+	gets  int
+}
+
+func (t *conflictingTable) Get(context.Context, string) (State, int, bool, error) {
+	t.gets++
+	return State{}, t.gets, t.found, nil
+}
+
+func (t *conflictingTable) Create(context.Context, string, State) (bool, error) {
+	t.found = true
+	return false, nil
+}
+
+func (t *conflictingTable) Replace(context.Context, string, int, State) (bool, error) {
+	return false, nil
+}
+
+func (t *conflictingTable) DeleteExpired(context.Context, time.Time) error {
+	return nil
+}
+
+func TestDatabaseStoreContention(t *testing.T) {
+	table := &conflictingTable{}
+	calls := 0
+	err := NewDatabaseStore(table).Update(context.Background(), "key", time.Now(), func(s State, ok bool) State {
+		calls++
+		return s
+	})
+	if !errors.Is(err, ErrContention) {
+		t.Fatalf("got error %v, want %v", err, ErrContention)
+	}
+	if table.gets != maxAttempts || calls != maxAttempts {
+		t.Fatalf("got %d reads and %d updates, want %d of each", table.gets, calls, maxAttempts)
+	}
+}
+
+func TestLoadConfigTrustedProxies(t *testing.T) {
+	ipLimits := Config{Limits: []Limit{{Route: AllRoutes, Subject: SubjectIP, Rate: 1, Period: time.Second, Burst: 1}}}
+	userLimits := Config{Limits: []Limit{{Route: AllRoutes, Subject: SubjectUser, Rate: 1, Period: time.Second, Burst: 1}}}
+
+	tests := []struct {
+		name     string
+		defaults Config
+		proxies  *string
+		want     []string
+		wantErr  bool
+	}{
+		{name: "ip limits need proxies", defaults: ipLimits, wantErr: true},
+		{name: "empty proxies for direct clients", defaults: ipLimits, proxies: ptr("")},
+		{name: "proxies", defaults: ipLimits, proxies: ptr("10.0.0.0/8, 192.0.2.1"), want: []string{"10.0.0.0/8", "192.0.2.1"}},
+		{name: "other limits need none", defaults: userLimits},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.proxies != nil {
+				t.Setenv("TRUSTED_PROXIES", *tt.proxies)
+			} else {
+				unsetenv(t, "TRUSTED_PROXIES")
+			}
+			for _, name := range []string{"RATE_LIMITS", "RATE_QUOTAS", "RATE_LIMIT_STORE"} {
+				unsetenv(t, name)
+			}
+
+			config, err := LoadConfig(tt.defaults)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if !slices.Equal(config.TrustedProxies, tt.want) {
+				t.Fatalf("got proxies %v, want %v", config.TrustedProxies, tt.want)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string { return &s }
+
+// unsetenv unsets the variable name for the duration of the test.
+func unsetenv(t *testing.T, name string) {
+	t.Setenv(name, "")
+	os.Unsetenv(name)
+}
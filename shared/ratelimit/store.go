@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// State is the stored state of a token bucket or a quota window.
+type State struct {
+	Value     float64
+	UpdatedAt time.Time
+	// ExpiresAt is when the state is no longer needed; an expired state is
+	// treated as absent.
+	ExpiresAt time.Time
+}
+
+// ErrContention is returned by stores whose update of a state kept
+// conflicting with concurrent ones. The call is rejected then.
+var ErrContention = errors.New("rate limit state kept changing concurrently")
+
+// Store keeps the state of the limits.
+type Store interface {
+	// Update atomically replaces the state at key with the result of fn, which
+	// receives the current state and whether there is one. fn may be called
+	// more than once when concurrent updates conflict, until the store gives
+	// up with ErrContention.
+	Update(ctx context.Context, key string, now time.Time, fn func(State, bool) State) error
+}
+
+// sweepInterval is how often expired states are removed.
+const sweepInterval = time.Minute
+
+// MemoryStore keeps the state in memory. Each replica enforces the limits on
+// its own, so it suits single-instance deployments.
+type MemoryStore struct {
+	mu        sync.Mutex
+	states    map[string]State
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{states: make(map[string]State)}
+}
+
+func (s *MemoryStore) Update(ctx context.Context, key string, now time.Time, fn func(State, bool) State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.states[key]
+	s.states[key] = fn(current, ok && now.Before(current.ExpiresAt))
+
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		for k, state := range s.states {
+			if !now.Before(state.ExpiresAt) {
+				delete(s.states, k)
+			}
+		}
+		s.lastSweep = now
+	}
+	return nil
+}
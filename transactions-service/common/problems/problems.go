@@ -0,0 +1,85 @@
+// Package problems implements RFC 7807 problem details for transactions-service.
+// The problem types and the codes shared with user-service live in
+// shared/problems; this package re-exports them and registers the codes of
+// transactions-service.
+package problems
+
+import (
+	"net/http"
+	shared "shared/problems"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+)
+
+type (
+	// Code is a stable, machine-readable error code.
+	Code = shared.Code
+	// Problem is an RFC 7807 problem details object extended with a stable code.
+	Problem = shared.Problem // @name problems.Problem
+)
+
+// Codes shared with user-service.
+const (
+	CodeInvalidRequest     = shared.CodeInvalidRequest
+	CodeValidationFailed   = shared.CodeValidationFailed
+	CodeUnauthorized       = shared.CodeUnauthorized
+	CodeForbidden          = shared.CodeForbidden
+	CodeUserNotFound       = shared.CodeUserNotFound
+	CodeEmailNotVerified   = shared.CodeEmailNotVerified
+	CodeStepUpRequired     = shared.CodeStepUpRequired
+	CodeUserErased         = shared.CodeUserErased
+	CodeAliasNotFound      = shared.CodeAliasNotFound
+	CodeRateLimited        = shared.CodeRateLimited
+	CodeQuotaExceeded      = shared.CodeQuotaExceeded
+	CodeServiceTimeout     = shared.CodeServiceTimeout
+	CodeServiceUnavailable = shared.CodeServiceUnavailable
+	CodeInternal           = shared.CodeInternal
+)
+
+// Codes of transactions-service.
+const (
+	CodePayeeNotFound        Code = "PAYEE_NOT_FOUND"         // 404 Not Found
+	CodePayeeExists          Code = "PAYEE_EXISTS"            // 409 Conflict
+	CodeInsufficientFunds    Code = "INSUFFICIENT_FUNDS"      // 422 Unprocessable Entity
+	CodeDuplicateRequest     Code = "DUPLICATE_REQUEST"       // 409 Conflict
+	CodeWalletFrozen         Code = "WALLET_FROZEN"           // 409 Conflict
+	CodeTransferNotFound     Code = "TRANSFER_NOT_FOUND"      // 404 Not Found
+	CodeDeadLetterNotFound   Code = "DEAD_LETTER_NOT_FOUND"   // 404 Not Found
+	CodeDeadLetterNotPending Code = "DEAD_LETTER_NOT_PENDING" // 409 Conflict
+	CodeWebhookNotFound      Code = "WEBHOOK_NOT_FOUND"       // 404 Not Found
+	CodeDeliveryNotFound     Code = "DELIVERY_NOT_FOUND"      // 404 Not Found
+)
+
+func init() {
+	shared.Register(CodePayeeNotFound, http.StatusNotFound, codes.NotFound, "Payee not found")
+	shared.Register(CodePayeeExists, http.StatusConflict, codes.AlreadyExists, "Payee already saved")
+	shared.Register(CodeInsufficientFunds, http.StatusUnprocessableEntity, codes.FailedPrecondition, "Insufficient funds")
+	shared.Register(CodeDuplicateRequest, http.StatusConflict, codes.AlreadyExists, "Request already processed")
+	shared.Register(CodeWalletFrozen, http.StatusConflict, codes.FailedPrecondition, "Wallet is frozen")
+	shared.Register(CodeTransferNotFound, http.StatusNotFound, codes.NotFound, "Transfer not found")
+	shared.Register(CodeDeadLetterNotFound, http.StatusNotFound, codes.NotFound, "Dead letter not found")
+	shared.Register(CodeDeadLetterNotPending, http.StatusConflict, codes.FailedPrecondition, "Dead letter is not pending")
+	shared.Register(CodeWebhookNotFound, http.StatusNotFound, codes.NotFound, "Webhook endpoint not found")
+	shared.Register(CodeDeliveryNotFound, http.StatusNotFound, codes.NotFound, "Webhook delivery not found")
+}
+
+// New builds the problem for code with the given detail.
+func New(code Code, detail string) *Problem {
+	return shared.New(code, detail)
+}
+
+// As returns the problem wrapped in err, if any.
+func As(err error) (*Problem, bool) {
+	return shared.As(err)
+}
+
+// Write aborts the request with the problem for code.
+func Write(c *gin.Context, code Code, detail string) {
+	shared.Write(c, code, detail)
+}
+
+// WriteProblem aborts the request with p, using the request path as its instance.
+func WriteProblem(c *gin.Context, p *Problem) {
+	shared.WriteProblem(c, p)
+}
@@ -8,9 +8,68 @@ type AddMoneyRequest struct {
 	RequestId uuid.UUID `json:"request_id"`
 }
 
+// TransferMoneyRequest names the recipient by to_user_id or by to, a handle,
+// email or phone number registered as their payment alias.
 type TransferMoneyRequest struct {
 	FromUserID       int       `json:"from_user_id"`
-	ToUserID         int       `json:"to_user_id"`
+	ToUserID         int       `json:"to_user_id,omitempty"`
+	To               string    `json:"to,omitempty" example:"@jane_doe"`
 	AmountToTransfer float64   `json:"amount_to_transfer"`
 	RequestId        uuid.UUID `json:"request_id"`
 }
+
+type DepositRequest struct {
+	Amount    float64   `json:"amount"`
+	RequestId uuid.UUID `json:"request_id"`
+}
+
+// CreateTransferRequest names the recipient by to_wallet_id or by to, a
+// handle, email or phone number registered as their payment alias.
+type CreateTransferRequest struct {
+	ToWalletID int       `json:"to_wallet_id,omitempty"`
+	To         string    `json:"to,omitempty" example:"@jane_doe"`
+	Amount     float64   `json:"amount"`
+	RequestId  uuid.UUID `json:"request_id"`
+}
+
+// CreatePayeeRequest names the paid wallet by wallet_id or by to, a handle,
+// email or phone number registered as its owner's payment alias.
+type CreatePayeeRequest struct {
+	WalletID      int      `json:"wallet_id,omitempty"`
+	To            string   `json:"to,omitempty" example:"@jane_doe"`
+	Nickname      string   `json:"nickname" binding:"required" example:"Jane (rent)"`
+	DefaultAmount *float64 `json:"default_amount,omitempty" example:"450"`
+	Memo          string   `json:"memo,omitempty" example:"Monthly rent"`
+}
+
+// UpdatePayeeRequest changes the fields it carries and keeps the others. A
+// default_amount of 0 and an empty memo remove them.
+type UpdatePayeeRequest struct {
+	Nickname      *string  `json:"nickname,omitempty" example:"Jane"`
+	DefaultAmount *float64 `json:"default_amount,omitempty" example:"500"`
+	Memo          *string  `json:"memo,omitempty" example:"Rent and utilities"`
+}
+
+// PayPayeeRequest pays a saved payee; amount defaults to the payee's default
+// amount.
+type PayPayeeRequest struct {
+	Amount    *float64  `json:"amount,omitempty"`
+	RequestId uuid.UUID `json:"request_id"`
+}
+
+type RegisterWebhookRequest struct {
+	Client     string   `json:"client" binding:"required" example:"acme"`
+	WalletID   int      `json:"wallet_id" binding:"required" example:"1"`
+	URL        string   `json:"url" binding:"required" example:"https://example.com/webhooks"`
+	EventTypes []string `json:"event_types" example:"balance-changed"`
+}
+
+type UpdateWebhookRequest struct {
+	URL        *string   `json:"url,omitempty"`
+	EventTypes *[]string `json:"event_types,omitempty"`
+	Enabled    *bool     `json:"enabled,omitempty"`
+}
+
+type FreezeWalletRequest struct {
+	Reason string `json:"reason" binding:"required" example:"suspected account takeover"`
+}
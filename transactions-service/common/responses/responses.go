@@ -1,11 +1,184 @@
 package responses
 
+import (
+	"time"
+)
+
+// StatusSuccess is the status of every successful response. Failures are
+// reported as problem details instead.
+const StatusSuccess = "success"
+
 type BaseResponse struct {
-	Status  string `json:"status"`
+	Status  string `json:"status" example:"success"`
 	Message string `json:"message"`
 }
 
 type AddMoneyResponse struct {
-	Status  string  `json:"status"`
-	Balance float64 `json:"balance"`
+	Status   string  `json:"status" example:"success"`
+	Balance  float64 `json:"balance"`
+	Currency string  `json:"currency" example:"USD"`
+}
+
+type WalletResponse struct {
+	ID        int       `json:"id"`
+	Email     string    `json:"email"`
+	Balance   float64   `json:"balance"`
+	Currency  string    `json:"currency" example:"USD"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TransferResponse struct {
+	ID           string    `json:"id" example:"6f1c2a52-3c0e-4c43-9d0e-8f3d2b7a1e4c"`
+	FromWalletID int       `json:"from_wallet_id"`
+	ToWalletID   int       `json:"to_wallet_id"`
+	Amount       float64   `json:"amount"`
+	Currency     string    `json:"currency" example:"USD"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type TransferListResponse struct {
+	Status    string             `json:"status" example:"success"`
+	Transfers []TransferResponse `json:"transfers"`
+	Total     int                `json:"total"`
+}
+
+type PayeeResponse struct {
+	ID       int `json:"id"`
+	WalletID int `json:"wallet_id"`
+	// Alias is the handle, email or phone number the payee was saved by.
+	Alias         string   `json:"alias,omitempty" example:"@jane_doe"`
+	Nickname      string   `json:"nickname" example:"Jane (rent)"`
+	DefaultAmount *float64 `json:"default_amount,omitempty" example:"450"`
+	Memo          string   `json:"memo,omitempty" example:"Monthly rent"`
+	// Status tells whether the payee can be paid: active, or frozen or closed
+	// when its wallet is.
+	Status     string     `json:"status" example:"active"`
+	LastPaidAt *time.Time `json:"last_paid_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+type PayeeListResponse struct {
+	Status string          `json:"status" example:"success"`
+	Payees []PayeeResponse `json:"payees"`
+}
+
+type PayeeSuggestionResponse struct {
+	WalletID       int       `json:"wallet_id"`
+	TransferCount  int       `json:"transfer_count"`
+	LastAmount     float64   `json:"last_amount"`
+	LastTransferAt time.Time `json:"last_transfer_at"`
+}
+
+type PayeeSuggestionListResponse struct {
+	Status      string                    `json:"status" example:"success"`
+	Suggestions []PayeeSuggestionResponse `json:"suggestions"`
+}
+
+type WebhookResponse struct {
+	ID                  int        `json:"id"`
+	Client              string     `json:"client"`
+	WalletID            *int       `json:"wallet_id"`
+	URL                 string     `json:"url"`
+	EventTypes          []string   `json:"event_types"`
+	Enabled             bool       `json:"enabled"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	// Secret is only returned when the endpoint is registered.
+	Secret string `json:"secret,omitempty"`
+}
+
+type WebhookListResponse struct {
+	Status   string            `json:"status" example:"success"`
+	Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             int        `json:"id"`
+	EndpointID     int        `json:"endpoint_id"`
+	EventID        int        `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status" example:"pending"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	ResponseStatus *int       `json:"response_status,omitempty"`
+	Error          string     `json:"error,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type WebhookDeliveryListResponse struct {
+	Status     string                    `json:"status" example:"success"`
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	Total      int                       `json:"total"`
+}
+
+type DeadLetterResponse struct {
+	ID        int       `json:"id"`
+	Subject   string    `json:"subject"`
+	Data      string    `json:"data"`
+	Error     string    `json:"error"`
+	ErrorCode string    `json:"error_code"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type DeadLetterListResponse struct {
+	Status      string               `json:"status" example:"success"`
+	DeadLetters []DeadLetterResponse `json:"dead_letters"`
+	Total       int                  `json:"total"`
+}
+
+type AdminWalletResponse struct {
+	ID           int        `json:"id"`
+	Email        string     `json:"email"`
+	Balance      float64    `json:"balance"`
+	Currency     string     `json:"currency" example:"USD"`
+	CreatedAt    time.Time  `json:"created_at"`
+	VerifiedAt   *time.Time `json:"verified_at,omitempty"`
+	FrozenAt     *time.Time `json:"frozen_at,omitempty"`
+	FrozenReason string     `json:"frozen_reason,omitempty"`
+	ErasedAt     *time.Time `json:"erased_at,omitempty"`
+}
+
+type AdminWalletListResponse struct {
+	Status  string                `json:"status" example:"success"`
+	Wallets []AdminWalletResponse `json:"wallets"`
+	Total   int                   `json:"total"`
+}
+
+type LedgerEntryResponse struct {
+	ID        int       `json:"id"`
+	Type      string    `json:"type" example:"debit"`
+	Amount    float64   `json:"amount"`
+	Currency  string    `json:"currency" example:"USD"`
+	RequestID string    `json:"request_id" example:"6f1c2a52-3c0e-4c43-9d0e-8f3d2b7a1e4c"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type LedgerResponse struct {
+	Status  string                `json:"status" example:"success"`
+	Entries []LedgerEntryResponse `json:"entries"`
+	Total   int                   `json:"total"`
+}
+
+type AuditLogEntryResponse struct {
+	ID         int            `json:"id"`
+	Actor      string         `json:"actor" example:"1"`
+	ActorRoles []string       `json:"actor_roles"`
+	Action     string         `json:"action" example:"wallet.freeze"`
+	TargetType string         `json:"target_type" example:"wallet"`
+	TargetID   string         `json:"target_id" example:"42"`
+	Details    map[string]any `json:"details,omitempty"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+type AuditLogResponse struct {
+	Status  string                  `json:"status" example:"success"`
+	Entries []AuditLogEntryResponse `json:"entries"`
+	Total   int                     `json:"total"`
 }
@@ -0,0 +1,367 @@
+package controllers
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"shared/messages"
+	"strconv"
+	"transactions-service/audit"
+	"transactions-service/common/problems"
+	"transactions-service/common/requests"
+	"transactions-service/common/responses"
+	"transactions-service/ent"
+	"transactions-service/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultAdminPageSize = 50
+	maxAdminPageSize     = 500
+)
+
+// AdminController serves the back-office API used by operators. Access is
+// granted per route by the permissions of the caller's roles.
+type AdminController struct {
+	client *ent.Client
+	admin  *services.AdminService
+}
+
+func NewAdminController(client *ent.Client, admin *services.AdminService) *AdminController {
+	return &AdminController{client: client, admin: admin}
+}
+
+// SearchWallets godoc
+// @Summary Search wallets
+// @Description Search wallets by email, frozen state and balance range
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param email query string false "Part of the email, ignoring case"
+// @Param frozen query bool false "Only frozen (true) or active (false) wallets"
+// @Param min_balance query number false "Minimum balance"
+// @Param max_balance query number false "Maximum balance"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AdminWalletListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/wallets [get]
+func (ctrl *AdminController) SearchWallets(c *gin.Context) {
+	search := services.WalletSearch{Email: c.Query("email")}
+
+	if v := c.Query("frozen"); v != "" {
+		frozen, err := strconv.ParseBool(v)
+		if err != nil {
+			problems.Write(c, problems.CodeInvalidRequest, "frozen must be true or false")
+			return
+		}
+		search.Frozen = &frozen
+	}
+	var ok bool
+	if search.MinBalance, ok = balanceQuery(c, "min_balance"); !ok {
+		return
+	}
+	if search.MaxBalance, ok = balanceQuery(c, "max_balance"); !ok {
+		return
+	}
+
+	limit, offset, err := pagination(c, defaultAdminPageSize, maxAdminPageSize)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	wallets, total, err := ctrl.admin.SearchWallets(context.Background(), search, limit, offset)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.AdminWalletResponse, 0, len(wallets))
+	for _, u := range wallets {
+		items = append(items, adminWalletResponse(u))
+	}
+
+	c.JSON(http.StatusOK, responses.AdminWalletListResponse{
+		Status:  responses.StatusSuccess,
+		Wallets: items,
+		Total:   total,
+	})
+}
+
+// GetWallet godoc
+// @Summary Inspect a wallet
+// @Description Get a wallet with its frozen state
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID (the ID of its user)"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AdminWalletResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/wallets/{id} [get]
+func (ctrl *AdminController) GetWallet(c *gin.Context) {
+	id, ok := adminWalletID(c)
+	if !ok {
+		return
+	}
+
+	u, err := ctrl.client.User.Get(context.Background(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			problems.Write(c, problems.CodeUserNotFound, "")
+			return
+		}
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, adminWalletResponse(u))
+}
+
+// GetLedger godoc
+// @Summary Get the ledger of a wallet
+// @Description List every credit and debit recorded for a wallet, newest first
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID (the ID of its user)"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.LedgerResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/wallets/{id}/ledger [get]
+func (ctrl *AdminController) GetLedger(c *gin.Context) {
+	id, ok := adminWalletID(c)
+	if !ok {
+		return
+	}
+
+	limit, offset, err := pagination(c, defaultAdminPageSize, maxAdminPageSize)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	records, total, err := ctrl.admin.Ledger(context.Background(), id, limit, offset)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	entries := make([]responses.LedgerEntryResponse, 0, len(records))
+	for _, t := range records {
+		entries = append(entries, responses.LedgerEntryResponse{
+			ID:        t.ID,
+			Type:      t.Type.String(),
+			Amount:    t.Amount,
+			Currency:  messages.DefaultCurrency,
+			RequestID: t.RequestID.String(),
+			CreatedAt: t.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, responses.LedgerResponse{
+		Status:  responses.StatusSuccess,
+		Entries: entries,
+		Total:   total,
+	})
+}
+
+// FreezeWallet godoc
+// @Summary Freeze a wallet
+// @Description Stop a wallet from sending or receiving money. Freezing a frozen wallet keeps the original freeze.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID (the ID of its user)"
+// @Param request body requests.FreezeWalletRequest true "Reason"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AdminWalletResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/wallets/{id}/freeze [post]
+func (ctrl *AdminController) FreezeWallet(c *gin.Context) {
+	ctrl.setFrozen(c, ctrl.admin.FreezeWallet)
+}
+
+// UnfreezeWallet godoc
+// @Summary Unfreeze a wallet
+// @Description Let a frozen wallet send and receive money again
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID (the ID of its user)"
+// @Param request body requests.FreezeWalletRequest true "Reason"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AdminWalletResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 410 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/wallets/{id}/unfreeze [post]
+func (ctrl *AdminController) UnfreezeWallet(c *gin.Context) {
+	ctrl.setFrozen(c, ctrl.admin.UnfreezeWallet)
+}
+
+func (ctrl *AdminController) setFrozen(c *gin.Context, set func(context.Context, int, string) (*ent.User, error)) {
+	id, ok := adminWalletID(c)
+	if !ok {
+		return
+	}
+
+	var req requests.FreezeWalletRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	// The request context carries the operator recorded in the audit log.
+	u, err := set(c.Request.Context(), id, req.Reason)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, adminWalletResponse(u))
+}
+
+// ListAuditLog godoc
+// @Summary List the audit log
+// @Description List the privileged actions performed on transactions-service, newest first
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param actor query string false "Filter by operator (token subject)"
+// @Param action query string false "Filter by action, e.g. wallet.freeze"
+// @Param target_type query string false "Filter by target type (wallet, dead_letter, webhook)"
+// @Param target_id query string false "Filter by target ID"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AuditLogResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/audit-log [get]
+func (ctrl *AdminController) ListAuditLog(c *gin.Context) {
+	limit, offset, err := pagination(c, defaultAdminPageSize, maxAdminPageSize)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	filter := audit.Filter{
+		Actor:      c.Query("actor"),
+		Action:     c.Query("action"),
+		TargetType: c.Query("target_type"),
+		TargetID:   c.Query("target_id"),
+	}
+	logs, total, err := audit.List(context.Background(), ctrl.client, filter, limit, offset)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	entries := make([]responses.AuditLogEntryResponse, 0, len(logs))
+	for _, l := range logs {
+		entries = append(entries, responses.AuditLogEntryResponse{
+			ID:         l.ID,
+			Actor:      l.Actor,
+			ActorRoles: l.ActorRoles,
+			Action:     l.Action,
+			TargetType: l.TargetType,
+			TargetID:   l.TargetID,
+			Details:    l.Details,
+			CreatedAt:  l.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, responses.AuditLogResponse{
+		Status:  responses.StatusSuccess,
+		Entries: entries,
+		Total:   total,
+	})
+}
+
+// recordAudit records a privileged action that has already been performed.
+// A failure to record it is logged rather than reported, as the action
+// cannot be undone.
+func recordAudit(c *gin.Context, client *ent.Client, action, targetType, targetID string, details map[string]any) {
+	if err := audit.Record(c.Request.Context(), client, action, targetType, targetID, details); err != nil {
+		log.Printf("error recording %s of %s %s: %v", action, targetType, targetID, err)
+	}
+}
+
+// adminWalletID reads the wallet ID of an admin route. Unlike walletID it
+// does not require the caller to own the wallet; the route's permission
+// grants access.
+func adminWalletID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, "invalid wallet id")
+		return 0, false
+	}
+	return id, true
+}
+
+func balanceQuery(c *gin.Context, name string) (*float64, bool) {
+	v := c.Query(name)
+	if v == "" {
+		return nil, true
+	}
+	b, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, name+" must be a number")
+		return nil, false
+	}
+	return &b, true
+}
+
+func adminWalletResponse(u *ent.User) responses.AdminWalletResponse {
+	return responses.AdminWalletResponse{
+		ID:           u.ID,
+		Email:        u.Email,
+		Balance:      u.Balance,
+		Currency:     messages.DefaultCurrency,
+		CreatedAt:    u.CreatedAt,
+		VerifiedAt:   u.VerifiedAt,
+		FrozenAt:     u.FrozenAt,
+		FrozenReason: u.FrozenReason,
+		ErasedAt:     u.ErasedAt,
+	}
+}
@@ -0,0 +1,226 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"transactions-service/audit"
+	"transactions-service/common/problems"
+	"transactions-service/common/responses"
+	"transactions-service/ent"
+	"transactions-service/ent/deadletter"
+	"transactions-service/messaging"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultDeadLetterPageSize = 50
+	maxDeadLetterPageSize     = 500
+)
+
+type DeadLettersController struct {
+	client *ent.Client
+}
+
+func NewDeadLettersController(client *ent.Client) *DeadLettersController {
+	return &DeadLettersController{client: client}
+}
+
+// ListDeadLetters godoc
+// @Summary List dead letters
+// @Description List messages that failed processing, newest first
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param status query string false "Filter by status (pending, discarded)"
+// @Param subject query string false "Filter by subject"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.DeadLetterListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v1/admin/dead-letters [get]
+func (ctrl *DeadLettersController) ListDeadLetters(c *gin.Context) {
+	query := ctrl.client.DeadLetter.Query()
+
+	if status := c.Query("status"); status != "" {
+		s := deadletter.Status(status)
+		if err := deadletter.StatusValidator(s); err != nil {
+			problems.Write(c, problems.CodeInvalidRequest, err.Error())
+			return
+		}
+		query = query.Where(deadletter.StatusEQ(s))
+	}
+	if subject := c.Query("subject"); subject != "" {
+		query = query.Where(deadletter.SubjectEQ(subject))
+	}
+
+	limit, offset, err := pagination(c, defaultDeadLetterPageSize, maxDeadLetterPageSize)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	ctx := context.Background()
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	dls, err := query.Order(ent.Desc(deadletter.FieldID)).Limit(limit).Offset(offset).All(ctx)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.DeadLetterResponse, 0, len(dls))
+	for _, dl := range dls {
+		items = append(items, deadLetterResponse(dl))
+	}
+
+	c.JSON(http.StatusOK, responses.DeadLetterListResponse{
+		Status:      responses.StatusSuccess,
+		DeadLetters: items,
+		Total:       total,
+	})
+}
+
+// GetDeadLetter godoc
+// @Summary Inspect a dead letter
+// @Description Get a failed message with its payload, last error and attempt count
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Dead letter ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.DeadLetterResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v1/admin/dead-letters/{id} [get]
+func (ctrl *DeadLettersController) GetDeadLetter(c *gin.Context) {
+	id, ok := deadLetterID(c)
+	if !ok {
+		return
+	}
+
+	dl, err := ctrl.client.DeadLetter.Get(context.Background(), id)
+	if err != nil {
+		sendDeadLetterError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, deadLetterResponse(dl))
+}
+
+// DiscardDeadLetter godoc
+// @Summary Discard a dead letter
+// @Description Mark a pending dead letter as discarded once it was dealt with
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Dead letter ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.DeadLetterResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v1/admin/dead-letters/{id} [delete]
+func (ctrl *DeadLettersController) DiscardDeadLetter(c *gin.Context) {
+	id, ok := deadLetterID(c)
+	if !ok {
+		return
+	}
+
+	n, err := ctrl.client.DeadLetter.Update().
+		Where(deadletter.IDEQ(id), deadletter.StatusEQ(deadletter.StatusPending)).
+		SetStatus(deadletter.StatusDiscarded).
+		Save(context.Background())
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	dl, err := ctrl.client.DeadLetter.Get(context.Background(), id)
+	if err != nil {
+		sendDeadLetterError(c, err)
+		return
+	}
+	if n == 0 {
+		sendDeadLetterError(c, messaging.ErrDeadLetterNotPending)
+		return
+	}
+
+	recordAudit(c, ctrl.client, audit.ActionDeadLetterDiscard, audit.TargetDeadLetter, strconv.Itoa(id), nil)
+	c.JSON(http.StatusOK, deadLetterResponse(dl))
+}
+
+func deadLetterID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, "invalid dead letter id")
+		return 0, false
+	}
+	return id, true
+}
+
+func deadLetterResponse(dl *ent.DeadLetter) responses.DeadLetterResponse {
+	return responses.DeadLetterResponse{
+		ID:        dl.ID,
+		Subject:   dl.Subject,
+		Data:      string(dl.Data),
+		Error:     dl.Error,
+		ErrorCode: dl.ErrorCode,
+		Status:    dl.Status.String(),
+		CreatedAt: dl.CreatedAt,
+		UpdatedAt: dl.UpdatedAt,
+	}
+}
+
+func sendDeadLetterError(c *gin.Context, err error) {
+	if ent.IsNotFound(err) {
+		problems.Write(c, problems.CodeDeadLetterNotFound, "")
+		return
+	}
+	writeProblem(c, err)
+}
+
+// pagination reads the limit and offset query parameters.
+func pagination(c *gin.Context, defaultLimit int, maxLimit int) (int, int, error) {
+	limit := defaultLimit
+	if v := c.Query("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l <= 0 {
+			return 0, 0, errors.New("limit must be a positive integer")
+		}
+		limit = min(l, maxLimit)
+	}
+
+	offset := 0
+	if v := c.Query("offset"); v != "" {
+		o, err := strconv.Atoi(v)
+		if err != nil || o < 0 {
+			return 0, 0, errors.New("offset must be a non-negative integer")
+		}
+		offset = o
+	}
+
+	return limit, offset, nil
+}
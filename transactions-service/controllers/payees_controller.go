@@ -0,0 +1,352 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"transactions-service/common/problems"
+	"transactions-service/common/requests"
+	"transactions-service/common/responses"
+	"transactions-service/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PayeesController serves the contact books of wallets: the payees their
+// owners saved to pay again.
+type PayeesController struct {
+	transactions *services.TransactionsService
+}
+
+func NewPayeesController(transactions *services.TransactionsService) *PayeesController {
+	return &PayeesController{transactions: transactions}
+}
+
+// ListPayees godoc
+// @Summary List the saved payees of a wallet
+// @Description List the payees saved by the owner of a wallet, ordered by nickname. Each payee's status is
+// @Description revalidated against the wallet it pays: frozen and closed wallets cannot be paid.
+// @Tags payees
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.PayeeListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/wallets/{id}/payees [get]
+func (ctrl *PayeesController) ListPayees(c *gin.Context) {
+	id, ok := walletID(c)
+	if !ok {
+		return
+	}
+
+	payees, err := ctrl.transactions.ListPayees(c.Request.Context(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.PayeeResponse, 0, len(payees))
+	for _, p := range payees {
+		items = append(items, payeeResponse(p))
+	}
+	c.JSON(http.StatusOK, responses.PayeeListResponse{
+		Status: responses.StatusSuccess,
+		Payees: items,
+	})
+}
+
+// CreatePayee godoc
+// @Summary Save a payee
+// @Description Save a wallet in the contact book of a wallet, named by its ID in wallet_id or by a handle,
+// @Description email or phone number of its owner in to. A wallet is saved at most once per contact book.
+// @Description Saving a payee needs a step-up token in the X-Step-Up-Token header.
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID"
+// @Param request body requests.CreatePayeeRequest true "Payee"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 201 {object} responses.PayeeResponse
+// @Header 201 {string} Location "URL of the saved payee"
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 410 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Failure 503 {object} problems.Problem
+// @Router /v2/wallets/{id}/payees [post]
+func (ctrl *PayeesController) CreatePayee(c *gin.Context) {
+	id, ok := walletID(c)
+	if !ok {
+		return
+	}
+
+	var req requests.CreatePayeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	p, err := ctrl.transactions.CreatePayee(c.Request.Context(), id, services.NewPayee{
+		WalletID:      req.WalletID,
+		Alias:         req.To,
+		Nickname:      req.Nickname,
+		DefaultAmount: req.DefaultAmount,
+		Memo:          req.Memo,
+	})
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Location", "/api/v2/wallets/"+strconv.Itoa(id)+"/payees/"+strconv.Itoa(p.ID))
+	c.JSON(http.StatusCreated, payeeResponse(p))
+}
+
+// GetPayee godoc
+// @Summary Get a saved payee
+// @Tags payees
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID"
+// @Param payeeId path int true "Payee ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.PayeeResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/wallets/{id}/payees/{payeeId} [get]
+func (ctrl *PayeesController) GetPayee(c *gin.Context) {
+	id, payee, ok := payeeID(c)
+	if !ok {
+		return
+	}
+
+	p, err := ctrl.transactions.GetPayee(c.Request.Context(), id, payee)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, payeeResponse(p))
+}
+
+// UpdatePayee godoc
+// @Summary Update a saved payee
+// @Description Change the nickname, default amount or memo of a payee; fields left out are kept. A
+// @Description default_amount of 0 and an empty memo remove them.
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID"
+// @Param payeeId path int true "Payee ID"
+// @Param request body requests.UpdatePayeeRequest true "Fields to change"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.PayeeResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/wallets/{id}/payees/{payeeId} [patch]
+func (ctrl *PayeesController) UpdatePayee(c *gin.Context) {
+	id, payee, ok := payeeID(c)
+	if !ok {
+		return
+	}
+
+	var req requests.UpdatePayeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	p, err := ctrl.transactions.UpdatePayee(c.Request.Context(), id, payee, services.PayeeUpdate{
+		Nickname:      req.Nickname,
+		DefaultAmount: req.DefaultAmount,
+		Memo:          req.Memo,
+	})
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, payeeResponse(p))
+}
+
+// DeletePayee godoc
+// @Summary Remove a saved payee
+// @Tags payees
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID"
+// @Param payeeId path int true "Payee ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.BaseResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/wallets/{id}/payees/{payeeId} [delete]
+func (ctrl *PayeesController) DeletePayee(c *gin.Context) {
+	id, payee, ok := payeeID(c)
+	if !ok {
+		return
+	}
+
+	if err := ctrl.transactions.DeletePayee(c.Request.Context(), id, payee); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.BaseResponse{
+		Status:  responses.StatusSuccess,
+		Message: "Payee removed",
+	})
+}
+
+// PayPayee godoc
+// @Summary Transfer money to a saved payee
+// @Description Transfer money from a wallet to one of its saved payees, by default the payee's default amount.
+// @Description The payee is revalidated first: frozen wallets answer 409 and closed ones 410. The request ID
+// @Description becomes the ID of the transfer, and amounts above the step-up threshold need a step-up token
+// @Description from user-service in the X-Step-Up-Token header.
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID"
+// @Param payeeId path int true "Payee ID"
+// @Param request body requests.PayPayeeRequest true "Payment"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 201 {object} responses.TransferResponse
+// @Header 201 {string} Location "URL of the created transfer"
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 410 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/wallets/{id}/payees/{payeeId}/transfers [post]
+func (ctrl *PayeesController) PayPayee(c *gin.Context) {
+	id, payee, ok := payeeID(c)
+	if !ok {
+		return
+	}
+
+	var req requests.PayPayeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	t, err := ctrl.transactions.PayPayee(c.Request.Context(), id, payee, req.Amount, req.RequestId)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Location", "/api/v2/transfers/"+t.ID.String())
+	c.JSON(http.StatusCreated, transferResponse(t))
+}
+
+// SuggestPayees godoc
+// @Summary Suggest payees
+// @Description Suggest the wallets the owner sent money to in the last 90 days and has not saved as payees,
+// @Description most frequently paid first.
+// @Tags payees
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.PayeeSuggestionListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/wallets/{id}/payees/suggestions [get]
+func (ctrl *PayeesController) SuggestPayees(c *gin.Context) {
+	id, ok := walletID(c)
+	if !ok {
+		return
+	}
+
+	suggestions, err := ctrl.transactions.SuggestPayees(c.Request.Context(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.PayeeSuggestionResponse, 0, len(suggestions))
+	for _, s := range suggestions {
+		items = append(items, responses.PayeeSuggestionResponse{
+			WalletID:       s.WalletID,
+			TransferCount:  s.TransferCount,
+			LastAmount:     s.LastAmount,
+			LastTransferAt: s.LastTransferAt,
+		})
+	}
+	c.JSON(http.StatusOK, responses.PayeeSuggestionListResponse{
+		Status:      responses.StatusSuccess,
+		Suggestions: items,
+	})
+}
+
+// payeeID reads the wallet and payee IDs of a payee route.
+func payeeID(c *gin.Context) (int, int, bool) {
+	id, ok := walletID(c)
+	if !ok {
+		return 0, 0, false
+	}
+	payee, err := strconv.Atoi(c.Param("payeeId"))
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, "invalid payee id")
+		return 0, 0, false
+	}
+	return id, payee, true
+}
+
+func payeeResponse(p *services.Payee) responses.PayeeResponse {
+	return responses.PayeeResponse{
+		ID:            p.ID,
+		WalletID:      p.WalletID,
+		Alias:         p.Alias,
+		Nickname:      p.Nickname,
+		DefaultAmount: p.DefaultAmount,
+		Memo:          p.Memo,
+		Status:        p.Status,
+		LastPaidAt:    p.LastPaidAt,
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+	}
+}
@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"errors"
+	"transactions-service/common/problems"
+	"transactions-service/messaging"
+	"transactions-service/services"
+	"transactions-service/webhooks"
+
+	"github.com/gin-gonic/gin"
+)
+
+// problemFor converts an error into a problem, handling the errors of the
+// admin endpoints before falling back to the business logic's mapping.
+func problemFor(err error) *problems.Problem {
+	switch {
+	case errors.Is(err, messaging.ErrDeadLetterNotPending):
+		return problems.New(problems.CodeDeadLetterNotPending, err.Error())
+	case errors.Is(err, webhooks.ErrEndpointNotFound):
+		return problems.New(problems.CodeWebhookNotFound, err.Error())
+	case errors.Is(err, webhooks.ErrDeliveryNotFound):
+		return problems.New(problems.CodeDeliveryNotFound, err.Error())
+	case errors.Is(err, webhooks.ErrInvalidURL),
+		errors.Is(err, webhooks.ErrUnknownEventType),
+		errors.Is(err, webhooks.ErrMissingClientName),
+		errors.Is(err, webhooks.ErrMissingWallet),
+		errors.Is(err, webhooks.ErrUnknownWallet):
+		return problems.New(problems.CodeValidationFailed, err.Error())
+	case errors.Is(err, webhooks.ErrInvalidStatus):
+		return problems.New(problems.CodeInvalidRequest, err.Error())
+	default:
+		return services.ProblemFor(err)
+	}
+}
+
+func writeProblem(c *gin.Context, err error) {
+	problems.WriteProblem(c, problemFor(err))
+}
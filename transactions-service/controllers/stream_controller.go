@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"shared/messages"
+	"strconv"
+	"time"
+	"transactions-service/common/problems"
+	"transactions-service/ent"
+	"transactions-service/services"
+	"transactions-service/stream"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	streamKeepAlive = 15 * time.Second
+	// streamRetry is the reconnection delay suggested to clients, in milliseconds.
+	streamRetry = 3000
+)
+
+type StreamController struct {
+	client       *ent.Client
+	transactions *services.TransactionsService
+	hub          *stream.Hub
+}
+
+func NewStreamController(client *ent.Client, transactions *services.TransactionsService, hub *stream.Hub) *StreamController {
+	return &StreamController{client: client, transactions: transactions, hub: hub}
+}
+
+// StreamWalletEvents godoc
+// @Summary Stream the events of a wallet
+// @Description Push balance-changed and transaction-created events of a wallet as Server-Sent Events.
+// @Description Each event's id is its sequence. A reconnecting client sends the last id it received in
+// @Description the Last-Event-ID header (or the last_event_id query parameter) and first receives the
+// @Description events it missed; a new stream starts with the wallet's latest balance-changed event.
+// @Tags wallets
+// @Produce text/event-stream
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID (the ID of its user)"
+// @Param Last-Event-ID header int false "Sequence of the last event received"
+// @Param last_event_id query int false "Sequence of the last event received, for clients that cannot set headers"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} messages.Event
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/wallets/{id}/events [get]
+func (ctrl *StreamController) StreamWalletEvents(c *gin.Context) {
+	id, ok := walletID(c)
+	if !ok {
+		return
+	}
+
+	lastEventID, resume, err := lastEventID(c)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, err := ctrl.transactions.GetWallet(ctx, id); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	// Subscribe before reading stored events, so no event falls between them;
+	// events received twice are skipped by sequence.
+	sub := ctrl.hub.Subscribe(id)
+	defer sub.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", streamRetry)
+	c.Writer.Flush()
+
+	sent := lastEventID
+	send := func(e messages.Event) error {
+		if e.Sequence <= sent {
+			return nil
+		}
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", e.Sequence, e.Type, data); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		sent = e.Sequence
+		return nil
+	}
+
+	if resume {
+		err = stream.Replay(ctx, ctrl.client, id, lastEventID, send)
+	} else {
+		var latest messages.Event
+		if latest, ok, err = stream.Latest(ctx, ctrl.client, id); err == nil && ok {
+			err = send(latest)
+		}
+	}
+	if err != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-sub.C:
+			if !ok {
+				// Dropped for falling behind; the client resumes from its last event.
+				return
+			}
+			if err := send(e); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(c.Writer, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// lastEventID reads the sequence a client resumes from and reports whether it resumes.
+func lastEventID(c *gin.Context) (int, bool, error) {
+	v := c.GetHeader("Last-Event-ID")
+	if v == "" {
+		v = c.Query("last_event_id")
+	}
+	if v == "" {
+		return 0, false, nil
+	}
+
+	id, err := strconv.Atoi(v)
+	if err != nil || id < 0 {
+		return 0, false, fmt.Errorf("last event id must be a non-negative integer")
+	}
+	return id, true, nil
+}
@@ -0,0 +1,291 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"shared/auth"
+	"shared/messages"
+	"strconv"
+	"transactions-service/common/problems"
+	"transactions-service/common/requests"
+	"transactions-service/common/responses"
+	"transactions-service/ent"
+	"transactions-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultTransferPageSize = 50
+	maxTransferPageSize     = 500
+)
+
+// WalletsController serves the resource-oriented v2 API. A wallet is
+// identified by the ID of the user owning it and a transfer by the request ID
+// it was made with.
+type WalletsController struct {
+	transactions *services.TransactionsService
+}
+
+func NewWalletsController(transactions *services.TransactionsService) *WalletsController {
+	return &WalletsController{transactions: transactions}
+}
+
+// GetWallet godoc
+// @Summary Get a wallet
+// @Description Get the balance of the wallet owned by a user
+// @Tags wallets
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID (the ID of its user)"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.WalletResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/wallets/{id} [get]
+func (ctrl *WalletsController) GetWallet(c *gin.Context) {
+	id, ok := walletID(c)
+	if !ok {
+		return
+	}
+
+	u, err := ctrl.transactions.GetWallet(context.Background(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, walletResponse(u))
+}
+
+// Deposit godoc
+// @Summary Deposit money into a wallet
+// @Description Credit an amount to a wallet. Repeating a request ID is rejected with 409.
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID (the ID of its user)"
+// @Param request body requests.DepositRequest true "Deposit"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.WalletResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/wallets/{id}/deposits [post]
+func (ctrl *WalletsController) Deposit(c *gin.Context) {
+	id, ok := walletID(c)
+	if !ok {
+		return
+	}
+
+	var req requests.DepositRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	u, err := ctrl.transactions.AddMoney(context.Background(), id, req.Amount, req.RequestId)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, walletResponse(u))
+}
+
+// ListTransfers godoc
+// @Summary List the transfers of a wallet
+// @Description List the transfers sent or received by a wallet, newest first
+// @Tags wallets
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Wallet ID (the ID of its user)"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.TransferListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/wallets/{id}/transfers [get]
+func (ctrl *WalletsController) ListTransfers(c *gin.Context) {
+	id, ok := walletID(c)
+	if !ok {
+		return
+	}
+
+	limit, offset, err := pagination(c, defaultTransferPageSize, maxTransferPageSize)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	transfers, total, err := ctrl.transactions.ListTransfers(context.Background(), id, limit, offset)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.TransferResponse, 0, len(transfers))
+	for _, t := range transfers {
+		items = append(items, transferResponse(t))
+	}
+
+	c.JSON(http.StatusOK, responses.TransferListResponse{
+		Status:    responses.StatusSuccess,
+		Transfers: items,
+		Total:     total,
+	})
+}
+
+// CreateTransfer godoc
+// @Summary Transfer money from a wallet
+// @Description Move an amount from a wallet to another one, named by its ID in to_wallet_id or by a handle,
+// @Description email or phone number of its owner in to. The request ID becomes the ID of the
+// @Description transfer; repeating it is rejected with 409. Wallets whose owner has not verified their
+// @Description email can receive transfers but not send them. Users sending more than the step-up threshold
+// @Description also send a step-up token from user-service in the X-Step-Up-Token header.
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "ID of the wallet to debit"
+// @Param request body requests.CreateTransferRequest true "Transfer"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 201 {object} responses.TransferResponse
+// @Header 201 {string} Location "URL of the created transfer"
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Failure 503 {object} problems.Problem
+// @Router /v2/wallets/{id}/transfers [post]
+func (ctrl *WalletsController) CreateTransfer(c *gin.Context) {
+	id, ok := walletID(c)
+	if !ok {
+		return
+	}
+
+	var req requests.CreateTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+	if err := ctrl.transactions.CheckStepUp(c.Request.Context(), req.Amount); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	to, err := ctrl.transactions.Recipient(c.Request.Context(), req.ToWalletID, req.To)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	t, err := ctrl.transactions.TransferMoney(context.Background(), id, to, req.Amount, req.RequestId)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Location", "/api/v2/transfers/"+t.ID.String())
+	c.JSON(http.StatusCreated, transferResponse(t))
+}
+
+// GetTransfer godoc
+// @Summary Get a transfer
+// @Description Get a transfer by the request ID it was made with. Only the owners of the two wallets
+// @Description may read it.
+// @Tags transfers
+// @Produce json
+// @Produce application/problem+json
+// @Param id path string true "Transfer ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.TransferResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/transfers/{id} [get]
+func (ctrl *WalletsController) GetTransfer(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, "transfer id must be a UUID")
+		return
+	}
+
+	t, err := ctrl.transactions.GetTransfer(context.Background(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+	if err := auth.AuthorizeUser(c.Request.Context(), t.FromWalletID); err != nil {
+		if auth.AuthorizeUser(c.Request.Context(), t.ToWalletID) != nil {
+			writeProblem(c, err)
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, transferResponse(t))
+}
+
+// walletID reads the wallet ID path parameter and checks that the caller owns
+// the wallet.
+func walletID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, "invalid wallet id")
+		return 0, false
+	}
+	if err := auth.AuthorizeUser(c.Request.Context(), id); err != nil {
+		writeProblem(c, err)
+		return 0, false
+	}
+	return id, true
+}
+
+func walletResponse(u *ent.User) responses.WalletResponse {
+	return responses.WalletResponse{
+		ID:        u.ID,
+		Email:     u.Email,
+		Balance:   u.Balance,
+		Currency:  messages.DefaultCurrency,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+func transferResponse(t *services.Transfer) responses.TransferResponse {
+	return responses.TransferResponse{
+		ID:           t.ID.String(),
+		FromWalletID: t.FromWalletID,
+		ToWalletID:   t.ToWalletID,
+		Amount:       t.Amount,
+		Currency:     messages.DefaultCurrency,
+		CreatedAt:    t.CreatedAt,
+	}
+}
@@ -0,0 +1,394 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"transactions-service/audit"
+	"transactions-service/common/problems"
+	"transactions-service/common/requests"
+	"transactions-service/common/responses"
+	"transactions-service/ent"
+	"transactions-service/webhooks"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultDeliveryPageSize = 50
+	maxDeliveryPageSize     = 500
+)
+
+type WebhooksController struct {
+	client   *ent.Client
+	webhooks *webhooks.Service
+}
+
+func NewWebhooksController(client *ent.Client, webhooks *webhooks.Service) *WebhooksController {
+	return &WebhooksController{client: client, webhooks: webhooks}
+}
+
+// RegisterWebhook godoc
+// @Summary Register a webhook endpoint
+// @Description Register an endpoint to be sent the events of a wallet. Every delivery is signed with the
+// @Description returned secret, which is not shown again. Without event types the endpoint receives every
+// @Description event of the wallet.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param request body requests.RegisterWebhookRequest true "Endpoint"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 201 {object} responses.WebhookResponse
+// @Header 201 {string} Location "URL of the registered endpoint"
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/webhooks [post]
+func (ctrl *WebhooksController) RegisterWebhook(c *gin.Context) {
+	var req requests.RegisterWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	e, err := ctrl.webhooks.Register(context.Background(), req.Client, req.WalletID, req.URL, req.EventTypes)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	recordAudit(c, ctrl.client, audit.ActionWebhookRegister, audit.TargetWebhook, strconv.Itoa(e.ID), map[string]any{
+		"client":    e.Client,
+		"wallet_id": req.WalletID,
+		"url":       e.URL,
+	})
+	resp := webhookResponse(e)
+	resp.Secret = e.Secret
+	c.Header("Location", "/api/v2/webhooks/"+strconv.Itoa(e.ID))
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListWebhooks godoc
+// @Summary List webhook endpoints
+// @Tags webhooks
+// @Produce json
+// @Produce application/problem+json
+// @Param client query string false "Only list the endpoints of this client"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.WebhookListResponse
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/webhooks [get]
+func (ctrl *WebhooksController) ListWebhooks(c *gin.Context) {
+	endpoints, err := ctrl.webhooks.List(context.Background(), c.Query("client"))
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.WebhookResponse, 0, len(endpoints))
+	for _, e := range endpoints {
+		items = append(items, webhookResponse(e))
+	}
+
+	c.JSON(http.StatusOK, responses.WebhookListResponse{
+		Status:   responses.StatusSuccess,
+		Webhooks: items,
+	})
+}
+
+// GetWebhook godoc
+// @Summary Get a webhook endpoint
+// @Tags webhooks
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Endpoint ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.WebhookResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/webhooks/{id} [get]
+func (ctrl *WebhooksController) GetWebhook(c *gin.Context) {
+	id, ok := pathID(c, "id", "invalid webhook id")
+	if !ok {
+		return
+	}
+
+	e, err := ctrl.webhooks.Get(context.Background(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookResponse(e))
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook endpoint
+// @Description Change the URL or event types of an endpoint, or disable and re-enable it.
+// @Description Re-enabling an endpoint resumes its pending deliveries.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Endpoint ID"
+// @Param request body requests.UpdateWebhookRequest true "Fields to change"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.WebhookResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/webhooks/{id} [patch]
+func (ctrl *WebhooksController) UpdateWebhook(c *gin.Context) {
+	id, ok := pathID(c, "id", "invalid webhook id")
+	if !ok {
+		return
+	}
+
+	var req requests.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	e, err := ctrl.webhooks.Update(context.Background(), id, webhooks.EndpointUpdate{
+		URL:        req.URL,
+		EventTypes: req.EventTypes,
+		Enabled:    req.Enabled,
+	})
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+	recordAudit(c, ctrl.client, audit.ActionWebhookUpdate, audit.TargetWebhook, strconv.Itoa(id), map[string]any{
+		"url":         e.URL,
+		"event_types": e.EventTypes,
+		"enabled":     e.Enabled,
+	})
+
+	c.JSON(http.StatusOK, webhookResponse(e))
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook endpoint
+// @Description Delete an endpoint together with its delivery log
+// @Tags webhooks
+// @Produce application/problem+json
+// @Param id path int true "Endpoint ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 204
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/webhooks/{id} [delete]
+func (ctrl *WebhooksController) DeleteWebhook(c *gin.Context) {
+	id, ok := pathID(c, "id", "invalid webhook id")
+	if !ok {
+		return
+	}
+
+	if err := ctrl.webhooks.Delete(context.Background(), id); err != nil {
+		writeProblem(c, err)
+		return
+	}
+	recordAudit(c, ctrl.client, audit.ActionWebhookDelete, audit.TargetWebhook, strconv.Itoa(id), nil)
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListDeliveries godoc
+// @Summary List the deliveries of a webhook endpoint
+// @Description List the delivery log of an endpoint, newest first
+// @Tags webhooks
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Endpoint ID"
+// @Param status query string false "Filter by status (pending, succeeded, failed)"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.WebhookDeliveryListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/webhooks/{id}/deliveries [get]
+func (ctrl *WebhooksController) ListDeliveries(c *gin.Context) {
+	id, ok := pathID(c, "id", "invalid webhook id")
+	if !ok {
+		return
+	}
+
+	limit, offset, err := pagination(c, defaultDeliveryPageSize, maxDeliveryPageSize)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	deliveries, total, err := ctrl.webhooks.Deliveries(context.Background(), id, c.Query("status"), limit, offset)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		items = append(items, deliveryResponse(d))
+	}
+
+	c.JSON(http.StatusOK, responses.WebhookDeliveryListResponse{
+		Status:     responses.StatusSuccess,
+		Deliveries: items,
+		Total:      total,
+	})
+}
+
+// GetDelivery godoc
+// @Summary Get a webhook delivery
+// @Description Get a delivery with its payload, attempt count and last outcome
+// @Tags webhooks
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Endpoint ID"
+// @Param deliveryId path int true "Delivery ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.WebhookDeliveryResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/webhooks/{id}/deliveries/{deliveryId} [get]
+func (ctrl *WebhooksController) GetDelivery(c *gin.Context) {
+	id, ok := pathID(c, "id", "invalid webhook id")
+	if !ok {
+		return
+	}
+	deliveryID, ok := pathID(c, "deliveryId", "invalid delivery id")
+	if !ok {
+		return
+	}
+
+	d, err := ctrl.webhooks.GetDelivery(context.Background(), id, deliveryID)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveryResponse(d))
+}
+
+// RedeliverDelivery godoc
+// @Summary Redeliver a webhook delivery
+// @Description Queue the event of a delivery to be sent to its endpoint again as a new delivery
+// @Tags webhooks
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "Endpoint ID"
+// @Param deliveryId path int true "Delivery ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 201 {object} responses.WebhookDeliveryResponse
+// @Header 201 {string} Location "URL of the new delivery"
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func (ctrl *WebhooksController) RedeliverDelivery(c *gin.Context) {
+	id, ok := pathID(c, "id", "invalid webhook id")
+	if !ok {
+		return
+	}
+	deliveryID, ok := pathID(c, "deliveryId", "invalid delivery id")
+	if !ok {
+		return
+	}
+
+	d, err := ctrl.webhooks.Redeliver(context.Background(), id, deliveryID)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+	recordAudit(c, ctrl.client, audit.ActionWebhookRedeliver, audit.TargetWebhook, strconv.Itoa(id), map[string]any{
+		"delivery_id": deliveryID,
+	})
+
+	c.Header("Location", "/api/v2/webhooks/"+strconv.Itoa(d.EndpointID)+"/deliveries/"+strconv.Itoa(d.ID))
+	c.JSON(http.StatusCreated, deliveryResponse(d))
+}
+
+// pathID reads an integer path parameter.
+func pathID(c *gin.Context, param string, message string) (int, bool) {
+	id, err := strconv.Atoi(c.Param(param))
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, message)
+		return 0, false
+	}
+	return id, true
+}
+
+func webhookResponse(e *ent.WebhookEndpoint) responses.WebhookResponse {
+	eventTypes := e.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	return responses.WebhookResponse{
+		ID:                  e.ID,
+		Client:              e.Client,
+		WalletID:            e.WalletID,
+		URL:                 e.URL,
+		EventTypes:          eventTypes,
+		Enabled:             e.Enabled,
+		ConsecutiveFailures: e.ConsecutiveFailures,
+		DisabledAt:          e.DisabledAt,
+		CreatedAt:           e.CreatedAt,
+		UpdatedAt:           e.UpdatedAt,
+	}
+}
+
+func deliveryResponse(d *ent.WebhookDelivery) responses.WebhookDeliveryResponse {
+	return responses.WebhookDeliveryResponse{
+		ID:             d.ID,
+		EndpointID:     d.EndpointID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        string(d.Payload),
+		Status:         d.Status.String(),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		Error:          d.Error,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+	}
+}
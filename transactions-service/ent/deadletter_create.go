@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactions-service/ent/deadletter"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeadLetterCreate is the builder for creating a DeadLetter entity.
+type DeadLetterCreate struct {
+	config
+	mutation *DeadLetterMutation
+	hooks    []Hook
+}
+
+// SetSubject sets the "subject" field.
+func (dlc *DeadLetterCreate) SetSubject(s string) *DeadLetterCreate {
+	dlc.mutation.SetSubject(s)
+	return dlc
+}
+
+// SetData sets the "data" field.
+func (dlc *DeadLetterCreate) SetData(b []byte) *DeadLetterCreate {
+	dlc.mutation.SetData(b)
+	return dlc
+}
+
+// SetUserID sets the "user_id" field.
+func (dlc *DeadLetterCreate) SetUserID(i int) *DeadLetterCreate {
+	dlc.mutation.SetUserID(i)
+	return dlc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableUserID(i *int) *DeadLetterCreate {
+	if i != nil {
+		dlc.SetUserID(*i)
+	}
+	return dlc
+}
+
+// SetEmail sets the "email" field.
+func (dlc *DeadLetterCreate) SetEmail(s string) *DeadLetterCreate {
+	dlc.mutation.SetEmail(s)
+	return dlc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableEmail(s *string) *DeadLetterCreate {
+	if s != nil {
+		dlc.SetEmail(*s)
+	}
+	return dlc
+}
+
+// SetError sets the "error" field.
+func (dlc *DeadLetterCreate) SetError(s string) *DeadLetterCreate {
+	dlc.mutation.SetError(s)
+	return dlc
+}
+
+// SetErrorCode sets the "error_code" field.
+func (dlc *DeadLetterCreate) SetErrorCode(s string) *DeadLetterCreate {
+	dlc.mutation.SetErrorCode(s)
+	return dlc
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableErrorCode(s *string) *DeadLetterCreate {
+	if s != nil {
+		dlc.SetErrorCode(*s)
+	}
+	return dlc
+}
+
+// SetStatus sets the "status" field.
+func (dlc *DeadLetterCreate) SetStatus(d deadletter.Status) *DeadLetterCreate {
+	dlc.mutation.SetStatus(d)
+	return dlc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableStatus(d *deadletter.Status) *DeadLetterCreate {
+	if d != nil {
+		dlc.SetStatus(*d)
+	}
+	return dlc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dlc *DeadLetterCreate) SetCreatedAt(t time.Time) *DeadLetterCreate {
+	dlc.mutation.SetCreatedAt(t)
+	return dlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableCreatedAt(t *time.Time) *DeadLetterCreate {
+	if t != nil {
+		dlc.SetCreatedAt(*t)
+	}
+	return dlc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dlc *DeadLetterCreate) SetUpdatedAt(t time.Time) *DeadLetterCreate {
+	dlc.mutation.SetUpdatedAt(t)
+	return dlc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableUpdatedAt(t *time.Time) *DeadLetterCreate {
+	if t != nil {
+		dlc.SetUpdatedAt(*t)
+	}
+	return dlc
+}
+
+// SetID sets the "id" field.
+func (dlc *DeadLetterCreate) SetID(i int) *DeadLetterCreate {
+	dlc.mutation.SetID(i)
+	return dlc
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dlc *DeadLetterCreate) Mutation() *DeadLetterMutation {
+	return dlc.mutation
+}
+
+// Save creates the DeadLetter in the database.
+func (dlc *DeadLetterCreate) Save(ctx context.Context) (*DeadLetter, error) {
+	dlc.defaults()
+	return withHooks(ctx, dlc.sqlSave, dlc.mutation, dlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dlc *DeadLetterCreate) SaveX(ctx context.Context) *DeadLetter {
+	v, err := dlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dlc *DeadLetterCreate) Exec(ctx context.Context) error {
+	_, err := dlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlc *DeadLetterCreate) ExecX(ctx context.Context) {
+	if err := dlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dlc *DeadLetterCreate) defaults() {
+	if _, ok := dlc.mutation.Status(); !ok {
+		v := deadletter.DefaultStatus
+		dlc.mutation.SetStatus(v)
+	}
+	if _, ok := dlc.mutation.CreatedAt(); !ok {
+		v := deadletter.DefaultCreatedAt()
+		dlc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dlc.mutation.UpdatedAt(); !ok {
+		v := deadletter.DefaultUpdatedAt()
+		dlc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dlc *DeadLetterCreate) check() error {
+	if _, ok := dlc.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "DeadLetter.subject"`)}
+	}
+	if _, ok := dlc.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "DeadLetter.data"`)}
+	}
+	if _, ok := dlc.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "DeadLetter.error"`)}
+	}
+	if _, ok := dlc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DeadLetter.status"`)}
+	}
+	if v, ok := dlc.mutation.Status(); ok {
+		if err := deadletter.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.status": %w`, err)}
+		}
+	}
+	if _, ok := dlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeadLetter.created_at"`)}
+	}
+	if _, ok := dlc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeadLetter.updated_at"`)}
+	}
+	return nil
+}
+
+func (dlc *DeadLetterCreate) sqlSave(ctx context.Context) (*DeadLetter, error) {
+	if err := dlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	dlc.mutation.id = &_node.ID
+	dlc.mutation.done = true
+	return _node, nil
+}
+
+func (dlc *DeadLetterCreate) createSpec() (*DeadLetter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeadLetter{config: dlc.config}
+		_spec = sqlgraph.NewCreateSpec(deadletter.Table, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeInt))
+	)
+	if id, ok := dlc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dlc.mutation.Subject(); ok {
+		_spec.SetField(deadletter.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := dlc.mutation.Data(); ok {
+		_spec.SetField(deadletter.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	if value, ok := dlc.mutation.UserID(); ok {
+		_spec.SetField(deadletter.FieldUserID, field.TypeInt, value)
+		_node.UserID = &value
+	}
+	if value, ok := dlc.mutation.Email(); ok {
+		_spec.SetField(deadletter.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := dlc.mutation.Error(); ok {
+		_spec.SetField(deadletter.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := dlc.mutation.ErrorCode(); ok {
+		_spec.SetField(deadletter.FieldErrorCode, field.TypeString, value)
+		_node.ErrorCode = value
+	}
+	if value, ok := dlc.mutation.Status(); ok {
+		_spec.SetField(deadletter.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dlc.mutation.CreatedAt(); ok {
+		_spec.SetField(deadletter.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dlc.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// DeadLetterCreateBulk is the builder for creating many DeadLetter entities in bulk.
+type DeadLetterCreateBulk struct {
+	config
+	err      error
+	builders []*DeadLetterCreate
+}
+
+// Save creates the DeadLetter entities in the database.
+func (dlcb *DeadLetterCreateBulk) Save(ctx context.Context) ([]*DeadLetter, error) {
+	if dlcb.err != nil {
+		return nil, dlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dlcb.builders))
+	nodes := make([]*DeadLetter, len(dlcb.builders))
+	mutators := make([]Mutator, len(dlcb.builders))
+	for i := range dlcb.builders {
+		func(i int, root context.Context) {
+			builder := dlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeadLetterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlcb *DeadLetterCreateBulk) SaveX(ctx context.Context) []*DeadLetter {
+	v, err := dlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dlcb *DeadLetterCreateBulk) Exec(ctx context.Context) error {
+	_, err := dlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlcb *DeadLetterCreateBulk) ExecX(ctx context.Context) {
+	if err := dlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactions-service/ent/deadletter"
+	"transactions-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeadLetterUpdate is the builder for updating DeadLetter entities.
+type DeadLetterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeadLetterMutation
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (dlu *DeadLetterUpdate) Where(ps ...predicate.DeadLetter) *DeadLetterUpdate {
+	dlu.mutation.Where(ps...)
+	return dlu
+}
+
+// SetData sets the "data" field.
+func (dlu *DeadLetterUpdate) SetData(b []byte) *DeadLetterUpdate {
+	dlu.mutation.SetData(b)
+	return dlu
+}
+
+// SetError sets the "error" field.
+func (dlu *DeadLetterUpdate) SetError(s string) *DeadLetterUpdate {
+	dlu.mutation.SetError(s)
+	return dlu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableError(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetError(*s)
+	}
+	return dlu
+}
+
+// SetErrorCode sets the "error_code" field.
+func (dlu *DeadLetterUpdate) SetErrorCode(s string) *DeadLetterUpdate {
+	dlu.mutation.SetErrorCode(s)
+	return dlu
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableErrorCode(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetErrorCode(*s)
+	}
+	return dlu
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (dlu *DeadLetterUpdate) ClearErrorCode() *DeadLetterUpdate {
+	dlu.mutation.ClearErrorCode()
+	return dlu
+}
+
+// SetStatus sets the "status" field.
+func (dlu *DeadLetterUpdate) SetStatus(d deadletter.Status) *DeadLetterUpdate {
+	dlu.mutation.SetStatus(d)
+	return dlu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableStatus(d *deadletter.Status) *DeadLetterUpdate {
+	if d != nil {
+		dlu.SetStatus(*d)
+	}
+	return dlu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dlu *DeadLetterUpdate) SetUpdatedAt(t time.Time) *DeadLetterUpdate {
+	dlu.mutation.SetUpdatedAt(t)
+	return dlu
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dlu *DeadLetterUpdate) Mutation() *DeadLetterMutation {
+	return dlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dlu *DeadLetterUpdate) Save(ctx context.Context) (int, error) {
+	dlu.defaults()
+	return withHooks(ctx, dlu.sqlSave, dlu.mutation, dlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlu *DeadLetterUpdate) SaveX(ctx context.Context) int {
+	affected, err := dlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dlu *DeadLetterUpdate) Exec(ctx context.Context) error {
+	_, err := dlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlu *DeadLetterUpdate) ExecX(ctx context.Context) {
+	if err := dlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dlu *DeadLetterUpdate) defaults() {
+	if _, ok := dlu.mutation.UpdatedAt(); !ok {
+		v := deadletter.UpdateDefaultUpdatedAt()
+		dlu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dlu *DeadLetterUpdate) check() error {
+	if v, ok := dlu.mutation.Status(); ok {
+		if err := deadletter.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dlu *DeadLetterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dlu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeInt))
+	if ps := dlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dlu.mutation.Data(); ok {
+		_spec.SetField(deadletter.FieldData, field.TypeBytes, value)
+	}
+	if dlu.mutation.UserIDCleared() {
+		_spec.ClearField(deadletter.FieldUserID, field.TypeInt)
+	}
+	if dlu.mutation.EmailCleared() {
+		_spec.ClearField(deadletter.FieldEmail, field.TypeString)
+	}
+	if value, ok := dlu.mutation.Error(); ok {
+		_spec.SetField(deadletter.FieldError, field.TypeString, value)
+	}
+	if value, ok := dlu.mutation.ErrorCode(); ok {
+		_spec.SetField(deadletter.FieldErrorCode, field.TypeString, value)
+	}
+	if dlu.mutation.ErrorCodeCleared() {
+		_spec.ClearField(deadletter.FieldErrorCode, field.TypeString)
+	}
+	if value, ok := dlu.mutation.Status(); ok {
+		_spec.SetField(deadletter.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dlu.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dlu.mutation.done = true
+	return n, nil
+}
+
+// DeadLetterUpdateOne is the builder for updating a single DeadLetter entity.
+type DeadLetterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeadLetterMutation
+}
+
+// SetData sets the "data" field.
+func (dluo *DeadLetterUpdateOne) SetData(b []byte) *DeadLetterUpdateOne {
+	dluo.mutation.SetData(b)
+	return dluo
+}
+
+// SetError sets the "error" field.
+func (dluo *DeadLetterUpdateOne) SetError(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetError(s)
+	return dluo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableError(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetError(*s)
+	}
+	return dluo
+}
+
+// SetErrorCode sets the "error_code" field.
+func (dluo *DeadLetterUpdateOne) SetErrorCode(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetErrorCode(s)
+	return dluo
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableErrorCode(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetErrorCode(*s)
+	}
+	return dluo
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (dluo *DeadLetterUpdateOne) ClearErrorCode() *DeadLetterUpdateOne {
+	dluo.mutation.ClearErrorCode()
+	return dluo
+}
+
+// SetStatus sets the "status" field.
+func (dluo *DeadLetterUpdateOne) SetStatus(d deadletter.Status) *DeadLetterUpdateOne {
+	dluo.mutation.SetStatus(d)
+	return dluo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableStatus(d *deadletter.Status) *DeadLetterUpdateOne {
+	if d != nil {
+		dluo.SetStatus(*d)
+	}
+	return dluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dluo *DeadLetterUpdateOne) SetUpdatedAt(t time.Time) *DeadLetterUpdateOne {
+	dluo.mutation.SetUpdatedAt(t)
+	return dluo
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dluo *DeadLetterUpdateOne) Mutation() *DeadLetterMutation {
+	return dluo.mutation
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (dluo *DeadLetterUpdateOne) Where(ps ...predicate.DeadLetter) *DeadLetterUpdateOne {
+	dluo.mutation.Where(ps...)
+	return dluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dluo *DeadLetterUpdateOne) Select(field string, fields ...string) *DeadLetterUpdateOne {
+	dluo.fields = append([]string{field}, fields...)
+	return dluo
+}
+
+// Save executes the query and returns the updated DeadLetter entity.
+func (dluo *DeadLetterUpdateOne) Save(ctx context.Context) (*DeadLetter, error) {
+	dluo.defaults()
+	return withHooks(ctx, dluo.sqlSave, dluo.mutation, dluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dluo *DeadLetterUpdateOne) SaveX(ctx context.Context) *DeadLetter {
+	node, err := dluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dluo *DeadLetterUpdateOne) Exec(ctx context.Context) error {
+	_, err := dluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dluo *DeadLetterUpdateOne) ExecX(ctx context.Context) {
+	if err := dluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dluo *DeadLetterUpdateOne) defaults() {
+	if _, ok := dluo.mutation.UpdatedAt(); !ok {
+		v := deadletter.UpdateDefaultUpdatedAt()
+		dluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dluo *DeadLetterUpdateOne) check() error {
+	if v, ok := dluo.mutation.Status(); ok {
+		if err := deadletter.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dluo *DeadLetterUpdateOne) sqlSave(ctx context.Context) (_node *DeadLetter, err error) {
+	if err := dluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeInt))
+	id, ok := dluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeadLetter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deadletter.FieldID)
+		for _, f := range fields {
+			if !deadletter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deadletter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dluo.mutation.Data(); ok {
+		_spec.SetField(deadletter.FieldData, field.TypeBytes, value)
+	}
+	if dluo.mutation.UserIDCleared() {
+		_spec.ClearField(deadletter.FieldUserID, field.TypeInt)
+	}
+	if dluo.mutation.EmailCleared() {
+		_spec.ClearField(deadletter.FieldEmail, field.TypeString)
+	}
+	if value, ok := dluo.mutation.Error(); ok {
+		_spec.SetField(deadletter.FieldError, field.TypeString, value)
+	}
+	if value, ok := dluo.mutation.ErrorCode(); ok {
+		_spec.SetField(deadletter.FieldErrorCode, field.TypeString, value)
+	}
+	if dluo.mutation.ErrorCodeCleared() {
+		_spec.ClearField(deadletter.FieldErrorCode, field.TypeString)
+	}
+	if value, ok := dluo.mutation.Status(); ok {
+		_spec.SetField(deadletter.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &DeadLetter{config: dluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dluo.mutation.done = true
+	return _node, nil
+}
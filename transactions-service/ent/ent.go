@@ -8,8 +8,16 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"transactions-service/ent/auditlog"
+	"transactions-service/ent/deadletter"
+	"transactions-service/ent/event"
+	"transactions-service/ent/lease"
+	"transactions-service/ent/payee"
+	"transactions-service/ent/ratelimitstate"
 	"transactions-service/ent/transaction"
 	"transactions-service/ent/user"
+	"transactions-service/ent/webhookdelivery"
+	"transactions-service/ent/webhookendpoint"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -74,8 +82,16 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			transaction.Table: transaction.ValidColumn,
-			user.Table:        user.ValidColumn,
+			auditlog.Table:        auditlog.ValidColumn,
+			deadletter.Table:      deadletter.ValidColumn,
+			event.Table:           event.ValidColumn,
+			lease.Table:           lease.ValidColumn,
+			payee.Table:           payee.ValidColumn,
+			ratelimitstate.Table:  ratelimitstate.ValidColumn,
+			transaction.Table:     transaction.ValidColumn,
+			user.Table:            user.ValidColumn,
+			webhookdelivery.Table: webhookdelivery.ValidColumn,
+			webhookendpoint.Table: webhookendpoint.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactions-service/ent/event"
+	"transactions-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventUpdate is the builder for updating Event entities.
+type EventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// Where appends a list predicates to the EventUpdate builder.
+func (eu *EventUpdate) Where(ps ...predicate.Event) *EventUpdate {
+	eu.mutation.Where(ps...)
+	return eu
+}
+
+// SetData sets the "data" field.
+func (eu *EventUpdate) SetData(b []byte) *EventUpdate {
+	eu.mutation.SetData(b)
+	return eu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (eu *EventUpdate) SetPublishedAt(t time.Time) *EventUpdate {
+	eu.mutation.SetPublishedAt(t)
+	return eu
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (eu *EventUpdate) SetNillablePublishedAt(t *time.Time) *EventUpdate {
+	if t != nil {
+		eu.SetPublishedAt(*t)
+	}
+	return eu
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (eu *EventUpdate) ClearPublishedAt() *EventUpdate {
+	eu.mutation.ClearPublishedAt()
+	return eu
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (eu *EventUpdate) SetDispatchedAt(t time.Time) *EventUpdate {
+	eu.mutation.SetDispatchedAt(t)
+	return eu
+}
+
+// SetNillableDispatchedAt sets the "dispatched_at" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableDispatchedAt(t *time.Time) *EventUpdate {
+	if t != nil {
+		eu.SetDispatchedAt(*t)
+	}
+	return eu
+}
+
+// ClearDispatchedAt clears the value of the "dispatched_at" field.
+func (eu *EventUpdate) ClearDispatchedAt() *EventUpdate {
+	eu.mutation.ClearDispatchedAt()
+	return eu
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (eu *EventUpdate) Mutation() *EventMutation {
+	return eu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eu *EventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eu.sqlSave, eu.mutation, eu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *EventUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *EventUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *EventUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eu *EventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(event.Table, event.Columns, sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt))
+	if ps := eu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.Data(); ok {
+		_spec.SetField(event.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := eu.mutation.PublishedAt(); ok {
+		_spec.SetField(event.FieldPublishedAt, field.TypeTime, value)
+	}
+	if eu.mutation.PublishedAtCleared() {
+		_spec.ClearField(event.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := eu.mutation.DispatchedAt(); ok {
+		_spec.SetField(event.FieldDispatchedAt, field.TypeTime, value)
+	}
+	if eu.mutation.DispatchedAtCleared() {
+		_spec.ClearField(event.FieldDispatchedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eu.mutation.done = true
+	return n, nil
+}
+
+// EventUpdateOne is the builder for updating a single Event entity.
+type EventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// SetData sets the "data" field.
+func (euo *EventUpdateOne) SetData(b []byte) *EventUpdateOne {
+	euo.mutation.SetData(b)
+	return euo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (euo *EventUpdateOne) SetPublishedAt(t time.Time) *EventUpdateOne {
+	euo.mutation.SetPublishedAt(t)
+	return euo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillablePublishedAt(t *time.Time) *EventUpdateOne {
+	if t != nil {
+		euo.SetPublishedAt(*t)
+	}
+	return euo
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (euo *EventUpdateOne) ClearPublishedAt() *EventUpdateOne {
+	euo.mutation.ClearPublishedAt()
+	return euo
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (euo *EventUpdateOne) SetDispatchedAt(t time.Time) *EventUpdateOne {
+	euo.mutation.SetDispatchedAt(t)
+	return euo
+}
+
+// SetNillableDispatchedAt sets the "dispatched_at" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableDispatchedAt(t *time.Time) *EventUpdateOne {
+	if t != nil {
+		euo.SetDispatchedAt(*t)
+	}
+	return euo
+}
+
+// ClearDispatchedAt clears the value of the "dispatched_at" field.
+func (euo *EventUpdateOne) ClearDispatchedAt() *EventUpdateOne {
+	euo.mutation.ClearDispatchedAt()
+	return euo
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (euo *EventUpdateOne) Mutation() *EventMutation {
+	return euo.mutation
+}
+
+// Where appends a list predicates to the EventUpdate builder.
+func (euo *EventUpdateOne) Where(ps ...predicate.Event) *EventUpdateOne {
+	euo.mutation.Where(ps...)
+	return euo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *EventUpdateOne) Select(field string, fields ...string) *EventUpdateOne {
+	euo.fields = append([]string{field}, fields...)
+	return euo
+}
+
+// Save executes the query and returns the updated Event entity.
+func (euo *EventUpdateOne) Save(ctx context.Context) (*Event, error) {
+	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *EventUpdateOne) SaveX(ctx context.Context) *Event {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *EventUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *EventUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (euo *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error) {
+	_spec := sqlgraph.NewUpdateSpec(event.Table, event.Columns, sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt))
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Event.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, event.FieldID)
+		for _, f := range fields {
+			if !event.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != event.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.Data(); ok {
+		_spec.SetField(event.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := euo.mutation.PublishedAt(); ok {
+		_spec.SetField(event.FieldPublishedAt, field.TypeTime, value)
+	}
+	if euo.mutation.PublishedAtCleared() {
+		_spec.ClearField(event.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := euo.mutation.DispatchedAt(); ok {
+		_spec.SetField(event.FieldDispatchedAt, field.TypeTime, value)
+	}
+	if euo.mutation.DispatchedAtCleared() {
+		_spec.ClearField(event.FieldDispatchedAt, field.TypeTime)
+	}
+	_node = &Event{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	euo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,38 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (t *Transaction) User(ctx context.Context) (*User, error) {
+	result, err := t.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QueryUser().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (u *User) Transactions(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TransactionOrder, where *TransactionWhereInput,
+) (*TransactionConnection, error) {
+	opts := []TransactionPaginateOption{
+		WithTransactionOrder(orderBy),
+		WithTransactionFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := u.Edges.totalCount[0][alias]
+	if nodes, err := u.NamedTransactions(alias); err == nil || hasTotalCount {
+		pager, err := newTransactionPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &TransactionConnection{Edges: []*TransactionEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return u.QueryTransactions().Paginate(ctx, after, first, before, last, opts...)
+}
@@ -0,0 +1,778 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"transactions-service/ent/predicate"
+	"transactions-service/ent/transaction"
+	"transactions-service/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// TransactionWhereInput represents a where input for filtering Transaction queries.
+type TransactionWhereInput struct {
+	Predicates []predicate.Transaction  `json:"-"`
+	Not        *TransactionWhereInput   `json:"not,omitempty"`
+	Or         []*TransactionWhereInput `json:"or,omitempty"`
+	And        []*TransactionWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "amount" field predicates.
+	Amount      *float64  `json:"amount,omitempty"`
+	AmountNEQ   *float64  `json:"amountNEQ,omitempty"`
+	AmountIn    []float64 `json:"amountIn,omitempty"`
+	AmountNotIn []float64 `json:"amountNotIn,omitempty"`
+	AmountGT    *float64  `json:"amountGT,omitempty"`
+	AmountGTE   *float64  `json:"amountGTE,omitempty"`
+	AmountLT    *float64  `json:"amountLT,omitempty"`
+	AmountLTE   *float64  `json:"amountLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "type" field predicates.
+	Type      *transaction.Type  `json:"type,omitempty"`
+	TypeNEQ   *transaction.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []transaction.Type `json:"typeIn,omitempty"`
+	TypeNotIn []transaction.Type `json:"typeNotIn,omitempty"`
+
+	// "request_id" field predicates.
+	RequestID      *uuid.UUID  `json:"requestID,omitempty"`
+	RequestIDNEQ   *uuid.UUID  `json:"requestIDNEQ,omitempty"`
+	RequestIDIn    []uuid.UUID `json:"requestIDIn,omitempty"`
+	RequestIDNotIn []uuid.UUID `json:"requestIDNotIn,omitempty"`
+	RequestIDGT    *uuid.UUID  `json:"requestIDGT,omitempty"`
+	RequestIDGTE   *uuid.UUID  `json:"requestIDGTE,omitempty"`
+	RequestIDLT    *uuid.UUID  `json:"requestIDLT,omitempty"`
+	RequestIDLTE   *uuid.UUID  `json:"requestIDLTE,omitempty"`
+
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *TransactionWhereInput) AddPredicates(predicates ...predicate.Transaction) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the TransactionWhereInput filter on the TransactionQuery builder.
+func (i *TransactionWhereInput) Filter(q *TransactionQuery) (*TransactionQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyTransactionWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyTransactionWhereInput is returned in case the TransactionWhereInput is empty.
+var ErrEmptyTransactionWhereInput = errors.New("ent: empty predicate TransactionWhereInput")
+
+// P returns a predicate for filtering transactions.
+// An error is returned if the input is empty or invalid.
+func (i *TransactionWhereInput) P() (predicate.Transaction, error) {
+	var predicates []predicate.Transaction
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, transaction.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Transaction, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, transaction.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Transaction, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, transaction.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, transaction.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, transaction.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, transaction.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, transaction.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, transaction.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, transaction.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, transaction.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, transaction.IDLTE(*i.IDLTE))
+	}
+	if i.Amount != nil {
+		predicates = append(predicates, transaction.AmountEQ(*i.Amount))
+	}
+	if i.AmountNEQ != nil {
+		predicates = append(predicates, transaction.AmountNEQ(*i.AmountNEQ))
+	}
+	if len(i.AmountIn) > 0 {
+		predicates = append(predicates, transaction.AmountIn(i.AmountIn...))
+	}
+	if len(i.AmountNotIn) > 0 {
+		predicates = append(predicates, transaction.AmountNotIn(i.AmountNotIn...))
+	}
+	if i.AmountGT != nil {
+		predicates = append(predicates, transaction.AmountGT(*i.AmountGT))
+	}
+	if i.AmountGTE != nil {
+		predicates = append(predicates, transaction.AmountGTE(*i.AmountGTE))
+	}
+	if i.AmountLT != nil {
+		predicates = append(predicates, transaction.AmountLT(*i.AmountLT))
+	}
+	if i.AmountLTE != nil {
+		predicates = append(predicates, transaction.AmountLTE(*i.AmountLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, transaction.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, transaction.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, transaction.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, transaction.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, transaction.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, transaction.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, transaction.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, transaction.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.Type != nil {
+		predicates = append(predicates, transaction.TypeEQ(*i.Type))
+	}
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, transaction.TypeNEQ(*i.TypeNEQ))
+	}
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, transaction.TypeIn(i.TypeIn...))
+	}
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, transaction.TypeNotIn(i.TypeNotIn...))
+	}
+	if i.RequestID != nil {
+		predicates = append(predicates, transaction.RequestIDEQ(*i.RequestID))
+	}
+	if i.RequestIDNEQ != nil {
+		predicates = append(predicates, transaction.RequestIDNEQ(*i.RequestIDNEQ))
+	}
+	if len(i.RequestIDIn) > 0 {
+		predicates = append(predicates, transaction.RequestIDIn(i.RequestIDIn...))
+	}
+	if len(i.RequestIDNotIn) > 0 {
+		predicates = append(predicates, transaction.RequestIDNotIn(i.RequestIDNotIn...))
+	}
+	if i.RequestIDGT != nil {
+		predicates = append(predicates, transaction.RequestIDGT(*i.RequestIDGT))
+	}
+	if i.RequestIDGTE != nil {
+		predicates = append(predicates, transaction.RequestIDGTE(*i.RequestIDGTE))
+	}
+	if i.RequestIDLT != nil {
+		predicates = append(predicates, transaction.RequestIDLT(*i.RequestIDLT))
+	}
+	if i.RequestIDLTE != nil {
+		predicates = append(predicates, transaction.RequestIDLTE(*i.RequestIDLTE))
+	}
+
+	if i.HasUser != nil {
+		p := transaction.HasUser()
+		if !*i.HasUser {
+			p = transaction.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, transaction.HasUserWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyTransactionWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return transaction.And(predicates...), nil
+	}
+}
+
+// UserWhereInput represents a where input for filtering User queries.
+type UserWhereInput struct {
+	Predicates []predicate.User  `json:"-"`
+	Not        *UserWhereInput   `json:"not,omitempty"`
+	Or         []*UserWhereInput `json:"or,omitempty"`
+	And        []*UserWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "email" field predicates.
+	Email             *string  `json:"email,omitempty"`
+	EmailNEQ          *string  `json:"emailNEQ,omitempty"`
+	EmailIn           []string `json:"emailIn,omitempty"`
+	EmailNotIn        []string `json:"emailNotIn,omitempty"`
+	EmailGT           *string  `json:"emailGT,omitempty"`
+	EmailGTE          *string  `json:"emailGTE,omitempty"`
+	EmailLT           *string  `json:"emailLT,omitempty"`
+	EmailLTE          *string  `json:"emailLTE,omitempty"`
+	EmailContains     *string  `json:"emailContains,omitempty"`
+	EmailHasPrefix    *string  `json:"emailHasPrefix,omitempty"`
+	EmailHasSuffix    *string  `json:"emailHasSuffix,omitempty"`
+	EmailEqualFold    *string  `json:"emailEqualFold,omitempty"`
+	EmailContainsFold *string  `json:"emailContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "email_updated_at" field predicates.
+	EmailUpdatedAt       *time.Time  `json:"emailUpdatedAt,omitempty"`
+	EmailUpdatedAtNEQ    *time.Time  `json:"emailUpdatedAtNEQ,omitempty"`
+	EmailUpdatedAtIn     []time.Time `json:"emailUpdatedAtIn,omitempty"`
+	EmailUpdatedAtNotIn  []time.Time `json:"emailUpdatedAtNotIn,omitempty"`
+	EmailUpdatedAtGT     *time.Time  `json:"emailUpdatedAtGT,omitempty"`
+	EmailUpdatedAtGTE    *time.Time  `json:"emailUpdatedAtGTE,omitempty"`
+	EmailUpdatedAtLT     *time.Time  `json:"emailUpdatedAtLT,omitempty"`
+	EmailUpdatedAtLTE    *time.Time  `json:"emailUpdatedAtLTE,omitempty"`
+	EmailUpdatedAtIsNil  bool        `json:"emailUpdatedAtIsNil,omitempty"`
+	EmailUpdatedAtNotNil bool        `json:"emailUpdatedAtNotNil,omitempty"`
+
+	// "verified_at" field predicates.
+	VerifiedAt       *time.Time  `json:"verifiedAt,omitempty"`
+	VerifiedAtNEQ    *time.Time  `json:"verifiedAtNEQ,omitempty"`
+	VerifiedAtIn     []time.Time `json:"verifiedAtIn,omitempty"`
+	VerifiedAtNotIn  []time.Time `json:"verifiedAtNotIn,omitempty"`
+	VerifiedAtGT     *time.Time  `json:"verifiedAtGT,omitempty"`
+	VerifiedAtGTE    *time.Time  `json:"verifiedAtGTE,omitempty"`
+	VerifiedAtLT     *time.Time  `json:"verifiedAtLT,omitempty"`
+	VerifiedAtLTE    *time.Time  `json:"verifiedAtLTE,omitempty"`
+	VerifiedAtIsNil  bool        `json:"verifiedAtIsNil,omitempty"`
+	VerifiedAtNotNil bool        `json:"verifiedAtNotNil,omitempty"`
+
+	// "balance" field predicates.
+	Balance      *float64  `json:"balance,omitempty"`
+	BalanceNEQ   *float64  `json:"balanceNEQ,omitempty"`
+	BalanceIn    []float64 `json:"balanceIn,omitempty"`
+	BalanceNotIn []float64 `json:"balanceNotIn,omitempty"`
+	BalanceGT    *float64  `json:"balanceGT,omitempty"`
+	BalanceGTE   *float64  `json:"balanceGTE,omitempty"`
+	BalanceLT    *float64  `json:"balanceLT,omitempty"`
+	BalanceLTE   *float64  `json:"balanceLTE,omitempty"`
+
+	// "frozen_at" field predicates.
+	FrozenAt       *time.Time  `json:"frozenAt,omitempty"`
+	FrozenAtNEQ    *time.Time  `json:"frozenAtNEQ,omitempty"`
+	FrozenAtIn     []time.Time `json:"frozenAtIn,omitempty"`
+	FrozenAtNotIn  []time.Time `json:"frozenAtNotIn,omitempty"`
+	FrozenAtGT     *time.Time  `json:"frozenAtGT,omitempty"`
+	FrozenAtGTE    *time.Time  `json:"frozenAtGTE,omitempty"`
+	FrozenAtLT     *time.Time  `json:"frozenAtLT,omitempty"`
+	FrozenAtLTE    *time.Time  `json:"frozenAtLTE,omitempty"`
+	FrozenAtIsNil  bool        `json:"frozenAtIsNil,omitempty"`
+	FrozenAtNotNil bool        `json:"frozenAtNotNil,omitempty"`
+
+	// "frozen_reason" field predicates.
+	FrozenReason             *string  `json:"frozenReason,omitempty"`
+	FrozenReasonNEQ          *string  `json:"frozenReasonNEQ,omitempty"`
+	FrozenReasonIn           []string `json:"frozenReasonIn,omitempty"`
+	FrozenReasonNotIn        []string `json:"frozenReasonNotIn,omitempty"`
+	FrozenReasonGT           *string  `json:"frozenReasonGT,omitempty"`
+	FrozenReasonGTE          *string  `json:"frozenReasonGTE,omitempty"`
+	FrozenReasonLT           *string  `json:"frozenReasonLT,omitempty"`
+	FrozenReasonLTE          *string  `json:"frozenReasonLTE,omitempty"`
+	FrozenReasonContains     *string  `json:"frozenReasonContains,omitempty"`
+	FrozenReasonHasPrefix    *string  `json:"frozenReasonHasPrefix,omitempty"`
+	FrozenReasonHasSuffix    *string  `json:"frozenReasonHasSuffix,omitempty"`
+	FrozenReasonIsNil        bool     `json:"frozenReasonIsNil,omitempty"`
+	FrozenReasonNotNil       bool     `json:"frozenReasonNotNil,omitempty"`
+	FrozenReasonEqualFold    *string  `json:"frozenReasonEqualFold,omitempty"`
+	FrozenReasonContainsFold *string  `json:"frozenReasonContainsFold,omitempty"`
+
+	// "erased_at" field predicates.
+	ErasedAt       *time.Time  `json:"erasedAt,omitempty"`
+	ErasedAtNEQ    *time.Time  `json:"erasedAtNEQ,omitempty"`
+	ErasedAtIn     []time.Time `json:"erasedAtIn,omitempty"`
+	ErasedAtNotIn  []time.Time `json:"erasedAtNotIn,omitempty"`
+	ErasedAtGT     *time.Time  `json:"erasedAtGT,omitempty"`
+	ErasedAtGTE    *time.Time  `json:"erasedAtGTE,omitempty"`
+	ErasedAtLT     *time.Time  `json:"erasedAtLT,omitempty"`
+	ErasedAtLTE    *time.Time  `json:"erasedAtLTE,omitempty"`
+	ErasedAtIsNil  bool        `json:"erasedAtIsNil,omitempty"`
+	ErasedAtNotNil bool        `json:"erasedAtNotNil,omitempty"`
+
+	// "transactions" edge predicates.
+	HasTransactions     *bool                    `json:"hasTransactions,omitempty"`
+	HasTransactionsWith []*TransactionWhereInput `json:"hasTransactionsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *UserWhereInput) AddPredicates(predicates ...predicate.User) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the UserWhereInput filter on the UserQuery builder.
+func (i *UserWhereInput) Filter(q *UserQuery) (*UserQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyUserWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyUserWhereInput is returned in case the UserWhereInput is empty.
+var ErrEmptyUserWhereInput = errors.New("ent: empty predicate UserWhereInput")
+
+// P returns a predicate for filtering users.
+// An error is returned if the input is empty or invalid.
+func (i *UserWhereInput) P() (predicate.User, error) {
+	var predicates []predicate.User
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, user.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.User, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, user.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.User, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, user.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, user.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, user.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, user.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, user.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, user.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, user.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, user.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, user.IDLTE(*i.IDLTE))
+	}
+	if i.Email != nil {
+		predicates = append(predicates, user.EmailEQ(*i.Email))
+	}
+	if i.EmailNEQ != nil {
+		predicates = append(predicates, user.EmailNEQ(*i.EmailNEQ))
+	}
+	if len(i.EmailIn) > 0 {
+		predicates = append(predicates, user.EmailIn(i.EmailIn...))
+	}
+	if len(i.EmailNotIn) > 0 {
+		predicates = append(predicates, user.EmailNotIn(i.EmailNotIn...))
+	}
+	if i.EmailGT != nil {
+		predicates = append(predicates, user.EmailGT(*i.EmailGT))
+	}
+	if i.EmailGTE != nil {
+		predicates = append(predicates, user.EmailGTE(*i.EmailGTE))
+	}
+	if i.EmailLT != nil {
+		predicates = append(predicates, user.EmailLT(*i.EmailLT))
+	}
+	if i.EmailLTE != nil {
+		predicates = append(predicates, user.EmailLTE(*i.EmailLTE))
+	}
+	if i.EmailContains != nil {
+		predicates = append(predicates, user.EmailContains(*i.EmailContains))
+	}
+	if i.EmailHasPrefix != nil {
+		predicates = append(predicates, user.EmailHasPrefix(*i.EmailHasPrefix))
+	}
+	if i.EmailHasSuffix != nil {
+		predicates = append(predicates, user.EmailHasSuffix(*i.EmailHasSuffix))
+	}
+	if i.EmailEqualFold != nil {
+		predicates = append(predicates, user.EmailEqualFold(*i.EmailEqualFold))
+	}
+	if i.EmailContainsFold != nil {
+		predicates = append(predicates, user.EmailContainsFold(*i.EmailContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, user.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, user.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, user.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, user.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, user.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, user.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, user.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, user.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.EmailUpdatedAt != nil {
+		predicates = append(predicates, user.EmailUpdatedAtEQ(*i.EmailUpdatedAt))
+	}
+	if i.EmailUpdatedAtNEQ != nil {
+		predicates = append(predicates, user.EmailUpdatedAtNEQ(*i.EmailUpdatedAtNEQ))
+	}
+	if len(i.EmailUpdatedAtIn) > 0 {
+		predicates = append(predicates, user.EmailUpdatedAtIn(i.EmailUpdatedAtIn...))
+	}
+	if len(i.EmailUpdatedAtNotIn) > 0 {
+		predicates = append(predicates, user.EmailUpdatedAtNotIn(i.EmailUpdatedAtNotIn...))
+	}
+	if i.EmailUpdatedAtGT != nil {
+		predicates = append(predicates, user.EmailUpdatedAtGT(*i.EmailUpdatedAtGT))
+	}
+	if i.EmailUpdatedAtGTE != nil {
+		predicates = append(predicates, user.EmailUpdatedAtGTE(*i.EmailUpdatedAtGTE))
+	}
+	if i.EmailUpdatedAtLT != nil {
+		predicates = append(predicates, user.EmailUpdatedAtLT(*i.EmailUpdatedAtLT))
+	}
+	if i.EmailUpdatedAtLTE != nil {
+		predicates = append(predicates, user.EmailUpdatedAtLTE(*i.EmailUpdatedAtLTE))
+	}
+	if i.EmailUpdatedAtIsNil {
+		predicates = append(predicates, user.EmailUpdatedAtIsNil())
+	}
+	if i.EmailUpdatedAtNotNil {
+		predicates = append(predicates, user.EmailUpdatedAtNotNil())
+	}
+	if i.VerifiedAt != nil {
+		predicates = append(predicates, user.VerifiedAtEQ(*i.VerifiedAt))
+	}
+	if i.VerifiedAtNEQ != nil {
+		predicates = append(predicates, user.VerifiedAtNEQ(*i.VerifiedAtNEQ))
+	}
+	if len(i.VerifiedAtIn) > 0 {
+		predicates = append(predicates, user.VerifiedAtIn(i.VerifiedAtIn...))
+	}
+	if len(i.VerifiedAtNotIn) > 0 {
+		predicates = append(predicates, user.VerifiedAtNotIn(i.VerifiedAtNotIn...))
+	}
+	if i.VerifiedAtGT != nil {
+		predicates = append(predicates, user.VerifiedAtGT(*i.VerifiedAtGT))
+	}
+	if i.VerifiedAtGTE != nil {
+		predicates = append(predicates, user.VerifiedAtGTE(*i.VerifiedAtGTE))
+	}
+	if i.VerifiedAtLT != nil {
+		predicates = append(predicates, user.VerifiedAtLT(*i.VerifiedAtLT))
+	}
+	if i.VerifiedAtLTE != nil {
+		predicates = append(predicates, user.VerifiedAtLTE(*i.VerifiedAtLTE))
+	}
+	if i.VerifiedAtIsNil {
+		predicates = append(predicates, user.VerifiedAtIsNil())
+	}
+	if i.VerifiedAtNotNil {
+		predicates = append(predicates, user.VerifiedAtNotNil())
+	}
+	if i.Balance != nil {
+		predicates = append(predicates, user.BalanceEQ(*i.Balance))
+	}
+	if i.BalanceNEQ != nil {
+		predicates = append(predicates, user.BalanceNEQ(*i.BalanceNEQ))
+	}
+	if len(i.BalanceIn) > 0 {
+		predicates = append(predicates, user.BalanceIn(i.BalanceIn...))
+	}
+	if len(i.BalanceNotIn) > 0 {
+		predicates = append(predicates, user.BalanceNotIn(i.BalanceNotIn...))
+	}
+	if i.BalanceGT != nil {
+		predicates = append(predicates, user.BalanceGT(*i.BalanceGT))
+	}
+	if i.BalanceGTE != nil {
+		predicates = append(predicates, user.BalanceGTE(*i.BalanceGTE))
+	}
+	if i.BalanceLT != nil {
+		predicates = append(predicates, user.BalanceLT(*i.BalanceLT))
+	}
+	if i.BalanceLTE != nil {
+		predicates = append(predicates, user.BalanceLTE(*i.BalanceLTE))
+	}
+	if i.FrozenAt != nil {
+		predicates = append(predicates, user.FrozenAtEQ(*i.FrozenAt))
+	}
+	if i.FrozenAtNEQ != nil {
+		predicates = append(predicates, user.FrozenAtNEQ(*i.FrozenAtNEQ))
+	}
+	if len(i.FrozenAtIn) > 0 {
+		predicates = append(predicates, user.FrozenAtIn(i.FrozenAtIn...))
+	}
+	if len(i.FrozenAtNotIn) > 0 {
+		predicates = append(predicates, user.FrozenAtNotIn(i.FrozenAtNotIn...))
+	}
+	if i.FrozenAtGT != nil {
+		predicates = append(predicates, user.FrozenAtGT(*i.FrozenAtGT))
+	}
+	if i.FrozenAtGTE != nil {
+		predicates = append(predicates, user.FrozenAtGTE(*i.FrozenAtGTE))
+	}
+	if i.FrozenAtLT != nil {
+		predicates = append(predicates, user.FrozenAtLT(*i.FrozenAtLT))
+	}
+	if i.FrozenAtLTE != nil {
+		predicates = append(predicates, user.FrozenAtLTE(*i.FrozenAtLTE))
+	}
+	if i.FrozenAtIsNil {
+		predicates = append(predicates, user.FrozenAtIsNil())
+	}
+	if i.FrozenAtNotNil {
+		predicates = append(predicates, user.FrozenAtNotNil())
+	}
+	if i.FrozenReason != nil {
+		predicates = append(predicates, user.FrozenReasonEQ(*i.FrozenReason))
+	}
+	if i.FrozenReasonNEQ != nil {
+		predicates = append(predicates, user.FrozenReasonNEQ(*i.FrozenReasonNEQ))
+	}
+	if len(i.FrozenReasonIn) > 0 {
+		predicates = append(predicates, user.FrozenReasonIn(i.FrozenReasonIn...))
+	}
+	if len(i.FrozenReasonNotIn) > 0 {
+		predicates = append(predicates, user.FrozenReasonNotIn(i.FrozenReasonNotIn...))
+	}
+	if i.FrozenReasonGT != nil {
+		predicates = append(predicates, user.FrozenReasonGT(*i.FrozenReasonGT))
+	}
+	if i.FrozenReasonGTE != nil {
+		predicates = append(predicates, user.FrozenReasonGTE(*i.FrozenReasonGTE))
+	}
+	if i.FrozenReasonLT != nil {
+		predicates = append(predicates, user.FrozenReasonLT(*i.FrozenReasonLT))
+	}
+	if i.FrozenReasonLTE != nil {
+		predicates = append(predicates, user.FrozenReasonLTE(*i.FrozenReasonLTE))
+	}
+	if i.FrozenReasonContains != nil {
+		predicates = append(predicates, user.FrozenReasonContains(*i.FrozenReasonContains))
+	}
+	if i.FrozenReasonHasPrefix != nil {
+		predicates = append(predicates, user.FrozenReasonHasPrefix(*i.FrozenReasonHasPrefix))
+	}
+	if i.FrozenReasonHasSuffix != nil {
+		predicates = append(predicates, user.FrozenReasonHasSuffix(*i.FrozenReasonHasSuffix))
+	}
+	if i.FrozenReasonIsNil {
+		predicates = append(predicates, user.FrozenReasonIsNil())
+	}
+	if i.FrozenReasonNotNil {
+		predicates = append(predicates, user.FrozenReasonNotNil())
+	}
+	if i.FrozenReasonEqualFold != nil {
+		predicates = append(predicates, user.FrozenReasonEqualFold(*i.FrozenReasonEqualFold))
+	}
+	if i.FrozenReasonContainsFold != nil {
+		predicates = append(predicates, user.FrozenReasonContainsFold(*i.FrozenReasonContainsFold))
+	}
+	if i.ErasedAt != nil {
+		predicates = append(predicates, user.ErasedAtEQ(*i.ErasedAt))
+	}
+	if i.ErasedAtNEQ != nil {
+		predicates = append(predicates, user.ErasedAtNEQ(*i.ErasedAtNEQ))
+	}
+	if len(i.ErasedAtIn) > 0 {
+		predicates = append(predicates, user.ErasedAtIn(i.ErasedAtIn...))
+	}
+	if len(i.ErasedAtNotIn) > 0 {
+		predicates = append(predicates, user.ErasedAtNotIn(i.ErasedAtNotIn...))
+	}
+	if i.ErasedAtGT != nil {
+		predicates = append(predicates, user.ErasedAtGT(*i.ErasedAtGT))
+	}
+	if i.ErasedAtGTE != nil {
+		predicates = append(predicates, user.ErasedAtGTE(*i.ErasedAtGTE))
+	}
+	if i.ErasedAtLT != nil {
+		predicates = append(predicates, user.ErasedAtLT(*i.ErasedAtLT))
+	}
+	if i.ErasedAtLTE != nil {
+		predicates = append(predicates, user.ErasedAtLTE(*i.ErasedAtLTE))
+	}
+	if i.ErasedAtIsNil {
+		predicates = append(predicates, user.ErasedAtIsNil())
+	}
+	if i.ErasedAtNotNil {
+		predicates = append(predicates, user.ErasedAtNotNil())
+	}
+
+	if i.HasTransactions != nil {
+		p := user.HasTransactions()
+		if !*i.HasTransactions {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasTransactionsWith) > 0 {
+		with := make([]predicate.Transaction, 0, len(i.HasTransactionsWith))
+		for _, w := range i.HasTransactionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasTransactionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasTransactionsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyUserWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return user.And(predicates...), nil
+	}
+}
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transactions-service/ent/payee"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Payee is the model entity for the Payee schema.
+type Payee struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID int `json:"owner_id,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID int `json:"wallet_id,omitempty"`
+	// Nickname holds the value of the "nickname" field.
+	Nickname string `json:"nickname,omitempty"`
+	// Alias holds the value of the "alias" field.
+	Alias string `json:"alias,omitempty"`
+	// DefaultAmount holds the value of the "default_amount" field.
+	DefaultAmount *float64 `json:"default_amount,omitempty"`
+	// Memo holds the value of the "memo" field.
+	Memo string `json:"memo,omitempty"`
+	// LastPaidAt holds the value of the "last_paid_at" field.
+	LastPaidAt *time.Time `json:"last_paid_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Payee) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payee.FieldDefaultAmount:
+			values[i] = new(sql.NullFloat64)
+		case payee.FieldID, payee.FieldOwnerID, payee.FieldWalletID:
+			values[i] = new(sql.NullInt64)
+		case payee.FieldNickname, payee.FieldAlias, payee.FieldMemo:
+			values[i] = new(sql.NullString)
+		case payee.FieldLastPaidAt, payee.FieldCreatedAt, payee.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Payee fields.
+func (pa *Payee) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payee.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pa.ID = int(value.Int64)
+		case payee.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				pa.OwnerID = int(value.Int64)
+			}
+		case payee.FieldWalletID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value.Valid {
+				pa.WalletID = int(value.Int64)
+			}
+		case payee.FieldNickname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nickname", values[i])
+			} else if value.Valid {
+				pa.Nickname = value.String
+			}
+		case payee.FieldAlias:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alias", values[i])
+			} else if value.Valid {
+				pa.Alias = value.String
+			}
+		case payee.FieldDefaultAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_amount", values[i])
+			} else if value.Valid {
+				pa.DefaultAmount = new(float64)
+				*pa.DefaultAmount = value.Float64
+			}
+		case payee.FieldMemo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field memo", values[i])
+			} else if value.Valid {
+				pa.Memo = value.String
+			}
+		case payee.FieldLastPaidAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_paid_at", values[i])
+			} else if value.Valid {
+				pa.LastPaidAt = new(time.Time)
+				*pa.LastPaidAt = value.Time
+			}
+		case payee.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pa.CreatedAt = value.Time
+			}
+		case payee.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pa.UpdatedAt = value.Time
+			}
+		default:
+			pa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Payee.
+// This includes values selected through modifiers, order, etc.
+func (pa *Payee) Value(name string) (ent.Value, error) {
+	return pa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Payee.
+// Note that you need to call Payee.Unwrap() before calling this method if this Payee
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *Payee) Update() *PayeeUpdateOne {
+	return NewPayeeClient(pa.config).UpdateOne(pa)
+}
+
+// Unwrap unwraps the Payee entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pa *Payee) Unwrap() *Payee {
+	_tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Payee is not a transactional entity")
+	}
+	pa.config.driver = _tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *Payee) String() string {
+	var builder strings.Builder
+	builder.WriteString("Payee(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", pa.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", pa.WalletID))
+	builder.WriteString(", ")
+	builder.WriteString("nickname=")
+	builder.WriteString(pa.Nickname)
+	builder.WriteString(", ")
+	builder.WriteString("alias=")
+	builder.WriteString(pa.Alias)
+	builder.WriteString(", ")
+	if v := pa.DefaultAmount; v != nil {
+		builder.WriteString("default_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("memo=")
+	builder.WriteString(pa.Memo)
+	builder.WriteString(", ")
+	if v := pa.LastPaidAt; v != nil {
+		builder.WriteString("last_paid_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Payees is a parsable slice of Payee.
+type Payees []*Payee
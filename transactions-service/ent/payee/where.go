@@ -0,0 +1,590 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payee
+
+import (
+	"time"
+	"transactions-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldID, id))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldWalletID, v))
+}
+
+// Nickname applies equality check predicate on the "nickname" field. It's identical to NicknameEQ.
+func Nickname(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldNickname, v))
+}
+
+// Alias applies equality check predicate on the "alias" field. It's identical to AliasEQ.
+func Alias(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldAlias, v))
+}
+
+// DefaultAmount applies equality check predicate on the "default_amount" field. It's identical to DefaultAmountEQ.
+func DefaultAmount(v float64) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldDefaultAmount, v))
+}
+
+// Memo applies equality check predicate on the "memo" field. It's identical to MemoEQ.
+func Memo(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldMemo, v))
+}
+
+// LastPaidAt applies equality check predicate on the "last_paid_at" field. It's identical to LastPaidAtEQ.
+func LastPaidAt(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldLastPaidAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...int) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...int) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldWalletID, v))
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldWalletID, v))
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...int) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldWalletID, vs...))
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...int) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldWalletID, vs...))
+}
+
+// WalletIDGT applies the GT predicate on the "wallet_id" field.
+func WalletIDGT(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldWalletID, v))
+}
+
+// WalletIDGTE applies the GTE predicate on the "wallet_id" field.
+func WalletIDGTE(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldWalletID, v))
+}
+
+// WalletIDLT applies the LT predicate on the "wallet_id" field.
+func WalletIDLT(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldWalletID, v))
+}
+
+// WalletIDLTE applies the LTE predicate on the "wallet_id" field.
+func WalletIDLTE(v int) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldWalletID, v))
+}
+
+// NicknameEQ applies the EQ predicate on the "nickname" field.
+func NicknameEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldNickname, v))
+}
+
+// NicknameNEQ applies the NEQ predicate on the "nickname" field.
+func NicknameNEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldNickname, v))
+}
+
+// NicknameIn applies the In predicate on the "nickname" field.
+func NicknameIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldNickname, vs...))
+}
+
+// NicknameNotIn applies the NotIn predicate on the "nickname" field.
+func NicknameNotIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldNickname, vs...))
+}
+
+// NicknameGT applies the GT predicate on the "nickname" field.
+func NicknameGT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldNickname, v))
+}
+
+// NicknameGTE applies the GTE predicate on the "nickname" field.
+func NicknameGTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldNickname, v))
+}
+
+// NicknameLT applies the LT predicate on the "nickname" field.
+func NicknameLT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldNickname, v))
+}
+
+// NicknameLTE applies the LTE predicate on the "nickname" field.
+func NicknameLTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldNickname, v))
+}
+
+// NicknameContains applies the Contains predicate on the "nickname" field.
+func NicknameContains(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContains(FieldNickname, v))
+}
+
+// NicknameHasPrefix applies the HasPrefix predicate on the "nickname" field.
+func NicknameHasPrefix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasPrefix(FieldNickname, v))
+}
+
+// NicknameHasSuffix applies the HasSuffix predicate on the "nickname" field.
+func NicknameHasSuffix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasSuffix(FieldNickname, v))
+}
+
+// NicknameEqualFold applies the EqualFold predicate on the "nickname" field.
+func NicknameEqualFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEqualFold(FieldNickname, v))
+}
+
+// NicknameContainsFold applies the ContainsFold predicate on the "nickname" field.
+func NicknameContainsFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContainsFold(FieldNickname, v))
+}
+
+// AliasEQ applies the EQ predicate on the "alias" field.
+func AliasEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldAlias, v))
+}
+
+// AliasNEQ applies the NEQ predicate on the "alias" field.
+func AliasNEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldAlias, v))
+}
+
+// AliasIn applies the In predicate on the "alias" field.
+func AliasIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldAlias, vs...))
+}
+
+// AliasNotIn applies the NotIn predicate on the "alias" field.
+func AliasNotIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldAlias, vs...))
+}
+
+// AliasGT applies the GT predicate on the "alias" field.
+func AliasGT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldAlias, v))
+}
+
+// AliasGTE applies the GTE predicate on the "alias" field.
+func AliasGTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldAlias, v))
+}
+
+// AliasLT applies the LT predicate on the "alias" field.
+func AliasLT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldAlias, v))
+}
+
+// AliasLTE applies the LTE predicate on the "alias" field.
+func AliasLTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldAlias, v))
+}
+
+// AliasContains applies the Contains predicate on the "alias" field.
+func AliasContains(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContains(FieldAlias, v))
+}
+
+// AliasHasPrefix applies the HasPrefix predicate on the "alias" field.
+func AliasHasPrefix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasPrefix(FieldAlias, v))
+}
+
+// AliasHasSuffix applies the HasSuffix predicate on the "alias" field.
+func AliasHasSuffix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasSuffix(FieldAlias, v))
+}
+
+// AliasIsNil applies the IsNil predicate on the "alias" field.
+func AliasIsNil() predicate.Payee {
+	return predicate.Payee(sql.FieldIsNull(FieldAlias))
+}
+
+// AliasNotNil applies the NotNil predicate on the "alias" field.
+func AliasNotNil() predicate.Payee {
+	return predicate.Payee(sql.FieldNotNull(FieldAlias))
+}
+
+// AliasEqualFold applies the EqualFold predicate on the "alias" field.
+func AliasEqualFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEqualFold(FieldAlias, v))
+}
+
+// AliasContainsFold applies the ContainsFold predicate on the "alias" field.
+func AliasContainsFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContainsFold(FieldAlias, v))
+}
+
+// DefaultAmountEQ applies the EQ predicate on the "default_amount" field.
+func DefaultAmountEQ(v float64) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldDefaultAmount, v))
+}
+
+// DefaultAmountNEQ applies the NEQ predicate on the "default_amount" field.
+func DefaultAmountNEQ(v float64) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldDefaultAmount, v))
+}
+
+// DefaultAmountIn applies the In predicate on the "default_amount" field.
+func DefaultAmountIn(vs ...float64) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldDefaultAmount, vs...))
+}
+
+// DefaultAmountNotIn applies the NotIn predicate on the "default_amount" field.
+func DefaultAmountNotIn(vs ...float64) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldDefaultAmount, vs...))
+}
+
+// DefaultAmountGT applies the GT predicate on the "default_amount" field.
+func DefaultAmountGT(v float64) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldDefaultAmount, v))
+}
+
+// DefaultAmountGTE applies the GTE predicate on the "default_amount" field.
+func DefaultAmountGTE(v float64) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldDefaultAmount, v))
+}
+
+// DefaultAmountLT applies the LT predicate on the "default_amount" field.
+func DefaultAmountLT(v float64) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldDefaultAmount, v))
+}
+
+// DefaultAmountLTE applies the LTE predicate on the "default_amount" field.
+func DefaultAmountLTE(v float64) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldDefaultAmount, v))
+}
+
+// DefaultAmountIsNil applies the IsNil predicate on the "default_amount" field.
+func DefaultAmountIsNil() predicate.Payee {
+	return predicate.Payee(sql.FieldIsNull(FieldDefaultAmount))
+}
+
+// DefaultAmountNotNil applies the NotNil predicate on the "default_amount" field.
+func DefaultAmountNotNil() predicate.Payee {
+	return predicate.Payee(sql.FieldNotNull(FieldDefaultAmount))
+}
+
+// MemoEQ applies the EQ predicate on the "memo" field.
+func MemoEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldMemo, v))
+}
+
+// MemoNEQ applies the NEQ predicate on the "memo" field.
+func MemoNEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldMemo, v))
+}
+
+// MemoIn applies the In predicate on the "memo" field.
+func MemoIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldMemo, vs...))
+}
+
+// MemoNotIn applies the NotIn predicate on the "memo" field.
+func MemoNotIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldMemo, vs...))
+}
+
+// MemoGT applies the GT predicate on the "memo" field.
+func MemoGT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldMemo, v))
+}
+
+// MemoGTE applies the GTE predicate on the "memo" field.
+func MemoGTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldMemo, v))
+}
+
+// MemoLT applies the LT predicate on the "memo" field.
+func MemoLT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldMemo, v))
+}
+
+// MemoLTE applies the LTE predicate on the "memo" field.
+func MemoLTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldMemo, v))
+}
+
+// MemoContains applies the Contains predicate on the "memo" field.
+func MemoContains(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContains(FieldMemo, v))
+}
+
+// MemoHasPrefix applies the HasPrefix predicate on the "memo" field.
+func MemoHasPrefix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasPrefix(FieldMemo, v))
+}
+
+// MemoHasSuffix applies the HasSuffix predicate on the "memo" field.
+func MemoHasSuffix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasSuffix(FieldMemo, v))
+}
+
+// MemoIsNil applies the IsNil predicate on the "memo" field.
+func MemoIsNil() predicate.Payee {
+	return predicate.Payee(sql.FieldIsNull(FieldMemo))
+}
+
+// MemoNotNil applies the NotNil predicate on the "memo" field.
+func MemoNotNil() predicate.Payee {
+	return predicate.Payee(sql.FieldNotNull(FieldMemo))
+}
+
+// MemoEqualFold applies the EqualFold predicate on the "memo" field.
+func MemoEqualFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEqualFold(FieldMemo, v))
+}
+
+// MemoContainsFold applies the ContainsFold predicate on the "memo" field.
+func MemoContainsFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContainsFold(FieldMemo, v))
+}
+
+// LastPaidAtEQ applies the EQ predicate on the "last_paid_at" field.
+func LastPaidAtEQ(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldLastPaidAt, v))
+}
+
+// LastPaidAtNEQ applies the NEQ predicate on the "last_paid_at" field.
+func LastPaidAtNEQ(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldLastPaidAt, v))
+}
+
+// LastPaidAtIn applies the In predicate on the "last_paid_at" field.
+func LastPaidAtIn(vs ...time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldLastPaidAt, vs...))
+}
+
+// LastPaidAtNotIn applies the NotIn predicate on the "last_paid_at" field.
+func LastPaidAtNotIn(vs ...time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldLastPaidAt, vs...))
+}
+
+// LastPaidAtGT applies the GT predicate on the "last_paid_at" field.
+func LastPaidAtGT(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldLastPaidAt, v))
+}
+
+// LastPaidAtGTE applies the GTE predicate on the "last_paid_at" field.
+func LastPaidAtGTE(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldLastPaidAt, v))
+}
+
+// LastPaidAtLT applies the LT predicate on the "last_paid_at" field.
+func LastPaidAtLT(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldLastPaidAt, v))
+}
+
+// LastPaidAtLTE applies the LTE predicate on the "last_paid_at" field.
+func LastPaidAtLTE(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldLastPaidAt, v))
+}
+
+// LastPaidAtIsNil applies the IsNil predicate on the "last_paid_at" field.
+func LastPaidAtIsNil() predicate.Payee {
+	return predicate.Payee(sql.FieldIsNull(FieldLastPaidAt))
+}
+
+// LastPaidAtNotNil applies the NotNil predicate on the "last_paid_at" field.
+func LastPaidAtNotNil() predicate.Payee {
+	return predicate.Payee(sql.FieldNotNull(FieldLastPaidAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Payee) predicate.Payee {
+	return predicate.Payee(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Payee) predicate.Payee {
+	return predicate.Payee(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Payee) predicate.Payee {
+	return predicate.Payee(sql.NotPredicates(p))
+}
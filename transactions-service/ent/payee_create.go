@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactions-service/ent/payee"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayeeCreate is the builder for creating a Payee entity.
+type PayeeCreate struct {
+	config
+	mutation *PayeeMutation
+	hooks    []Hook
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (pc *PayeeCreate) SetOwnerID(i int) *PayeeCreate {
+	pc.mutation.SetOwnerID(i)
+	return pc
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (pc *PayeeCreate) SetWalletID(i int) *PayeeCreate {
+	pc.mutation.SetWalletID(i)
+	return pc
+}
+
+// SetNickname sets the "nickname" field.
+func (pc *PayeeCreate) SetNickname(s string) *PayeeCreate {
+	pc.mutation.SetNickname(s)
+	return pc
+}
+
+// SetAlias sets the "alias" field.
+func (pc *PayeeCreate) SetAlias(s string) *PayeeCreate {
+	pc.mutation.SetAlias(s)
+	return pc
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (pc *PayeeCreate) SetNillableAlias(s *string) *PayeeCreate {
+	if s != nil {
+		pc.SetAlias(*s)
+	}
+	return pc
+}
+
+// SetDefaultAmount sets the "default_amount" field.
+func (pc *PayeeCreate) SetDefaultAmount(f float64) *PayeeCreate {
+	pc.mutation.SetDefaultAmount(f)
+	return pc
+}
+
+// SetNillableDefaultAmount sets the "default_amount" field if the given value is not nil.
+func (pc *PayeeCreate) SetNillableDefaultAmount(f *float64) *PayeeCreate {
+	if f != nil {
+		pc.SetDefaultAmount(*f)
+	}
+	return pc
+}
+
+// SetMemo sets the "memo" field.
+func (pc *PayeeCreate) SetMemo(s string) *PayeeCreate {
+	pc.mutation.SetMemo(s)
+	return pc
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (pc *PayeeCreate) SetNillableMemo(s *string) *PayeeCreate {
+	if s != nil {
+		pc.SetMemo(*s)
+	}
+	return pc
+}
+
+// SetLastPaidAt sets the "last_paid_at" field.
+func (pc *PayeeCreate) SetLastPaidAt(t time.Time) *PayeeCreate {
+	pc.mutation.SetLastPaidAt(t)
+	return pc
+}
+
+// SetNillableLastPaidAt sets the "last_paid_at" field if the given value is not nil.
+func (pc *PayeeCreate) SetNillableLastPaidAt(t *time.Time) *PayeeCreate {
+	if t != nil {
+		pc.SetLastPaidAt(*t)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PayeeCreate) SetCreatedAt(t time.Time) *PayeeCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PayeeCreate) SetNillableCreatedAt(t *time.Time) *PayeeCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PayeeCreate) SetUpdatedAt(t time.Time) *PayeeCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PayeeCreate) SetNillableUpdatedAt(t *time.Time) *PayeeCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// Mutation returns the PayeeMutation object of the builder.
+func (pc *PayeeCreate) Mutation() *PayeeMutation {
+	return pc.mutation
+}
+
+// Save creates the Payee in the database.
+func (pc *PayeeCreate) Save(ctx context.Context) (*Payee, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PayeeCreate) SaveX(ctx context.Context) *Payee {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PayeeCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PayeeCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PayeeCreate) defaults() {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := payee.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := payee.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PayeeCreate) check() error {
+	if _, ok := pc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Payee.owner_id"`)}
+	}
+	if _, ok := pc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "Payee.wallet_id"`)}
+	}
+	if _, ok := pc.mutation.Nickname(); !ok {
+		return &ValidationError{Name: "nickname", err: errors.New(`ent: missing required field "Payee.nickname"`)}
+	}
+	if v, ok := pc.mutation.Nickname(); ok {
+		if err := payee.NicknameValidator(v); err != nil {
+			return &ValidationError{Name: "nickname", err: fmt.Errorf(`ent: validator failed for field "Payee.nickname": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Payee.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Payee.updated_at"`)}
+	}
+	return nil
+}
+
+func (pc *PayeeCreate) sqlSave(ctx context.Context) (*Payee, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PayeeCreate) createSpec() (*Payee, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Payee{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(payee.Table, sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt))
+	)
+	if value, ok := pc.mutation.OwnerID(); ok {
+		_spec.SetField(payee.FieldOwnerID, field.TypeInt, value)
+		_node.OwnerID = value
+	}
+	if value, ok := pc.mutation.WalletID(); ok {
+		_spec.SetField(payee.FieldWalletID, field.TypeInt, value)
+		_node.WalletID = value
+	}
+	if value, ok := pc.mutation.Nickname(); ok {
+		_spec.SetField(payee.FieldNickname, field.TypeString, value)
+		_node.Nickname = value
+	}
+	if value, ok := pc.mutation.Alias(); ok {
+		_spec.SetField(payee.FieldAlias, field.TypeString, value)
+		_node.Alias = value
+	}
+	if value, ok := pc.mutation.DefaultAmount(); ok {
+		_spec.SetField(payee.FieldDefaultAmount, field.TypeFloat64, value)
+		_node.DefaultAmount = &value
+	}
+	if value, ok := pc.mutation.Memo(); ok {
+		_spec.SetField(payee.FieldMemo, field.TypeString, value)
+		_node.Memo = value
+	}
+	if value, ok := pc.mutation.LastPaidAt(); ok {
+		_spec.SetField(payee.FieldLastPaidAt, field.TypeTime, value)
+		_node.LastPaidAt = &value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(payee.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(payee.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// PayeeCreateBulk is the builder for creating many Payee entities in bulk.
+type PayeeCreateBulk struct {
+	config
+	err      error
+	builders []*PayeeCreate
+}
+
+// Save creates the Payee entities in the database.
+func (pcb *PayeeCreateBulk) Save(ctx context.Context) ([]*Payee, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Payee, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayeeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PayeeCreateBulk) SaveX(ctx context.Context) []*Payee {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PayeeCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PayeeCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
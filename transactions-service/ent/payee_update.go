@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactions-service/ent/payee"
+	"transactions-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayeeUpdate is the builder for updating Payee entities.
+type PayeeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PayeeMutation
+}
+
+// Where appends a list predicates to the PayeeUpdate builder.
+func (pu *PayeeUpdate) Where(ps ...predicate.Payee) *PayeeUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetNickname sets the "nickname" field.
+func (pu *PayeeUpdate) SetNickname(s string) *PayeeUpdate {
+	pu.mutation.SetNickname(s)
+	return pu
+}
+
+// SetNillableNickname sets the "nickname" field if the given value is not nil.
+func (pu *PayeeUpdate) SetNillableNickname(s *string) *PayeeUpdate {
+	if s != nil {
+		pu.SetNickname(*s)
+	}
+	return pu
+}
+
+// SetAlias sets the "alias" field.
+func (pu *PayeeUpdate) SetAlias(s string) *PayeeUpdate {
+	pu.mutation.SetAlias(s)
+	return pu
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (pu *PayeeUpdate) SetNillableAlias(s *string) *PayeeUpdate {
+	if s != nil {
+		pu.SetAlias(*s)
+	}
+	return pu
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (pu *PayeeUpdate) ClearAlias() *PayeeUpdate {
+	pu.mutation.ClearAlias()
+	return pu
+}
+
+// SetDefaultAmount sets the "default_amount" field.
+func (pu *PayeeUpdate) SetDefaultAmount(f float64) *PayeeUpdate {
+	pu.mutation.ResetDefaultAmount()
+	pu.mutation.SetDefaultAmount(f)
+	return pu
+}
+
+// SetNillableDefaultAmount sets the "default_amount" field if the given value is not nil.
+func (pu *PayeeUpdate) SetNillableDefaultAmount(f *float64) *PayeeUpdate {
+	if f != nil {
+		pu.SetDefaultAmount(*f)
+	}
+	return pu
+}
+
+// AddDefaultAmount adds f to the "default_amount" field.
+func (pu *PayeeUpdate) AddDefaultAmount(f float64) *PayeeUpdate {
+	pu.mutation.AddDefaultAmount(f)
+	return pu
+}
+
+// ClearDefaultAmount clears the value of the "default_amount" field.
+func (pu *PayeeUpdate) ClearDefaultAmount() *PayeeUpdate {
+	pu.mutation.ClearDefaultAmount()
+	return pu
+}
+
+// SetMemo sets the "memo" field.
+func (pu *PayeeUpdate) SetMemo(s string) *PayeeUpdate {
+	pu.mutation.SetMemo(s)
+	return pu
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (pu *PayeeUpdate) SetNillableMemo(s *string) *PayeeUpdate {
+	if s != nil {
+		pu.SetMemo(*s)
+	}
+	return pu
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (pu *PayeeUpdate) ClearMemo() *PayeeUpdate {
+	pu.mutation.ClearMemo()
+	return pu
+}
+
+// SetLastPaidAt sets the "last_paid_at" field.
+func (pu *PayeeUpdate) SetLastPaidAt(t time.Time) *PayeeUpdate {
+	pu.mutation.SetLastPaidAt(t)
+	return pu
+}
+
+// SetNillableLastPaidAt sets the "last_paid_at" field if the given value is not nil.
+func (pu *PayeeUpdate) SetNillableLastPaidAt(t *time.Time) *PayeeUpdate {
+	if t != nil {
+		pu.SetLastPaidAt(*t)
+	}
+	return pu
+}
+
+// ClearLastPaidAt clears the value of the "last_paid_at" field.
+func (pu *PayeeUpdate) ClearLastPaidAt() *PayeeUpdate {
+	pu.mutation.ClearLastPaidAt()
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PayeeUpdate) SetUpdatedAt(t time.Time) *PayeeUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// Mutation returns the PayeeMutation object of the builder.
+func (pu *PayeeUpdate) Mutation() *PayeeMutation {
+	return pu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PayeeUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PayeeUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PayeeUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PayeeUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PayeeUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := payee.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PayeeUpdate) check() error {
+	if v, ok := pu.mutation.Nickname(); ok {
+		if err := payee.NicknameValidator(v); err != nil {
+			return &ValidationError{Name: "nickname", err: fmt.Errorf(`ent: validator failed for field "Payee.nickname": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *PayeeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payee.Table, payee.Columns, sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Nickname(); ok {
+		_spec.SetField(payee.FieldNickname, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Alias(); ok {
+		_spec.SetField(payee.FieldAlias, field.TypeString, value)
+	}
+	if pu.mutation.AliasCleared() {
+		_spec.ClearField(payee.FieldAlias, field.TypeString)
+	}
+	if value, ok := pu.mutation.DefaultAmount(); ok {
+		_spec.SetField(payee.FieldDefaultAmount, field.TypeFloat64, value)
+	}
+	if value, ok := pu.mutation.AddedDefaultAmount(); ok {
+		_spec.AddField(payee.FieldDefaultAmount, field.TypeFloat64, value)
+	}
+	if pu.mutation.DefaultAmountCleared() {
+		_spec.ClearField(payee.FieldDefaultAmount, field.TypeFloat64)
+	}
+	if value, ok := pu.mutation.Memo(); ok {
+		_spec.SetField(payee.FieldMemo, field.TypeString, value)
+	}
+	if pu.mutation.MemoCleared() {
+		_spec.ClearField(payee.FieldMemo, field.TypeString)
+	}
+	if value, ok := pu.mutation.LastPaidAt(); ok {
+		_spec.SetField(payee.FieldLastPaidAt, field.TypeTime, value)
+	}
+	if pu.mutation.LastPaidAtCleared() {
+		_spec.ClearField(payee.FieldLastPaidAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(payee.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payee.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PayeeUpdateOne is the builder for updating a single Payee entity.
+type PayeeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PayeeMutation
+}
+
+// SetNickname sets the "nickname" field.
+func (puo *PayeeUpdateOne) SetNickname(s string) *PayeeUpdateOne {
+	puo.mutation.SetNickname(s)
+	return puo
+}
+
+// SetNillableNickname sets the "nickname" field if the given value is not nil.
+func (puo *PayeeUpdateOne) SetNillableNickname(s *string) *PayeeUpdateOne {
+	if s != nil {
+		puo.SetNickname(*s)
+	}
+	return puo
+}
+
+// SetAlias sets the "alias" field.
+func (puo *PayeeUpdateOne) SetAlias(s string) *PayeeUpdateOne {
+	puo.mutation.SetAlias(s)
+	return puo
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (puo *PayeeUpdateOne) SetNillableAlias(s *string) *PayeeUpdateOne {
+	if s != nil {
+		puo.SetAlias(*s)
+	}
+	return puo
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (puo *PayeeUpdateOne) ClearAlias() *PayeeUpdateOne {
+	puo.mutation.ClearAlias()
+	return puo
+}
+
+// SetDefaultAmount sets the "default_amount" field.
+func (puo *PayeeUpdateOne) SetDefaultAmount(f float64) *PayeeUpdateOne {
+	puo.mutation.ResetDefaultAmount()
+	puo.mutation.SetDefaultAmount(f)
+	return puo
+}
+
+// SetNillableDefaultAmount sets the "default_amount" field if the given value is not nil.
+func (puo *PayeeUpdateOne) SetNillableDefaultAmount(f *float64) *PayeeUpdateOne {
+	if f != nil {
+		puo.SetDefaultAmount(*f)
+	}
+	return puo
+}
+
+// AddDefaultAmount adds f to the "default_amount" field.
+func (puo *PayeeUpdateOne) AddDefaultAmount(f float64) *PayeeUpdateOne {
+	puo.mutation.AddDefaultAmount(f)
+	return puo
+}
+
+// ClearDefaultAmount clears the value of the "default_amount" field.
+func (puo *PayeeUpdateOne) ClearDefaultAmount() *PayeeUpdateOne {
+	puo.mutation.ClearDefaultAmount()
+	return puo
+}
+
+// SetMemo sets the "memo" field.
+func (puo *PayeeUpdateOne) SetMemo(s string) *PayeeUpdateOne {
+	puo.mutation.SetMemo(s)
+	return puo
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (puo *PayeeUpdateOne) SetNillableMemo(s *string) *PayeeUpdateOne {
+	if s != nil {
+		puo.SetMemo(*s)
+	}
+	return puo
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (puo *PayeeUpdateOne) ClearMemo() *PayeeUpdateOne {
+	puo.mutation.ClearMemo()
+	return puo
+}
+
+// SetLastPaidAt sets the "last_paid_at" field.
+func (puo *PayeeUpdateOne) SetLastPaidAt(t time.Time) *PayeeUpdateOne {
+	puo.mutation.SetLastPaidAt(t)
+	return puo
+}
+
+// SetNillableLastPaidAt sets the "last_paid_at" field if the given value is not nil.
+func (puo *PayeeUpdateOne) SetNillableLastPaidAt(t *time.Time) *PayeeUpdateOne {
+	if t != nil {
+		puo.SetLastPaidAt(*t)
+	}
+	return puo
+}
+
+// ClearLastPaidAt clears the value of the "last_paid_at" field.
+func (puo *PayeeUpdateOne) ClearLastPaidAt() *PayeeUpdateOne {
+	puo.mutation.ClearLastPaidAt()
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PayeeUpdateOne) SetUpdatedAt(t time.Time) *PayeeUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// Mutation returns the PayeeMutation object of the builder.
+func (puo *PayeeUpdateOne) Mutation() *PayeeMutation {
+	return puo.mutation
+}
+
+// Where appends a list predicates to the PayeeUpdate builder.
+func (puo *PayeeUpdateOne) Where(ps ...predicate.Payee) *PayeeUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PayeeUpdateOne) Select(field string, fields ...string) *PayeeUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Payee entity.
+func (puo *PayeeUpdateOne) Save(ctx context.Context) (*Payee, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PayeeUpdateOne) SaveX(ctx context.Context) *Payee {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PayeeUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PayeeUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PayeeUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := payee.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PayeeUpdateOne) check() error {
+	if v, ok := puo.mutation.Nickname(); ok {
+		if err := payee.NicknameValidator(v); err != nil {
+			return &ValidationError{Name: "nickname", err: fmt.Errorf(`ent: validator failed for field "Payee.nickname": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *PayeeUpdateOne) sqlSave(ctx context.Context) (_node *Payee, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payee.Table, payee.Columns, sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Payee.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payee.FieldID)
+		for _, f := range fields {
+			if !payee.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payee.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Nickname(); ok {
+		_spec.SetField(payee.FieldNickname, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Alias(); ok {
+		_spec.SetField(payee.FieldAlias, field.TypeString, value)
+	}
+	if puo.mutation.AliasCleared() {
+		_spec.ClearField(payee.FieldAlias, field.TypeString)
+	}
+	if value, ok := puo.mutation.DefaultAmount(); ok {
+		_spec.SetField(payee.FieldDefaultAmount, field.TypeFloat64, value)
+	}
+	if value, ok := puo.mutation.AddedDefaultAmount(); ok {
+		_spec.AddField(payee.FieldDefaultAmount, field.TypeFloat64, value)
+	}
+	if puo.mutation.DefaultAmountCleared() {
+		_spec.ClearField(payee.FieldDefaultAmount, field.TypeFloat64)
+	}
+	if value, ok := puo.mutation.Memo(); ok {
+		_spec.SetField(payee.FieldMemo, field.TypeString, value)
+	}
+	if puo.mutation.MemoCleared() {
+		_spec.ClearField(payee.FieldMemo, field.TypeString)
+	}
+	if value, ok := puo.mutation.LastPaidAt(); ok {
+		_spec.SetField(payee.FieldLastPaidAt, field.TypeTime, value)
+	}
+	if puo.mutation.LastPaidAtCleared() {
+		_spec.ClearField(payee.FieldLastPaidAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(payee.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Payee{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payee.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
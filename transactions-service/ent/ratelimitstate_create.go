@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactions-service/ent/ratelimitstate"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RateLimitStateCreate is the builder for creating a RateLimitState entity.
+type RateLimitStateCreate struct {
+	config
+	mutation *RateLimitStateMutation
+	hooks    []Hook
+}
+
+// SetValue sets the "value" field.
+func (rlsc *RateLimitStateCreate) SetValue(f float64) *RateLimitStateCreate {
+	rlsc.mutation.SetValue(f)
+	return rlsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rlsc *RateLimitStateCreate) SetUpdatedAt(t time.Time) *RateLimitStateCreate {
+	rlsc.mutation.SetUpdatedAt(t)
+	return rlsc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rlsc *RateLimitStateCreate) SetExpiresAt(t time.Time) *RateLimitStateCreate {
+	rlsc.mutation.SetExpiresAt(t)
+	return rlsc
+}
+
+// SetVersion sets the "version" field.
+func (rlsc *RateLimitStateCreate) SetVersion(i int) *RateLimitStateCreate {
+	rlsc.mutation.SetVersion(i)
+	return rlsc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (rlsc *RateLimitStateCreate) SetNillableVersion(i *int) *RateLimitStateCreate {
+	if i != nil {
+		rlsc.SetVersion(*i)
+	}
+	return rlsc
+}
+
+// SetID sets the "id" field.
+func (rlsc *RateLimitStateCreate) SetID(s string) *RateLimitStateCreate {
+	rlsc.mutation.SetID(s)
+	return rlsc
+}
+
+// Mutation returns the RateLimitStateMutation object of the builder.
+func (rlsc *RateLimitStateCreate) Mutation() *RateLimitStateMutation {
+	return rlsc.mutation
+}
+
+// Save creates the RateLimitState in the database.
+func (rlsc *RateLimitStateCreate) Save(ctx context.Context) (*RateLimitState, error) {
+	rlsc.defaults()
+	return withHooks(ctx, rlsc.sqlSave, rlsc.mutation, rlsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rlsc *RateLimitStateCreate) SaveX(ctx context.Context) *RateLimitState {
+	v, err := rlsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rlsc *RateLimitStateCreate) Exec(ctx context.Context) error {
+	_, err := rlsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlsc *RateLimitStateCreate) ExecX(ctx context.Context) {
+	if err := rlsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rlsc *RateLimitStateCreate) defaults() {
+	if _, ok := rlsc.mutation.Version(); !ok {
+		v := ratelimitstate.DefaultVersion
+		rlsc.mutation.SetVersion(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rlsc *RateLimitStateCreate) check() error {
+	if _, ok := rlsc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "RateLimitState.value"`)}
+	}
+	if _, ok := rlsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RateLimitState.updated_at"`)}
+	}
+	if _, ok := rlsc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "RateLimitState.expires_at"`)}
+	}
+	if _, ok := rlsc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "RateLimitState.version"`)}
+	}
+	return nil
+}
+
+func (rlsc *RateLimitStateCreate) sqlSave(ctx context.Context) (*RateLimitState, error) {
+	if err := rlsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rlsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rlsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RateLimitState.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rlsc.mutation.id = &_node.ID
+	rlsc.mutation.done = true
+	return _node, nil
+}
+
+func (rlsc *RateLimitStateCreate) createSpec() (*RateLimitState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RateLimitState{config: rlsc.config}
+		_spec = sqlgraph.NewCreateSpec(ratelimitstate.Table, sqlgraph.NewFieldSpec(ratelimitstate.FieldID, field.TypeString))
+	)
+	if id, ok := rlsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rlsc.mutation.Value(); ok {
+		_spec.SetField(ratelimitstate.FieldValue, field.TypeFloat64, value)
+		_node.Value = value
+	}
+	if value, ok := rlsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratelimitstate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rlsc.mutation.ExpiresAt(); ok {
+		_spec.SetField(ratelimitstate.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := rlsc.mutation.Version(); ok {
+		_spec.SetField(ratelimitstate.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	return _node, _spec
+}
+
+// RateLimitStateCreateBulk is the builder for creating many RateLimitState entities in bulk.
+type RateLimitStateCreateBulk struct {
+	config
+	err      error
+	builders []*RateLimitStateCreate
+}
+
+// Save creates the RateLimitState entities in the database.
+func (rlscb *RateLimitStateCreateBulk) Save(ctx context.Context) ([]*RateLimitState, error) {
+	if rlscb.err != nil {
+		return nil, rlscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rlscb.builders))
+	nodes := make([]*RateLimitState, len(rlscb.builders))
+	mutators := make([]Mutator, len(rlscb.builders))
+	for i := range rlscb.builders {
+		func(i int, root context.Context) {
+			builder := rlscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RateLimitStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rlscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rlscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rlscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rlscb *RateLimitStateCreateBulk) SaveX(ctx context.Context) []*RateLimitState {
+	v, err := rlscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rlscb *RateLimitStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := rlscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlscb *RateLimitStateCreateBulk) ExecX(ctx context.Context) {
+	if err := rlscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"transactions-service/ent/predicate"
+	"transactions-service/ent/ratelimitstate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RateLimitStateDelete is the builder for deleting a RateLimitState entity.
+type RateLimitStateDelete struct {
+	config
+	hooks    []Hook
+	mutation *RateLimitStateMutation
+}
+
+// Where appends a list predicates to the RateLimitStateDelete builder.
+func (rlsd *RateLimitStateDelete) Where(ps ...predicate.RateLimitState) *RateLimitStateDelete {
+	rlsd.mutation.Where(ps...)
+	return rlsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rlsd *RateLimitStateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rlsd.sqlExec, rlsd.mutation, rlsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlsd *RateLimitStateDelete) ExecX(ctx context.Context) int {
+	n, err := rlsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rlsd *RateLimitStateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ratelimitstate.Table, sqlgraph.NewFieldSpec(ratelimitstate.FieldID, field.TypeString))
+	if ps := rlsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rlsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rlsd.mutation.done = true
+	return affected, err
+}
+
+// RateLimitStateDeleteOne is the builder for deleting a single RateLimitState entity.
+type RateLimitStateDeleteOne struct {
+	rlsd *RateLimitStateDelete
+}
+
+// Where appends a list predicates to the RateLimitStateDelete builder.
+func (rlsdo *RateLimitStateDeleteOne) Where(ps ...predicate.RateLimitState) *RateLimitStateDeleteOne {
+	rlsdo.rlsd.mutation.Where(ps...)
+	return rlsdo
+}
+
+// Exec executes the deletion query.
+func (rlsdo *RateLimitStateDeleteOne) Exec(ctx context.Context) error {
+	n, err := rlsdo.rlsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ratelimitstate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlsdo *RateLimitStateDeleteOne) ExecX(ctx context.Context) {
+	if err := rlsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
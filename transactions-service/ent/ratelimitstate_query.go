@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"transactions-service/ent/predicate"
+	"transactions-service/ent/ratelimitstate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RateLimitStateQuery is the builder for querying RateLimitState entities.
+type RateLimitStateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []ratelimitstate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RateLimitState
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*RateLimitState) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RateLimitStateQuery builder.
+func (rlsq *RateLimitStateQuery) Where(ps ...predicate.RateLimitState) *RateLimitStateQuery {
+	rlsq.predicates = append(rlsq.predicates, ps...)
+	return rlsq
+}
+
+// Limit the number of records to be returned by this query.
+func (rlsq *RateLimitStateQuery) Limit(limit int) *RateLimitStateQuery {
+	rlsq.ctx.Limit = &limit
+	return rlsq
+}
+
+// Offset to start from.
+func (rlsq *RateLimitStateQuery) Offset(offset int) *RateLimitStateQuery {
+	rlsq.ctx.Offset = &offset
+	return rlsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rlsq *RateLimitStateQuery) Unique(unique bool) *RateLimitStateQuery {
+	rlsq.ctx.Unique = &unique
+	return rlsq
+}
+
+// Order specifies how the records should be ordered.
+func (rlsq *RateLimitStateQuery) Order(o ...ratelimitstate.OrderOption) *RateLimitStateQuery {
+	rlsq.order = append(rlsq.order, o...)
+	return rlsq
+}
+
+// First returns the first RateLimitState entity from the query.
+// Returns a *NotFoundError when no RateLimitState was found.
+func (rlsq *RateLimitStateQuery) First(ctx context.Context) (*RateLimitState, error) {
+	nodes, err := rlsq.Limit(1).All(setContextOp(ctx, rlsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ratelimitstate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rlsq *RateLimitStateQuery) FirstX(ctx context.Context) *RateLimitState {
+	node, err := rlsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RateLimitState ID from the query.
+// Returns a *NotFoundError when no RateLimitState ID was found.
+func (rlsq *RateLimitStateQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rlsq.Limit(1).IDs(setContextOp(ctx, rlsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ratelimitstate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rlsq *RateLimitStateQuery) FirstIDX(ctx context.Context) string {
+	id, err := rlsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RateLimitState entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RateLimitState entity is found.
+// Returns a *NotFoundError when no RateLimitState entities are found.
+func (rlsq *RateLimitStateQuery) Only(ctx context.Context) (*RateLimitState, error) {
+	nodes, err := rlsq.Limit(2).All(setContextOp(ctx, rlsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ratelimitstate.Label}
+	default:
+		return nil, &NotSingularError{ratelimitstate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rlsq *RateLimitStateQuery) OnlyX(ctx context.Context) *RateLimitState {
+	node, err := rlsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RateLimitState ID in the query.
+// Returns a *NotSingularError when more than one RateLimitState ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rlsq *RateLimitStateQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rlsq.Limit(2).IDs(setContextOp(ctx, rlsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ratelimitstate.Label}
+	default:
+		err = &NotSingularError{ratelimitstate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rlsq *RateLimitStateQuery) OnlyIDX(ctx context.Context) string {
+	id, err := rlsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RateLimitStates.
+func (rlsq *RateLimitStateQuery) All(ctx context.Context) ([]*RateLimitState, error) {
+	ctx = setContextOp(ctx, rlsq.ctx, "All")
+	if err := rlsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RateLimitState, *RateLimitStateQuery]()
+	return withInterceptors[[]*RateLimitState](ctx, rlsq, qr, rlsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rlsq *RateLimitStateQuery) AllX(ctx context.Context) []*RateLimitState {
+	nodes, err := rlsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RateLimitState IDs.
+func (rlsq *RateLimitStateQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if rlsq.ctx.Unique == nil && rlsq.path != nil {
+		rlsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rlsq.ctx, "IDs")
+	if err = rlsq.Select(ratelimitstate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rlsq *RateLimitStateQuery) IDsX(ctx context.Context) []string {
+	ids, err := rlsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rlsq *RateLimitStateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rlsq.ctx, "Count")
+	if err := rlsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rlsq, querierCount[*RateLimitStateQuery](), rlsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rlsq *RateLimitStateQuery) CountX(ctx context.Context) int {
+	count, err := rlsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rlsq *RateLimitStateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rlsq.ctx, "Exist")
+	switch _, err := rlsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rlsq *RateLimitStateQuery) ExistX(ctx context.Context) bool {
+	exist, err := rlsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RateLimitStateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rlsq *RateLimitStateQuery) Clone() *RateLimitStateQuery {
+	if rlsq == nil {
+		return nil
+	}
+	return &RateLimitStateQuery{
+		config:     rlsq.config,
+		ctx:        rlsq.ctx.Clone(),
+		order:      append([]ratelimitstate.OrderOption{}, rlsq.order...),
+		inters:     append([]Interceptor{}, rlsq.inters...),
+		predicates: append([]predicate.RateLimitState{}, rlsq.predicates...),
+		// clone intermediate query.
+		sql:  rlsq.sql.Clone(),
+		path: rlsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Value float64 `json:"value,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RateLimitState.Query().
+//		GroupBy(ratelimitstate.FieldValue).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rlsq *RateLimitStateQuery) GroupBy(field string, fields ...string) *RateLimitStateGroupBy {
+	rlsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RateLimitStateGroupBy{build: rlsq}
+	grbuild.flds = &rlsq.ctx.Fields
+	grbuild.label = ratelimitstate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Value float64 `json:"value,omitempty"`
+//	}
+//
+//	client.RateLimitState.Query().
+//		Select(ratelimitstate.FieldValue).
+//		Scan(ctx, &v)
+func (rlsq *RateLimitStateQuery) Select(fields ...string) *RateLimitStateSelect {
+	rlsq.ctx.Fields = append(rlsq.ctx.Fields, fields...)
+	sbuild := &RateLimitStateSelect{RateLimitStateQuery: rlsq}
+	sbuild.label = ratelimitstate.Label
+	sbuild.flds, sbuild.scan = &rlsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RateLimitStateSelect configured with the given aggregations.
+func (rlsq *RateLimitStateQuery) Aggregate(fns ...AggregateFunc) *RateLimitStateSelect {
+	return rlsq.Select().Aggregate(fns...)
+}
+
+func (rlsq *RateLimitStateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rlsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rlsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rlsq.ctx.Fields {
+		if !ratelimitstate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rlsq.path != nil {
+		prev, err := rlsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rlsq.sql = prev
+	}
+	return nil
+}
+
+func (rlsq *RateLimitStateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RateLimitState, error) {
+	var (
+		nodes = []*RateLimitState{}
+		_spec = rlsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RateLimitState).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RateLimitState{config: rlsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(rlsq.modifiers) > 0 {
+		_spec.Modifiers = rlsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rlsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range rlsq.loadTotal {
+		if err := rlsq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rlsq *RateLimitStateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rlsq.querySpec()
+	if len(rlsq.modifiers) > 0 {
+		_spec.Modifiers = rlsq.modifiers
+	}
+	_spec.Node.Columns = rlsq.ctx.Fields
+	if len(rlsq.ctx.Fields) > 0 {
+		_spec.Unique = rlsq.ctx.Unique != nil && *rlsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rlsq.driver, _spec)
+}
+
+func (rlsq *RateLimitStateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ratelimitstate.Table, ratelimitstate.Columns, sqlgraph.NewFieldSpec(ratelimitstate.FieldID, field.TypeString))
+	_spec.From = rlsq.sql
+	if unique := rlsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rlsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rlsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratelimitstate.FieldID)
+		for i := range fields {
+			if fields[i] != ratelimitstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rlsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rlsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rlsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rlsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rlsq *RateLimitStateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rlsq.driver.Dialect())
+	t1 := builder.Table(ratelimitstate.Table)
+	columns := rlsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ratelimitstate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rlsq.sql != nil {
+		selector = rlsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rlsq.ctx.Unique != nil && *rlsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rlsq.predicates {
+		p(selector)
+	}
+	for _, p := range rlsq.order {
+		p(selector)
+	}
+	if offset := rlsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rlsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RateLimitStateGroupBy is the group-by builder for RateLimitState entities.
+type RateLimitStateGroupBy struct {
+	selector
+	build *RateLimitStateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rlsgb *RateLimitStateGroupBy) Aggregate(fns ...AggregateFunc) *RateLimitStateGroupBy {
+	rlsgb.fns = append(rlsgb.fns, fns...)
+	return rlsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rlsgb *RateLimitStateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rlsgb.build.ctx, "GroupBy")
+	if err := rlsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RateLimitStateQuery, *RateLimitStateGroupBy](ctx, rlsgb.build, rlsgb, rlsgb.build.inters, v)
+}
+
+func (rlsgb *RateLimitStateGroupBy) sqlScan(ctx context.Context, root *RateLimitStateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rlsgb.fns))
+	for _, fn := range rlsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rlsgb.flds)+len(rlsgb.fns))
+		for _, f := range *rlsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rlsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rlsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RateLimitStateSelect is the builder for selecting fields of RateLimitState entities.
+type RateLimitStateSelect struct {
+	*RateLimitStateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rlss *RateLimitStateSelect) Aggregate(fns ...AggregateFunc) *RateLimitStateSelect {
+	rlss.fns = append(rlss.fns, fns...)
+	return rlss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rlss *RateLimitStateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rlss.ctx, "Select")
+	if err := rlss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RateLimitStateQuery, *RateLimitStateSelect](ctx, rlss.RateLimitStateQuery, rlss, rlss.inters, v)
+}
+
+func (rlss *RateLimitStateSelect) sqlScan(ctx context.Context, root *RateLimitStateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rlss.fns))
+	for _, fn := range rlss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rlss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rlss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// DeadLetter holds the schema definition for the DeadLetter entity.
+type DeadLetter struct {
+	ent.Schema
+}
+
+// Fields of the DeadLetter.
+func (DeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.String("subject").Immutable(),
+		field.Bytes("data"),
+		// user_id and email identify the user a user-created message was
+		// about, lowercased, so that erasing the user finds its letters.
+		field.Int("user_id").Optional().Nillable().Immutable(),
+		field.String("email").Optional().Immutable(),
+		field.Text("error"),
+		field.String("error_code").Optional(),
+		field.Enum("status").Values("pending", "discarded").Default("pending"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DeadLetter.
+func (DeadLetter) Edges() []ent.Edge { return nil }
+
+// Indexes of the DeadLetter.
+func (DeadLetter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "subject"),
+		index.Fields("user_id"),
+		index.Fields("email"),
+	}
+}
+
+// Annotations of the DeadLetter.
+func (DeadLetter) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Event holds the schema definition for the Event entity. Events are written in
+// the same database transaction as the change they describe and published to
+// NATS afterwards; the ID doubles as the event's sequence number. Webhook
+// deliveries are fanned out from the same events.
+type Event struct {
+	ent.Schema
+}
+
+// Fields of the Event.
+func (Event) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.String("type").Immutable(),
+		field.Int("user_id").Immutable(),
+		// data is only rewritten to pseudonymize the email of an erased user.
+		field.Bytes("data"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("published_at").Optional().Nillable(),
+		field.Time("dispatched_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Event.
+func (Event) Edges() []ent.Edge { return nil }
+
+// Indexes of the Event.
+func (Event) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("published_at"),
+		index.Fields("dispatched_at"),
+		index.Fields("user_id"),
+	}
+}
+
+// Annotations of the Event.
+func (Event) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// Lease holds the schema definition for the Lease entity. A lease names a
+// background job and records which replica may run it until it expires.
+type Lease struct {
+	ent.Schema
+}
+
+// Fields of the Lease.
+func (Lease) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.String("holder"),
+		field.Time("acquired_at"),
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the Lease.
+func (Lease) Edges() []ent.Edge { return nil }
+
+// Annotations of the Lease.
+func (Lease) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
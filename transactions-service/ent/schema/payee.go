@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Payee holds the schema definition for the Payee entity, a wallet saved in
+// the contact book of another wallet's owner to pay it again quickly.
+type Payee struct {
+	ent.Schema
+}
+
+// Fields of the Payee.
+func (Payee) Fields() []ent.Field {
+	return []ent.Field{
+		// owner_id is the wallet whose contact book holds the payee.
+		field.Int("owner_id").Immutable(),
+		// wallet_id is the wallet that is paid.
+		field.Int("wallet_id").Immutable(),
+		field.String("nickname").NotEmpty(),
+		// alias is the handle, email or phone number the payee was saved by,
+		// shown instead of the wallet ID. It is cleared when the paid user is
+		// erased.
+		field.String("alias").Optional(),
+		field.Float("default_amount").Optional().Nillable(),
+		field.String("memo").Optional(),
+		field.Time("last_paid_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Payee.
+func (Payee) Edges() []ent.Edge { return nil }
+
+// Indexes of the Payee.
+func (Payee) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "wallet_id").Unique(),
+	}
+}
+
+// Annotations of the Payee.
+func (Payee) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
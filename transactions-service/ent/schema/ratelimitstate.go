@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RateLimitState holds the schema definition for the RateLimitState entity,
+// the state of a token bucket or quota window shared by every replica.
+type RateLimitState struct {
+	ent.Schema
+}
+
+// Fields of the RateLimitState.
+func (RateLimitState) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the key of the bucket or window.
+		field.String("id").Unique().Immutable(),
+		field.Float("value"),
+		field.Time("updated_at"),
+		field.Time("expires_at"),
+		// version is incremented by every update, which only applies to the
+		// version it was computed from.
+		field.Int("version").Default(0),
+	}
+}
+
+// Edges of the RateLimitState.
+func (RateLimitState) Edges() []ent.Edge { return nil }
+
+// Indexes of the RateLimitState.
+func (RateLimitState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
+
+// Annotations of the RateLimitState.
+func (RateLimitState) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -1,9 +1,12 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -16,9 +19,12 @@ type Transaction struct {
 func (Transaction) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id").Unique().Immutable(),
-		field.Float("amount"),
-		field.Time("created_at"),
-		field.Enum("type").Values("credit", "debit"),
+		field.Float("amount").
+			Annotations(entgql.OrderField("AMOUNT")),
+		field.Time("created_at").
+			Annotations(entgql.OrderField("CREATED_AT")),
+		field.Enum("type").Values("credit", "debit").
+			Annotations(entgql.OrderField("TYPE")),
 		field.UUID("request_id", uuid.Nil),
 	}
 }
@@ -29,3 +35,21 @@ func (Transaction) Edges() []ent.Edge {
 		edge.From("user", User.Type).Ref("transactions").Unique(),
 	}
 }
+
+// Indexes of the Transaction.
+func (Transaction) Indexes() []ent.Index {
+	return []ent.Index{
+		// A request is applied once: a deposit has a single credit and a
+		// transfer a debit and a credit, so a retry racing the first attempt
+		// fails on this index.
+		index.Fields("request_id", "type").Unique(),
+	}
+}
+
+// Annotations of the Transaction.
+func (Transaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
@@ -1,7 +1,9 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"time"
@@ -16,15 +18,40 @@ type User struct {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id").Unique().Immutable(),
-		field.String("email").Unique(),
-		field.Time("created_at").Default(time.Now),
-		field.Float("balance").Default(0),
+		field.String("email").Unique().
+			Annotations(entgql.OrderField("EMAIL")),
+		field.Time("created_at").Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
+		// email_updated_at is when user-service last changed the email or its
+		// verification; older user-updated messages are ignored.
+		field.Time("email_updated_at").Optional().Nillable(),
+		// verified_at is when the user verified the email; an unverified wallet
+		// can receive money but not send it.
+		field.Time("verified_at").Optional().Nillable(),
+		field.Float("balance").Default(0).
+			Annotations(entgql.OrderField("BALANCE")),
+		// frozen_at is set while compliance has frozen the wallet; a frozen
+		// wallet can neither send nor receive money.
+		field.Time("frozen_at").Optional().Nillable(),
+		field.String("frozen_reason").Optional(),
+		// erased_at is set once the user was erased: the email is replaced by a
+		// pseudonym and the wallet is frozen, while its transactions are kept.
+		field.Time("erased_at").Optional().Nillable(),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("transactions", Transaction.Type),
+		edge.To("transactions", Transaction.Type).
+			Annotations(entgql.RelayConnection()),
+	}
+}
+
+// Annotations of the User.
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
 	}
 }
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// WebhookEndpoint holds the schema definition for the WebhookEndpoint entity.
+// An endpoint receives the events of its wallet of the types it subscribed to,
+// or of every type when it did not filter them.
+type WebhookEndpoint struct {
+	ent.Schema
+}
+
+// Fields of the WebhookEndpoint.
+func (WebhookEndpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.String("client").NotEmpty(),
+		field.String("url").NotEmpty(),
+		// wallet_id is the wallet whose events the endpoint receives. Endpoints
+		// registered before endpoints were scoped have none and receive nothing.
+		field.Int("wallet_id").Optional().Nillable().Immutable(),
+		field.String("secret").Sensitive(),
+		field.Strings("event_types").Optional(),
+		field.Bool("enabled").Default(true),
+		field.Int("consecutive_failures").Default(0),
+		field.Time("disabled_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WebhookEndpoint.
+func (WebhookEndpoint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
+
+// Indexes of the WebhookEndpoint.
+func (WebhookEndpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id"),
+	}
+}
+
+// Annotations of the WebhookEndpoint.
+func (WebhookEndpoint) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
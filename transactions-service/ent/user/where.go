@@ -65,11 +65,36 @@ func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// EmailUpdatedAt applies equality check predicate on the "email_updated_at" field. It's identical to EmailUpdatedAtEQ.
+func EmailUpdatedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailUpdatedAt, v))
+}
+
+// VerifiedAt applies equality check predicate on the "verified_at" field. It's identical to VerifiedAtEQ.
+func VerifiedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
 // Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
 func Balance(v float64) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldBalance, v))
 }
 
+// FrozenAt applies equality check predicate on the "frozen_at" field. It's identical to FrozenAtEQ.
+func FrozenAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFrozenAt, v))
+}
+
+// FrozenReason applies equality check predicate on the "frozen_reason" field. It's identical to FrozenReasonEQ.
+func FrozenReason(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFrozenReason, v))
+}
+
+// ErasedAt applies equality check predicate on the "erased_at" field. It's identical to ErasedAtEQ.
+func ErasedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldErasedAt, v))
+}
+
 // EmailEQ applies the EQ predicate on the "email" field.
 func EmailEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
@@ -175,6 +200,106 @@ func CreatedAtLTE(v time.Time) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// EmailUpdatedAtEQ applies the EQ predicate on the "email_updated_at" field.
+func EmailUpdatedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailUpdatedAt, v))
+}
+
+// EmailUpdatedAtNEQ applies the NEQ predicate on the "email_updated_at" field.
+func EmailUpdatedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmailUpdatedAt, v))
+}
+
+// EmailUpdatedAtIn applies the In predicate on the "email_updated_at" field.
+func EmailUpdatedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmailUpdatedAt, vs...))
+}
+
+// EmailUpdatedAtNotIn applies the NotIn predicate on the "email_updated_at" field.
+func EmailUpdatedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmailUpdatedAt, vs...))
+}
+
+// EmailUpdatedAtGT applies the GT predicate on the "email_updated_at" field.
+func EmailUpdatedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldEmailUpdatedAt, v))
+}
+
+// EmailUpdatedAtGTE applies the GTE predicate on the "email_updated_at" field.
+func EmailUpdatedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldEmailUpdatedAt, v))
+}
+
+// EmailUpdatedAtLT applies the LT predicate on the "email_updated_at" field.
+func EmailUpdatedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldEmailUpdatedAt, v))
+}
+
+// EmailUpdatedAtLTE applies the LTE predicate on the "email_updated_at" field.
+func EmailUpdatedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldEmailUpdatedAt, v))
+}
+
+// EmailUpdatedAtIsNil applies the IsNil predicate on the "email_updated_at" field.
+func EmailUpdatedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEmailUpdatedAt))
+}
+
+// EmailUpdatedAtNotNil applies the NotNil predicate on the "email_updated_at" field.
+func EmailUpdatedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEmailUpdatedAt))
+}
+
+// VerifiedAtEQ applies the EQ predicate on the "verified_at" field.
+func VerifiedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtNEQ applies the NEQ predicate on the "verified_at" field.
+func VerifiedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIn applies the In predicate on the "verified_at" field.
+func VerifiedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtNotIn applies the NotIn predicate on the "verified_at" field.
+func VerifiedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtGT applies the GT predicate on the "verified_at" field.
+func VerifiedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtGTE applies the GTE predicate on the "verified_at" field.
+func VerifiedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLT applies the LT predicate on the "verified_at" field.
+func VerifiedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLTE applies the LTE predicate on the "verified_at" field.
+func VerifiedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIsNil applies the IsNil predicate on the "verified_at" field.
+func VerifiedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldVerifiedAt))
+}
+
+// VerifiedAtNotNil applies the NotNil predicate on the "verified_at" field.
+func VerifiedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldVerifiedAt))
+}
+
 // BalanceEQ applies the EQ predicate on the "balance" field.
 func BalanceEQ(v float64) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldBalance, v))
@@ -215,6 +340,181 @@ func BalanceLTE(v float64) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldBalance, v))
 }
 
+// FrozenAtEQ applies the EQ predicate on the "frozen_at" field.
+func FrozenAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFrozenAt, v))
+}
+
+// FrozenAtNEQ applies the NEQ predicate on the "frozen_at" field.
+func FrozenAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldFrozenAt, v))
+}
+
+// FrozenAtIn applies the In predicate on the "frozen_at" field.
+func FrozenAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldFrozenAt, vs...))
+}
+
+// FrozenAtNotIn applies the NotIn predicate on the "frozen_at" field.
+func FrozenAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldFrozenAt, vs...))
+}
+
+// FrozenAtGT applies the GT predicate on the "frozen_at" field.
+func FrozenAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldFrozenAt, v))
+}
+
+// FrozenAtGTE applies the GTE predicate on the "frozen_at" field.
+func FrozenAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldFrozenAt, v))
+}
+
+// FrozenAtLT applies the LT predicate on the "frozen_at" field.
+func FrozenAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldFrozenAt, v))
+}
+
+// FrozenAtLTE applies the LTE predicate on the "frozen_at" field.
+func FrozenAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldFrozenAt, v))
+}
+
+// FrozenAtIsNil applies the IsNil predicate on the "frozen_at" field.
+func FrozenAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldFrozenAt))
+}
+
+// FrozenAtNotNil applies the NotNil predicate on the "frozen_at" field.
+func FrozenAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldFrozenAt))
+}
+
+// FrozenReasonEQ applies the EQ predicate on the "frozen_reason" field.
+func FrozenReasonEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFrozenReason, v))
+}
+
+// FrozenReasonNEQ applies the NEQ predicate on the "frozen_reason" field.
+func FrozenReasonNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldFrozenReason, v))
+}
+
+// FrozenReasonIn applies the In predicate on the "frozen_reason" field.
+func FrozenReasonIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldFrozenReason, vs...))
+}
+
+// FrozenReasonNotIn applies the NotIn predicate on the "frozen_reason" field.
+func FrozenReasonNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldFrozenReason, vs...))
+}
+
+// FrozenReasonGT applies the GT predicate on the "frozen_reason" field.
+func FrozenReasonGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldFrozenReason, v))
+}
+
+// FrozenReasonGTE applies the GTE predicate on the "frozen_reason" field.
+func FrozenReasonGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldFrozenReason, v))
+}
+
+// FrozenReasonLT applies the LT predicate on the "frozen_reason" field.
+func FrozenReasonLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldFrozenReason, v))
+}
+
+// FrozenReasonLTE applies the LTE predicate on the "frozen_reason" field.
+func FrozenReasonLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldFrozenReason, v))
+}
+
+// FrozenReasonContains applies the Contains predicate on the "frozen_reason" field.
+func FrozenReasonContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldFrozenReason, v))
+}
+
+// FrozenReasonHasPrefix applies the HasPrefix predicate on the "frozen_reason" field.
+func FrozenReasonHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldFrozenReason, v))
+}
+
+// FrozenReasonHasSuffix applies the HasSuffix predicate on the "frozen_reason" field.
+func FrozenReasonHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldFrozenReason, v))
+}
+
+// FrozenReasonIsNil applies the IsNil predicate on the "frozen_reason" field.
+func FrozenReasonIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldFrozenReason))
+}
+
+// FrozenReasonNotNil applies the NotNil predicate on the "frozen_reason" field.
+func FrozenReasonNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldFrozenReason))
+}
+
+// FrozenReasonEqualFold applies the EqualFold predicate on the "frozen_reason" field.
+func FrozenReasonEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldFrozenReason, v))
+}
+
+// FrozenReasonContainsFold applies the ContainsFold predicate on the "frozen_reason" field.
+func FrozenReasonContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldFrozenReason, v))
+}
+
+// ErasedAtEQ applies the EQ predicate on the "erased_at" field.
+func ErasedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldErasedAt, v))
+}
+
+// ErasedAtNEQ applies the NEQ predicate on the "erased_at" field.
+func ErasedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldErasedAt, v))
+}
+
+// ErasedAtIn applies the In predicate on the "erased_at" field.
+func ErasedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldErasedAt, vs...))
+}
+
+// ErasedAtNotIn applies the NotIn predicate on the "erased_at" field.
+func ErasedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldErasedAt, vs...))
+}
+
+// ErasedAtGT applies the GT predicate on the "erased_at" field.
+func ErasedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldErasedAt, v))
+}
+
+// ErasedAtGTE applies the GTE predicate on the "erased_at" field.
+func ErasedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldErasedAt, v))
+}
+
+// ErasedAtLT applies the LT predicate on the "erased_at" field.
+func ErasedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldErasedAt, v))
+}
+
+// ErasedAtLTE applies the LTE predicate on the "erased_at" field.
+func ErasedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldErasedAt, v))
+}
+
+// ErasedAtIsNil applies the IsNil predicate on the "erased_at" field.
+func ErasedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldErasedAt))
+}
+
+// ErasedAtNotNil applies the NotNil predicate on the "erased_at" field.
+func ErasedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldErasedAt))
+}
+
 // HasTransactions applies the HasEdge predicate on the "transactions" edge.
 func HasTransactions() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -41,6 +41,34 @@ func (uc *UserCreate) SetNillableCreatedAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetEmailUpdatedAt sets the "email_updated_at" field.
+func (uc *UserCreate) SetEmailUpdatedAt(t time.Time) *UserCreate {
+	uc.mutation.SetEmailUpdatedAt(t)
+	return uc
+}
+
+// SetNillableEmailUpdatedAt sets the "email_updated_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailUpdatedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetEmailUpdatedAt(*t)
+	}
+	return uc
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (uc *UserCreate) SetVerifiedAt(t time.Time) *UserCreate {
+	uc.mutation.SetVerifiedAt(t)
+	return uc
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableVerifiedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetVerifiedAt(*t)
+	}
+	return uc
+}
+
 // SetBalance sets the "balance" field.
 func (uc *UserCreate) SetBalance(f float64) *UserCreate {
 	uc.mutation.SetBalance(f)
@@ -55,6 +83,48 @@ func (uc *UserCreate) SetNillableBalance(f *float64) *UserCreate {
 	return uc
 }
 
+// SetFrozenAt sets the "frozen_at" field.
+func (uc *UserCreate) SetFrozenAt(t time.Time) *UserCreate {
+	uc.mutation.SetFrozenAt(t)
+	return uc
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableFrozenAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetFrozenAt(*t)
+	}
+	return uc
+}
+
+// SetFrozenReason sets the "frozen_reason" field.
+func (uc *UserCreate) SetFrozenReason(s string) *UserCreate {
+	uc.mutation.SetFrozenReason(s)
+	return uc
+}
+
+// SetNillableFrozenReason sets the "frozen_reason" field if the given value is not nil.
+func (uc *UserCreate) SetNillableFrozenReason(s *string) *UserCreate {
+	if s != nil {
+		uc.SetFrozenReason(*s)
+	}
+	return uc
+}
+
+// SetErasedAt sets the "erased_at" field.
+func (uc *UserCreate) SetErasedAt(t time.Time) *UserCreate {
+	uc.mutation.SetErasedAt(t)
+	return uc
+}
+
+// SetNillableErasedAt sets the "erased_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableErasedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetErasedAt(*t)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(i int) *UserCreate {
 	uc.mutation.SetID(i)
@@ -172,10 +242,30 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := uc.mutation.EmailUpdatedAt(); ok {
+		_spec.SetField(user.FieldEmailUpdatedAt, field.TypeTime, value)
+		_node.EmailUpdatedAt = &value
+	}
+	if value, ok := uc.mutation.VerifiedAt(); ok {
+		_spec.SetField(user.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
 	if value, ok := uc.mutation.Balance(); ok {
 		_spec.SetField(user.FieldBalance, field.TypeFloat64, value)
 		_node.Balance = value
 	}
+	if value, ok := uc.mutation.FrozenAt(); ok {
+		_spec.SetField(user.FieldFrozenAt, field.TypeTime, value)
+		_node.FrozenAt = &value
+	}
+	if value, ok := uc.mutation.FrozenReason(); ok {
+		_spec.SetField(user.FieldFrozenReason, field.TypeString, value)
+		_node.FrozenReason = value
+	}
+	if value, ok := uc.mutation.ErasedAt(); ok {
+		_spec.SetField(user.FieldErasedAt, field.TypeTime, value)
+		_node.ErasedAt = &value
+	}
 	if nodes := uc.mutation.TransactionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -57,6 +57,46 @@ func (uu *UserUpdate) SetNillableCreatedAt(t *time.Time) *UserUpdate {
 	return uu
 }
 
+// SetEmailUpdatedAt sets the "email_updated_at" field.
+func (uu *UserUpdate) SetEmailUpdatedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetEmailUpdatedAt(t)
+	return uu
+}
+
+// SetNillableEmailUpdatedAt sets the "email_updated_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmailUpdatedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetEmailUpdatedAt(*t)
+	}
+	return uu
+}
+
+// ClearEmailUpdatedAt clears the value of the "email_updated_at" field.
+func (uu *UserUpdate) ClearEmailUpdatedAt() *UserUpdate {
+	uu.mutation.ClearEmailUpdatedAt()
+	return uu
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (uu *UserUpdate) SetVerifiedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetVerifiedAt(t)
+	return uu
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableVerifiedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetVerifiedAt(*t)
+	}
+	return uu
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (uu *UserUpdate) ClearVerifiedAt() *UserUpdate {
+	uu.mutation.ClearVerifiedAt()
+	return uu
+}
+
 // SetBalance sets the "balance" field.
 func (uu *UserUpdate) SetBalance(f float64) *UserUpdate {
 	uu.mutation.ResetBalance()
@@ -78,6 +118,66 @@ func (uu *UserUpdate) AddBalance(f float64) *UserUpdate {
 	return uu
 }
 
+// SetFrozenAt sets the "frozen_at" field.
+func (uu *UserUpdate) SetFrozenAt(t time.Time) *UserUpdate {
+	uu.mutation.SetFrozenAt(t)
+	return uu
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableFrozenAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetFrozenAt(*t)
+	}
+	return uu
+}
+
+// ClearFrozenAt clears the value of the "frozen_at" field.
+func (uu *UserUpdate) ClearFrozenAt() *UserUpdate {
+	uu.mutation.ClearFrozenAt()
+	return uu
+}
+
+// SetFrozenReason sets the "frozen_reason" field.
+func (uu *UserUpdate) SetFrozenReason(s string) *UserUpdate {
+	uu.mutation.SetFrozenReason(s)
+	return uu
+}
+
+// SetNillableFrozenReason sets the "frozen_reason" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableFrozenReason(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetFrozenReason(*s)
+	}
+	return uu
+}
+
+// ClearFrozenReason clears the value of the "frozen_reason" field.
+func (uu *UserUpdate) ClearFrozenReason() *UserUpdate {
+	uu.mutation.ClearFrozenReason()
+	return uu
+}
+
+// SetErasedAt sets the "erased_at" field.
+func (uu *UserUpdate) SetErasedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetErasedAt(t)
+	return uu
+}
+
+// SetNillableErasedAt sets the "erased_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableErasedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetErasedAt(*t)
+	}
+	return uu
+}
+
+// ClearErasedAt clears the value of the "erased_at" field.
+func (uu *UserUpdate) ClearErasedAt() *UserUpdate {
+	uu.mutation.ClearErasedAt()
+	return uu
+}
+
 // AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
 func (uu *UserUpdate) AddTransactionIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddTransactionIDs(ids...)
@@ -161,12 +261,42 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := uu.mutation.EmailUpdatedAt(); ok {
+		_spec.SetField(user.FieldEmailUpdatedAt, field.TypeTime, value)
+	}
+	if uu.mutation.EmailUpdatedAtCleared() {
+		_spec.ClearField(user.FieldEmailUpdatedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.VerifiedAt(); ok {
+		_spec.SetField(user.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if uu.mutation.VerifiedAtCleared() {
+		_spec.ClearField(user.FieldVerifiedAt, field.TypeTime)
+	}
 	if value, ok := uu.mutation.Balance(); ok {
 		_spec.SetField(user.FieldBalance, field.TypeFloat64, value)
 	}
 	if value, ok := uu.mutation.AddedBalance(); ok {
 		_spec.AddField(user.FieldBalance, field.TypeFloat64, value)
 	}
+	if value, ok := uu.mutation.FrozenAt(); ok {
+		_spec.SetField(user.FieldFrozenAt, field.TypeTime, value)
+	}
+	if uu.mutation.FrozenAtCleared() {
+		_spec.ClearField(user.FieldFrozenAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.FrozenReason(); ok {
+		_spec.SetField(user.FieldFrozenReason, field.TypeString, value)
+	}
+	if uu.mutation.FrozenReasonCleared() {
+		_spec.ClearField(user.FieldFrozenReason, field.TypeString)
+	}
+	if value, ok := uu.mutation.ErasedAt(); ok {
+		_spec.SetField(user.FieldErasedAt, field.TypeTime, value)
+	}
+	if uu.mutation.ErasedAtCleared() {
+		_spec.ClearField(user.FieldErasedAt, field.TypeTime)
+	}
 	if uu.mutation.TransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -260,6 +390,46 @@ func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
 	return uuo
 }
 
+// SetEmailUpdatedAt sets the "email_updated_at" field.
+func (uuo *UserUpdateOne) SetEmailUpdatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetEmailUpdatedAt(t)
+	return uuo
+}
+
+// SetNillableEmailUpdatedAt sets the "email_updated_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmailUpdatedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetEmailUpdatedAt(*t)
+	}
+	return uuo
+}
+
+// ClearEmailUpdatedAt clears the value of the "email_updated_at" field.
+func (uuo *UserUpdateOne) ClearEmailUpdatedAt() *UserUpdateOne {
+	uuo.mutation.ClearEmailUpdatedAt()
+	return uuo
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (uuo *UserUpdateOne) SetVerifiedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetVerifiedAt(t)
+	return uuo
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableVerifiedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetVerifiedAt(*t)
+	}
+	return uuo
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (uuo *UserUpdateOne) ClearVerifiedAt() *UserUpdateOne {
+	uuo.mutation.ClearVerifiedAt()
+	return uuo
+}
+
 // SetBalance sets the "balance" field.
 func (uuo *UserUpdateOne) SetBalance(f float64) *UserUpdateOne {
 	uuo.mutation.ResetBalance()
@@ -281,6 +451,66 @@ func (uuo *UserUpdateOne) AddBalance(f float64) *UserUpdateOne {
 	return uuo
 }
 
+// SetFrozenAt sets the "frozen_at" field.
+func (uuo *UserUpdateOne) SetFrozenAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetFrozenAt(t)
+	return uuo
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableFrozenAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetFrozenAt(*t)
+	}
+	return uuo
+}
+
+// ClearFrozenAt clears the value of the "frozen_at" field.
+func (uuo *UserUpdateOne) ClearFrozenAt() *UserUpdateOne {
+	uuo.mutation.ClearFrozenAt()
+	return uuo
+}
+
+// SetFrozenReason sets the "frozen_reason" field.
+func (uuo *UserUpdateOne) SetFrozenReason(s string) *UserUpdateOne {
+	uuo.mutation.SetFrozenReason(s)
+	return uuo
+}
+
+// SetNillableFrozenReason sets the "frozen_reason" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableFrozenReason(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetFrozenReason(*s)
+	}
+	return uuo
+}
+
+// ClearFrozenReason clears the value of the "frozen_reason" field.
+func (uuo *UserUpdateOne) ClearFrozenReason() *UserUpdateOne {
+	uuo.mutation.ClearFrozenReason()
+	return uuo
+}
+
+// SetErasedAt sets the "erased_at" field.
+func (uuo *UserUpdateOne) SetErasedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetErasedAt(t)
+	return uuo
+}
+
+// SetNillableErasedAt sets the "erased_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableErasedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetErasedAt(*t)
+	}
+	return uuo
+}
+
+// ClearErasedAt clears the value of the "erased_at" field.
+func (uuo *UserUpdateOne) ClearErasedAt() *UserUpdateOne {
+	uuo.mutation.ClearErasedAt()
+	return uuo
+}
+
 // AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
 func (uuo *UserUpdateOne) AddTransactionIDs(ids ...int) *UserUpdateOne {
 	uuo.mutation.AddTransactionIDs(ids...)
@@ -394,12 +624,42 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := uuo.mutation.EmailUpdatedAt(); ok {
+		_spec.SetField(user.FieldEmailUpdatedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.EmailUpdatedAtCleared() {
+		_spec.ClearField(user.FieldEmailUpdatedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.VerifiedAt(); ok {
+		_spec.SetField(user.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.VerifiedAtCleared() {
+		_spec.ClearField(user.FieldVerifiedAt, field.TypeTime)
+	}
 	if value, ok := uuo.mutation.Balance(); ok {
 		_spec.SetField(user.FieldBalance, field.TypeFloat64, value)
 	}
 	if value, ok := uuo.mutation.AddedBalance(); ok {
 		_spec.AddField(user.FieldBalance, field.TypeFloat64, value)
 	}
+	if value, ok := uuo.mutation.FrozenAt(); ok {
+		_spec.SetField(user.FieldFrozenAt, field.TypeTime, value)
+	}
+	if uuo.mutation.FrozenAtCleared() {
+		_spec.ClearField(user.FieldFrozenAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.FrozenReason(); ok {
+		_spec.SetField(user.FieldFrozenReason, field.TypeString, value)
+	}
+	if uuo.mutation.FrozenReasonCleared() {
+		_spec.ClearField(user.FieldFrozenReason, field.TypeString)
+	}
+	if value, ok := uuo.mutation.ErasedAt(); ok {
+		_spec.SetField(user.FieldErasedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.ErasedAtCleared() {
+		_spec.ClearField(user.FieldErasedAt, field.TypeTime)
+	}
 	if uuo.mutation.TransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactions-service/ent/predicate"
+	"transactions-service/ent/webhookdelivery"
+	"transactions-service/ent/webhookendpoint"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookEndpointUpdate is the builder for updating WebhookEndpoint entities.
+type WebhookEndpointUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookEndpointMutation
+}
+
+// Where appends a list predicates to the WebhookEndpointUpdate builder.
+func (weu *WebhookEndpointUpdate) Where(ps ...predicate.WebhookEndpoint) *WebhookEndpointUpdate {
+	weu.mutation.Where(ps...)
+	return weu
+}
+
+// SetClient sets the "client" field.
+func (weu *WebhookEndpointUpdate) SetClient(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetClient(s)
+	return weu
+}
+
+// SetNillableClient sets the "client" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableClient(s *string) *WebhookEndpointUpdate {
+	if s != nil {
+		weu.SetClient(*s)
+	}
+	return weu
+}
+
+// SetURL sets the "url" field.
+func (weu *WebhookEndpointUpdate) SetURL(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetURL(s)
+	return weu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableURL(s *string) *WebhookEndpointUpdate {
+	if s != nil {
+		weu.SetURL(*s)
+	}
+	return weu
+}
+
+// SetSecret sets the "secret" field.
+func (weu *WebhookEndpointUpdate) SetSecret(s string) *WebhookEndpointUpdate {
+	weu.mutation.SetSecret(s)
+	return weu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableSecret(s *string) *WebhookEndpointUpdate {
+	if s != nil {
+		weu.SetSecret(*s)
+	}
+	return weu
+}
+
+// SetEventTypes sets the "event_types" field.
+func (weu *WebhookEndpointUpdate) SetEventTypes(s []string) *WebhookEndpointUpdate {
+	weu.mutation.SetEventTypes(s)
+	return weu
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (weu *WebhookEndpointUpdate) AppendEventTypes(s []string) *WebhookEndpointUpdate {
+	weu.mutation.AppendEventTypes(s)
+	return weu
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (weu *WebhookEndpointUpdate) ClearEventTypes() *WebhookEndpointUpdate {
+	weu.mutation.ClearEventTypes()
+	return weu
+}
+
+// SetEnabled sets the "enabled" field.
+func (weu *WebhookEndpointUpdate) SetEnabled(b bool) *WebhookEndpointUpdate {
+	weu.mutation.SetEnabled(b)
+	return weu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableEnabled(b *bool) *WebhookEndpointUpdate {
+	if b != nil {
+		weu.SetEnabled(*b)
+	}
+	return weu
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (weu *WebhookEndpointUpdate) SetConsecutiveFailures(i int) *WebhookEndpointUpdate {
+	weu.mutation.ResetConsecutiveFailures()
+	weu.mutation.SetConsecutiveFailures(i)
+	return weu
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableConsecutiveFailures(i *int) *WebhookEndpointUpdate {
+	if i != nil {
+		weu.SetConsecutiveFailures(*i)
+	}
+	return weu
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (weu *WebhookEndpointUpdate) AddConsecutiveFailures(i int) *WebhookEndpointUpdate {
+	weu.mutation.AddConsecutiveFailures(i)
+	return weu
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (weu *WebhookEndpointUpdate) SetDisabledAt(t time.Time) *WebhookEndpointUpdate {
+	weu.mutation.SetDisabledAt(t)
+	return weu
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (weu *WebhookEndpointUpdate) SetNillableDisabledAt(t *time.Time) *WebhookEndpointUpdate {
+	if t != nil {
+		weu.SetDisabledAt(*t)
+	}
+	return weu
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (weu *WebhookEndpointUpdate) ClearDisabledAt() *WebhookEndpointUpdate {
+	weu.mutation.ClearDisabledAt()
+	return weu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (weu *WebhookEndpointUpdate) SetUpdatedAt(t time.Time) *WebhookEndpointUpdate {
+	weu.mutation.SetUpdatedAt(t)
+	return weu
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (weu *WebhookEndpointUpdate) AddDeliveryIDs(ids ...int) *WebhookEndpointUpdate {
+	weu.mutation.AddDeliveryIDs(ids...)
+	return weu
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (weu *WebhookEndpointUpdate) AddDeliveries(w ...*WebhookDelivery) *WebhookEndpointUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return weu.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookEndpointMutation object of the builder.
+func (weu *WebhookEndpointUpdate) Mutation() *WebhookEndpointMutation {
+	return weu.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (weu *WebhookEndpointUpdate) ClearDeliveries() *WebhookEndpointUpdate {
+	weu.mutation.ClearDeliveries()
+	return weu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (weu *WebhookEndpointUpdate) RemoveDeliveryIDs(ids ...int) *WebhookEndpointUpdate {
+	weu.mutation.RemoveDeliveryIDs(ids...)
+	return weu
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (weu *WebhookEndpointUpdate) RemoveDeliveries(w ...*WebhookDelivery) *WebhookEndpointUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return weu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (weu *WebhookEndpointUpdate) Save(ctx context.Context) (int, error) {
+	weu.defaults()
+	return withHooks(ctx, weu.sqlSave, weu.mutation, weu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weu *WebhookEndpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := weu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (weu *WebhookEndpointUpdate) Exec(ctx context.Context) error {
+	_, err := weu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weu *WebhookEndpointUpdate) ExecX(ctx context.Context) {
+	if err := weu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (weu *WebhookEndpointUpdate) defaults() {
+	if _, ok := weu.mutation.UpdatedAt(); !ok {
+		v := webhookendpoint.UpdateDefaultUpdatedAt()
+		weu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weu *WebhookEndpointUpdate) check() error {
+	if v, ok := weu.mutation.GetClient(); ok {
+		if err := webhookendpoint.ClientValidator(v); err != nil {
+			return &ValidationError{Name: "client", err: fmt.Errorf(`ent: validator failed for field "WebhookEndpoint.client": %w`, err)}
+		}
+	}
+	if v, ok := weu.mutation.URL(); ok {
+		if err := webhookendpoint.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookEndpoint.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weu *WebhookEndpointUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := weu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookendpoint.Table, webhookendpoint.Columns, sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeInt))
+	if ps := weu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weu.mutation.GetClient(); ok {
+		_spec.SetField(webhookendpoint.FieldClient, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.URL(); ok {
+		_spec.SetField(webhookendpoint.FieldURL, field.TypeString, value)
+	}
+	if weu.mutation.WalletIDCleared() {
+		_spec.ClearField(webhookendpoint.FieldWalletID, field.TypeInt)
+	}
+	if value, ok := weu.mutation.Secret(); ok {
+		_spec.SetField(webhookendpoint.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.EventTypes(); ok {
+		_spec.SetField(webhookendpoint.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := weu.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookendpoint.FieldEventTypes, value)
+		})
+	}
+	if weu.mutation.EventTypesCleared() {
+		_spec.ClearField(webhookendpoint.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := weu.mutation.Enabled(); ok {
+		_spec.SetField(webhookendpoint.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := weu.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(webhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := weu.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(webhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := weu.mutation.DisabledAt(); ok {
+		_spec.SetField(webhookendpoint.FieldDisabledAt, field.TypeTime, value)
+	}
+	if weu.mutation.DisabledAtCleared() {
+		_spec.ClearField(webhookendpoint.FieldDisabledAt, field.TypeTime)
+	}
+	if value, ok := weu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookendpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if weu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookendpoint.DeliveriesTable,
+			Columns: []string{webhookendpoint.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !weu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookendpoint.DeliveriesTable,
+			Columns: []string{webhookendpoint.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookendpoint.DeliveriesTable,
+			Columns: []string{webhookendpoint.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, weu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookendpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	weu.mutation.done = true
+	return n, nil
+}
+
+// WebhookEndpointUpdateOne is the builder for updating a single WebhookEndpoint entity.
+type WebhookEndpointUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookEndpointMutation
+}
+
+// SetClient sets the "client" field.
+func (weuo *WebhookEndpointUpdateOne) SetClient(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetClient(s)
+	return weuo
+}
+
+// SetNillableClient sets the "client" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableClient(s *string) *WebhookEndpointUpdateOne {
+	if s != nil {
+		weuo.SetClient(*s)
+	}
+	return weuo
+}
+
+// SetURL sets the "url" field.
+func (weuo *WebhookEndpointUpdateOne) SetURL(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetURL(s)
+	return weuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableURL(s *string) *WebhookEndpointUpdateOne {
+	if s != nil {
+		weuo.SetURL(*s)
+	}
+	return weuo
+}
+
+// SetSecret sets the "secret" field.
+func (weuo *WebhookEndpointUpdateOne) SetSecret(s string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetSecret(s)
+	return weuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableSecret(s *string) *WebhookEndpointUpdateOne {
+	if s != nil {
+		weuo.SetSecret(*s)
+	}
+	return weuo
+}
+
+// SetEventTypes sets the "event_types" field.
+func (weuo *WebhookEndpointUpdateOne) SetEventTypes(s []string) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetEventTypes(s)
+	return weuo
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (weuo *WebhookEndpointUpdateOne) AppendEventTypes(s []string) *WebhookEndpointUpdateOne {
+	weuo.mutation.AppendEventTypes(s)
+	return weuo
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (weuo *WebhookEndpointUpdateOne) ClearEventTypes() *WebhookEndpointUpdateOne {
+	weuo.mutation.ClearEventTypes()
+	return weuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (weuo *WebhookEndpointUpdateOne) SetEnabled(b bool) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetEnabled(b)
+	return weuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableEnabled(b *bool) *WebhookEndpointUpdateOne {
+	if b != nil {
+		weuo.SetEnabled(*b)
+	}
+	return weuo
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (weuo *WebhookEndpointUpdateOne) SetConsecutiveFailures(i int) *WebhookEndpointUpdateOne {
+	weuo.mutation.ResetConsecutiveFailures()
+	weuo.mutation.SetConsecutiveFailures(i)
+	return weuo
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableConsecutiveFailures(i *int) *WebhookEndpointUpdateOne {
+	if i != nil {
+		weuo.SetConsecutiveFailures(*i)
+	}
+	return weuo
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (weuo *WebhookEndpointUpdateOne) AddConsecutiveFailures(i int) *WebhookEndpointUpdateOne {
+	weuo.mutation.AddConsecutiveFailures(i)
+	return weuo
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (weuo *WebhookEndpointUpdateOne) SetDisabledAt(t time.Time) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetDisabledAt(t)
+	return weuo
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (weuo *WebhookEndpointUpdateOne) SetNillableDisabledAt(t *time.Time) *WebhookEndpointUpdateOne {
+	if t != nil {
+		weuo.SetDisabledAt(*t)
+	}
+	return weuo
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (weuo *WebhookEndpointUpdateOne) ClearDisabledAt() *WebhookEndpointUpdateOne {
+	weuo.mutation.ClearDisabledAt()
+	return weuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (weuo *WebhookEndpointUpdateOne) SetUpdatedAt(t time.Time) *WebhookEndpointUpdateOne {
+	weuo.mutation.SetUpdatedAt(t)
+	return weuo
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (weuo *WebhookEndpointUpdateOne) AddDeliveryIDs(ids ...int) *WebhookEndpointUpdateOne {
+	weuo.mutation.AddDeliveryIDs(ids...)
+	return weuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (weuo *WebhookEndpointUpdateOne) AddDeliveries(w ...*WebhookDelivery) *WebhookEndpointUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return weuo.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookEndpointMutation object of the builder.
+func (weuo *WebhookEndpointUpdateOne) Mutation() *WebhookEndpointMutation {
+	return weuo.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (weuo *WebhookEndpointUpdateOne) ClearDeliveries() *WebhookEndpointUpdateOne {
+	weuo.mutation.ClearDeliveries()
+	return weuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (weuo *WebhookEndpointUpdateOne) RemoveDeliveryIDs(ids ...int) *WebhookEndpointUpdateOne {
+	weuo.mutation.RemoveDeliveryIDs(ids...)
+	return weuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (weuo *WebhookEndpointUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *WebhookEndpointUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return weuo.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the WebhookEndpointUpdate builder.
+func (weuo *WebhookEndpointUpdateOne) Where(ps ...predicate.WebhookEndpoint) *WebhookEndpointUpdateOne {
+	weuo.mutation.Where(ps...)
+	return weuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (weuo *WebhookEndpointUpdateOne) Select(field string, fields ...string) *WebhookEndpointUpdateOne {
+	weuo.fields = append([]string{field}, fields...)
+	return weuo
+}
+
+// Save executes the query and returns the updated WebhookEndpoint entity.
+func (weuo *WebhookEndpointUpdateOne) Save(ctx context.Context) (*WebhookEndpoint, error) {
+	weuo.defaults()
+	return withHooks(ctx, weuo.sqlSave, weuo.mutation, weuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weuo *WebhookEndpointUpdateOne) SaveX(ctx context.Context) *WebhookEndpoint {
+	node, err := weuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (weuo *WebhookEndpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := weuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weuo *WebhookEndpointUpdateOne) ExecX(ctx context.Context) {
+	if err := weuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (weuo *WebhookEndpointUpdateOne) defaults() {
+	if _, ok := weuo.mutation.UpdatedAt(); !ok {
+		v := webhookendpoint.UpdateDefaultUpdatedAt()
+		weuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weuo *WebhookEndpointUpdateOne) check() error {
+	if v, ok := weuo.mutation.GetClient(); ok {
+		if err := webhookendpoint.ClientValidator(v); err != nil {
+			return &ValidationError{Name: "client", err: fmt.Errorf(`ent: validator failed for field "WebhookEndpoint.client": %w`, err)}
+		}
+	}
+	if v, ok := weuo.mutation.URL(); ok {
+		if err := webhookendpoint.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookEndpoint.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weuo *WebhookEndpointUpdateOne) sqlSave(ctx context.Context) (_node *WebhookEndpoint, err error) {
+	if err := weuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookendpoint.Table, webhookendpoint.Columns, sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeInt))
+	id, ok := weuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookEndpoint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := weuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookendpoint.FieldID)
+		for _, f := range fields {
+			if !webhookendpoint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookendpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := weuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weuo.mutation.GetClient(); ok {
+		_spec.SetField(webhookendpoint.FieldClient, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.URL(); ok {
+		_spec.SetField(webhookendpoint.FieldURL, field.TypeString, value)
+	}
+	if weuo.mutation.WalletIDCleared() {
+		_spec.ClearField(webhookendpoint.FieldWalletID, field.TypeInt)
+	}
+	if value, ok := weuo.mutation.Secret(); ok {
+		_spec.SetField(webhookendpoint.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.EventTypes(); ok {
+		_spec.SetField(webhookendpoint.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := weuo.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookendpoint.FieldEventTypes, value)
+		})
+	}
+	if weuo.mutation.EventTypesCleared() {
+		_spec.ClearField(webhookendpoint.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := weuo.mutation.Enabled(); ok {
+		_spec.SetField(webhookendpoint.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := weuo.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(webhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := weuo.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(webhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := weuo.mutation.DisabledAt(); ok {
+		_spec.SetField(webhookendpoint.FieldDisabledAt, field.TypeTime, value)
+	}
+	if weuo.mutation.DisabledAtCleared() {
+		_spec.ClearField(webhookendpoint.FieldDisabledAt, field.TypeTime)
+	}
+	if value, ok := weuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookendpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if weuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookendpoint.DeliveriesTable,
+			Columns: []string{webhookendpoint.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !weuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookendpoint.DeliveriesTable,
+			Columns: []string{webhookendpoint.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookendpoint.DeliveriesTable,
+			Columns: []string{webhookendpoint.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WebhookEndpoint{config: weuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, weuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookendpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	weuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: transactions/v1/transactions.proto
+
+package transactionsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	TransactionsService_AddMoney_FullMethodName      = "/transactions.v1.TransactionsService/AddMoney"
+	TransactionsService_TransferMoney_FullMethodName = "/transactions.v1.TransactionsService/TransferMoney"
+	TransactionsService_GetWallet_FullMethodName     = "/transactions.v1.TransactionsService/GetWallet"
+)
+
+// TransactionsServiceClient is the client API for TransactionsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TransactionsService moves money into and between wallets.
+type TransactionsServiceClient interface {
+	// AddMoney credits an amount to a user's wallet.
+	AddMoney(ctx context.Context, in *AddMoneyRequest, opts ...grpc.CallOption) (*AddMoneyResponse, error)
+	// TransferMoney moves an amount from one user's wallet to another's.
+	TransferMoney(ctx context.Context, in *TransferMoneyRequest, opts ...grpc.CallOption) (*TransferMoneyResponse, error)
+	// GetWallet returns a user's wallet.
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
+}
+
+type transactionsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransactionsServiceClient(cc grpc.ClientConnInterface) TransactionsServiceClient {
+	return &transactionsServiceClient{cc}
+}
+
+func (c *transactionsServiceClient) AddMoney(ctx context.Context, in *AddMoneyRequest, opts ...grpc.CallOption) (*AddMoneyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMoneyResponse)
+	err := c.cc.Invoke(ctx, TransactionsService_AddMoney_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsServiceClient) TransferMoney(ctx context.Context, in *TransferMoneyRequest, opts ...grpc.CallOption) (*TransferMoneyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferMoneyResponse)
+	err := c.cc.Invoke(ctx, TransactionsService_TransferMoney_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionsServiceClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletResponse)
+	err := c.cc.Invoke(ctx, TransactionsService_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransactionsServiceServer is the server API for TransactionsService service.
+// All implementations must embed UnimplementedTransactionsServiceServer
+// for forward compatibility
+//
+// TransactionsService moves money into and between wallets.
+type TransactionsServiceServer interface {
+	// AddMoney credits an amount to a user's wallet.
+	AddMoney(context.Context, *AddMoneyRequest) (*AddMoneyResponse, error)
+	// TransferMoney moves an amount from one user's wallet to another's.
+	TransferMoney(context.Context, *TransferMoneyRequest) (*TransferMoneyResponse, error)
+	// GetWallet returns a user's wallet.
+	GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error)
+	mustEmbedUnimplementedTransactionsServiceServer()
+}
+
+// UnimplementedTransactionsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTransactionsServiceServer struct {
+}
+
+func (UnimplementedTransactionsServiceServer) AddMoney(context.Context, *AddMoneyRequest) (*AddMoneyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMoney not implemented")
+}
+func (UnimplementedTransactionsServiceServer) TransferMoney(context.Context, *TransferMoneyRequest) (*TransferMoneyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferMoney not implemented")
+}
+func (UnimplementedTransactionsServiceServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedTransactionsServiceServer) mustEmbedUnimplementedTransactionsServiceServer() {}
+
+// UnsafeTransactionsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransactionsServiceServer will
+// result in compilation errors.
+type UnsafeTransactionsServiceServer interface {
+	mustEmbedUnimplementedTransactionsServiceServer()
+}
+
+func RegisterTransactionsServiceServer(s grpc.ServiceRegistrar, srv TransactionsServiceServer) {
+	s.RegisterService(&TransactionsService_ServiceDesc, srv)
+}
+
+func _TransactionsService_AddMoney_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMoneyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServiceServer).AddMoney(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionsService_AddMoney_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServiceServer).AddMoney(ctx, req.(*AddMoneyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionsService_TransferMoney_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferMoneyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServiceServer).TransferMoney(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionsService_TransferMoney_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServiceServer).TransferMoney(ctx, req.(*TransferMoneyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionsService_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionsServiceServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionsService_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionsServiceServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransactionsService_ServiceDesc is the grpc.ServiceDesc for TransactionsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransactionsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "transactions.v1.TransactionsService",
+	HandlerType: (*TransactionsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddMoney",
+			Handler:    _TransactionsService_AddMoney_Handler,
+		},
+		{
+			MethodName: "TransferMoney",
+			Handler:    _TransactionsService_TransferMoney_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _TransactionsService_GetWallet_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "transactions/v1/transactions.proto",
+}
@@ -0,0 +1,30 @@
+package graph
+
+import (
+	"net/http"
+	"transactions-service/ent"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/99designs/gqlgen/graphql/playground"
+)
+
+// DefaultComplexityLimit is the query complexity allowed when no limit is configured.
+const DefaultComplexityLimit = 1000
+
+// NewHandler serves GraphQL queries over GET and POST. Queries whose
+// complexity exceeds complexityLimit are rejected before they are executed.
+func NewHandler(client *ent.Client, complexityLimit int) http.Handler {
+	srv := handler.New(NewSchema(client))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(complexityLimit))
+	return srv
+}
+
+// NewPlayground serves a GraphQL playground that sends its queries to endpoint.
+func NewPlayground(endpoint string) http.Handler {
+	return playground.Handler("Transactions GraphQL", endpoint)
+}
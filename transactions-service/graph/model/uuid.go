@@ -0,0 +1,27 @@
+// Package model holds GraphQL scalars that are not provided by gqlgen.
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+)
+
+// MarshalUUID writes a UUID as a GraphQL string.
+func MarshalUUID(u uuid.UUID) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.Quote(u.String()))
+	})
+}
+
+// UnmarshalUUID reads a UUID from a GraphQL string.
+func UnmarshalUUID(v any) (uuid.UUID, error) {
+	s, ok := v.(string)
+	if !ok {
+		return uuid.Nil, fmt.Errorf("%T is not a string", v)
+	}
+	return uuid.Parse(s)
+}
@@ -0,0 +1,79 @@
+// Package graph serves the read-only GraphQL API over the ent schema.
+package graph
+
+import (
+	"errors"
+	"transactions-service/ent"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// This file will not be regenerated automatically.
+//
+// It serves as dependency injection for your app, add any dependencies you require here.
+
+const (
+	// DefaultPageSize is the page size of connections queried without first or last.
+	DefaultPageSize = 50
+	// MaxPageSize caps first and last of every connection.
+	MaxPageSize = 500
+)
+
+// User and transaction IDs are not globally unique, so a bare ID does not
+// identify a node.
+var errNodeLookup = errors.New("node lookups by ID are not supported, query users or transactions with a where filter instead")
+
+// Resolver is the root resolver of the GraphQL API.
+type Resolver struct {
+	client *ent.Client
+}
+
+// NewSchema creates the executable GraphQL schema. Connections are counted in
+// the query complexity as many times as the number of items they may return.
+func NewSchema(client *ent.Client) graphql.ExecutableSchema {
+	config := Config{
+		Resolvers: &Resolver{client: client},
+	}
+
+	config.Complexity.Query.Users = func(childComplexity int, _ *entgql.Cursor[int], first *int, _ *entgql.Cursor[int], last *int, _ *ent.UserOrder, _ *ent.UserWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	config.Complexity.Query.Transactions = func(childComplexity int, _ *entgql.Cursor[int], first *int, _ *entgql.Cursor[int], last *int, _ *ent.TransactionOrder, _ *ent.TransactionWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	config.Complexity.User.Transactions = func(childComplexity int, _ *entgql.Cursor[int], first *int, _ *entgql.Cursor[int], last *int, _ *ent.TransactionOrder, _ *ent.TransactionWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	return NewExecutableSchema(config)
+}
+
+// pageSize defaults and caps the page size of a connection query.
+func pageSize(first *int, last *int) (*int, *int) {
+	if first == nil && last == nil {
+		n := DefaultPageSize
+		return &n, nil
+	}
+	if first != nil && *first > MaxPageSize {
+		n := MaxPageSize
+		first = &n
+	}
+	if last != nil && *last > MaxPageSize {
+		n := MaxPageSize
+		last = &n
+	}
+	return first, last
+}
+
+func connectionComplexity(childComplexity int, first *int, last *int) int {
+	first, last = pageSize(first, last)
+	n := 0
+	if first != nil {
+		n = *first
+	}
+	if last != nil && *last > n {
+		n = *last
+	}
+	return 1 + n*childComplexity
+}
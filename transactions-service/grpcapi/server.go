@@ -0,0 +1,134 @@
+// Package grpcapi serves the gRPC API of transactions-service next to the REST
+// API, on top of the same business logic.
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"shared/auth"
+	"shared/ratelimit"
+	"time"
+	"transactions-service/common/problems"
+	transactionsv1 "transactions-service/gen/transactions/v1"
+	"transactions-service/services"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+)
+
+// DefaultTimeout bounds calls whose client did not set a deadline.
+const DefaultTimeout = 15 * time.Second
+
+// NewServer creates a gRPC server with the transactions service and reflection registered.
+// Calls are authenticated with the API key in their x-api-key metadata or the
+// access token in their authorization metadata, then rate limited.
+func NewServer(transactions *services.TransactionsService, verifier *auth.Verifier, apiKeys auth.APIKeyResolver, limiter *ratelimit.Limiter) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			deadlineInterceptor(DefaultTimeout),
+			errorInterceptor,
+			authInterceptor(verifier, apiKeys),
+			rateLimitInterceptor(limiter),
+		),
+	)
+
+	transactionsv1.RegisterTransactionsServiceServer(server, NewTransactionsServer(transactions))
+	reflection.Register(server)
+
+	return server
+}
+
+// deadlineInterceptor applies timeout to calls that arrive without a deadline.
+func deadlineInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return handler(ctx, req)
+	}
+}
+
+// errorInterceptor converts domain errors returned by handlers into gRPC statuses.
+func errorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, statusFor(ctx, err)
+	}
+	return resp, nil
+}
+
+// authInterceptor stores the principal of calls that carry an API key or a
+// bearer access token in their metadata, with the step-up of token callers. Calls without credentials proceed
+// unauthenticated and are rejected by the handlers that need a caller.
+func authInterceptor(verifier *auth.Verifier, apiKeys auth.APIKeyResolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		if keys := md.Get(auth.APIKeyHeader); len(keys) > 0 {
+			p, err := apiKeys.ResolveAPIKey(ctx, keys[0])
+			if errors.Is(err, auth.ErrInvalidAPIKey) {
+				return nil, problems.New(problems.CodeUnauthorized, err.Error())
+			}
+			if err != nil {
+				return nil, problems.New(problems.CodeServiceUnavailable, "the API key could not be verified")
+			}
+			return handler(auth.WithPrincipal(ctx, p), req)
+		}
+
+		values := md.Get("authorization")
+		if len(values) == 0 {
+			return handler(ctx, req)
+		}
+
+		token, ok := auth.BearerToken(values[0])
+		if !ok {
+			return nil, problems.New(problems.CodeUnauthorized, "malformed authorization metadata")
+		}
+		claims, err := verifier.Verify(ctx, token, auth.TokenTypeAccess)
+		if err != nil {
+			return nil, problems.New(problems.CodeUnauthorized, err.Error())
+		}
+		p := auth.PrincipalFromClaims(claims)
+		if stepUp := md.Get(auth.StepUpHeader); len(stepUp) > 0 {
+			if err := verifier.StepUp(ctx, p, stepUp[0]); err != nil {
+				return nil, problems.New(problems.CodeUnauthorized, err.Error())
+			}
+		}
+		return handler(auth.WithPrincipal(ctx, p), req)
+	}
+}
+
+// rateLimitInterceptor rejects the calls of callers over one of their limits
+// and reports the state of the most constraining limit in the response
+// headers. When the store fails, calls are rejected like those over their
+// limits.
+func rateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var ip string
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			ip = p.Addr.String()
+			if host, _, err := net.SplitHostPort(ip); err == nil {
+				ip = host
+			}
+		}
+
+		d, err := limiter.Allow(ctx, info.FullMethod, ratelimit.Subjects(ctx, ip))
+		if err != nil {
+			log.Printf("error applying rate limits to %s: %v", info.FullMethod, err)
+		}
+
+		if headers := d.Headers(); headers != nil {
+			grpc.SetHeader(ctx, metadata.New(headers))
+		}
+		if !d.Allowed {
+			return nil, d.Problem()
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,39 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"transactions-service/services"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain identifies the service in the ErrorInfo details of a status.
+const errorDomain = "transactions-service"
+
+// statusFor converts an error into a gRPC status carrying the problem code as
+// the reason of an ErrorInfo detail.
+func statusFor(ctx context.Context, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.DeadlineExceeded) || ctx.Err() == context.DeadlineExceeded {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	p := services.ProblemFor(err)
+	st := status.New(p.Code.GRPCCode(), p.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(p.Code),
+		Domain: errorDomain,
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
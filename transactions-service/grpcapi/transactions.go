@@ -0,0 +1,100 @@
+package grpcapi
+
+import (
+	"context"
+	"shared/auth"
+	"shared/messages"
+	transactionsv1 "transactions-service/gen/transactions/v1"
+	"transactions-service/services"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TransactionsServer implements transactionsv1.TransactionsServiceServer.
+type TransactionsServer struct {
+	transactionsv1.UnimplementedTransactionsServiceServer
+	transactions *services.TransactionsService
+}
+
+func NewTransactionsServer(transactions *services.TransactionsService) *TransactionsServer {
+	return &TransactionsServer{transactions: transactions}
+}
+
+func (s *TransactionsServer) AddMoney(ctx context.Context, req *transactionsv1.AddMoneyRequest) (*transactionsv1.AddMoneyResponse, error) {
+	requestID, err := uuid.Parse(req.GetRequestId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "request_id must be a UUID")
+	}
+
+	if err := authorize(ctx, int(req.GetUserId()), auth.ScopeTransferWrite); err != nil {
+		return nil, err
+	}
+
+	u, err := s.transactions.AddMoney(ctx, int(req.GetUserId()), req.GetAmount(), requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transactionsv1.AddMoneyResponse{
+		Balance:  u.Balance,
+		Currency: messages.DefaultCurrency,
+	}, nil
+}
+
+func (s *TransactionsServer) TransferMoney(ctx context.Context, req *transactionsv1.TransferMoneyRequest) (*transactionsv1.TransferMoneyResponse, error) {
+	requestID, err := uuid.Parse(req.GetRequestId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "request_id must be a UUID")
+	}
+
+	if err := authorize(ctx, int(req.GetFromUserId()), auth.ScopeTransferWrite); err != nil {
+		return nil, err
+	}
+	if err := s.transactions.CheckStepUp(ctx, req.GetAmount()); err != nil {
+		return nil, err
+	}
+
+	to, err := s.transactions.Recipient(ctx, int(req.GetToUserId()), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.transactions.TransferMoney(ctx, int(req.GetFromUserId()), to, req.GetAmount(), requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transactionsv1.TransferMoneyResponse{RequestId: transfer.ID.String()}, nil
+}
+
+func (s *TransactionsServer) GetWallet(ctx context.Context, req *transactionsv1.GetWalletRequest) (*transactionsv1.GetWalletResponse, error) {
+	if err := authorize(ctx, int(req.GetUserId()), auth.ScopeWalletRead); err != nil {
+		return nil, err
+	}
+
+	u, err := s.transactions.GetWallet(ctx, int(req.GetUserId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &transactionsv1.GetWalletResponse{
+		Wallet: &transactionsv1.Wallet{
+			UserId:    int64(u.ID),
+			Email:     u.Email,
+			Balance:   u.Balance,
+			Currency:  messages.DefaultCurrency,
+			CreatedAt: timestamppb.New(u.CreatedAt),
+		},
+	}, nil
+}
+
+// authorize checks that the caller was granted scope and owns the wallet of userID.
+func authorize(ctx context.Context, userID int, scope string) error {
+	if err := auth.RequireScope(ctx, scope); err != nil {
+		return err
+	}
+	return auth.AuthorizeUser(ctx, userID)
+}
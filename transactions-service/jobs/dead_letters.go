@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+	"transactions-service/ent"
+	"transactions-service/ent/deadletter"
+)
+
+// PurgeDeadLetters returns a job that deletes discarded dead letters that were
+// last updated more than retention ago.
+func PurgeDeadLetters(client *ent.Client, retention time.Duration) Job {
+	return Job{
+		Name:     "purge-dead-letters",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			n, err := client.DeadLetter.Delete().
+				Where(
+					deadletter.StatusNEQ(deadletter.StatusPending),
+					deadletter.UpdatedAtLT(time.Now().Add(-retention)),
+				).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				log.Printf("purged %d resolved dead letters", n)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+	"transactions-service/ent"
+	"transactions-service/ent/lease"
+
+	"github.com/google/uuid"
+)
+
+// Lease is a named, expiring lock stored in the database. Only the replica
+// holding a lease runs the job guarded by it, so any number of replicas can
+// be started without a job running twice.
+type Lease struct {
+	client *ent.Client
+	name   string
+	holder string
+	ttl    time.Duration
+}
+
+// NewLease creates a lease for the given job name held by holder.
+func NewLease(client *ent.Client, name string, holder string, ttl time.Duration) *Lease {
+	return &Lease{client: client, name: name, holder: holder, ttl: ttl}
+}
+
+// TryAcquire takes the lease if it is free or expired, or renews it if this
+// holder already owns it. It reports whether the lease is held afterwards.
+func (l *Lease) TryAcquire(ctx context.Context) (bool, error) {
+	now := time.Now()
+
+	n, err := l.client.Lease.Update().
+		Where(
+			lease.IDEQ(l.name),
+			lease.Or(lease.HolderEQ(l.holder), lease.ExpiresAtLT(now)),
+		).
+		SetHolder(l.holder).
+		SetExpiresAt(now.Add(l.ttl)).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("renewing lease %s: %w", l.name, err)
+	}
+	if n > 0 {
+		return true, nil
+	}
+
+	_, err = l.client.Lease.Create().
+		SetID(l.name).
+		SetHolder(l.holder).
+		SetAcquiredAt(now).
+		SetExpiresAt(now.Add(l.ttl)).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("creating lease %s: %w", l.name, err)
+	}
+	return true, nil
+}
+
+// Renew extends the lease while this holder still owns it. It reports false
+// once the lease expired, as another replica may have taken it since.
+func (l *Lease) Renew(ctx context.Context) (bool, error) {
+	now := time.Now()
+	n, err := l.client.Lease.Update().
+		Where(lease.IDEQ(l.name), lease.HolderEQ(l.holder), lease.ExpiresAtGTE(now)).
+		SetExpiresAt(now.Add(l.ttl)).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("renewing lease %s: %w", l.name, err)
+	}
+	return n > 0, nil
+}
+
+// Release gives the lease up so another replica can take it without waiting for it to expire.
+func (l *Lease) Release(ctx context.Context) error {
+	_, err := l.client.Lease.Delete().
+		Where(lease.IDEQ(l.name), lease.HolderEQ(l.holder)).
+		Exec(ctx)
+	return err
+}
+
+// InstanceID identifies this replica as a lease holder. It is taken from
+// INSTANCE_ID when set and is otherwise the host name with a random suffix,
+// so that replicas sharing a host name never hold a lease together.
+func InstanceID() string {
+	if id := os.Getenv("INSTANCE_ID"); id != "" {
+		return id
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return host + "-" + uuid.NewString()[:8]
+}
@@ -0,0 +1,100 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+	"transactions-service/ent"
+)
+
+// Job is a periodic background task.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Runner runs background jobs on the one replica that holds each job's lease.
+type Runner struct {
+	client *ent.Client
+	holder string
+}
+
+// NewRunner creates a runner whose leases are held under holder.
+func NewRunner(client *ent.Client, holder string) *Runner {
+	return &Runner{client: client, holder: holder}
+}
+
+// Start runs job every interval in the background until ctx is cancelled. The
+// lease lives for twice the interval and is renewed while the job runs, so a
+// replica that stops renewing it hands the job over to another replica within
+// two intervals, and a run that loses it is cancelled.
+func (r *Runner) Start(ctx context.Context, job Job) {
+	l := NewLease(r.client, job.Name, r.holder, 2*job.Interval)
+
+	go func() {
+		ticker := time.NewTicker(job.Interval)
+		defer ticker.Stop()
+
+		for {
+			r.tick(ctx, l, job)
+
+			select {
+			case <-ctx.Done():
+				if err := l.Release(context.Background()); err != nil {
+					log.Printf("error releasing lease of job %s: %v", job.Name, err)
+				}
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (r *Runner) tick(ctx context.Context, l *Lease, job Job) {
+	held, err := l.TryAcquire(ctx)
+	if err != nil {
+		log.Printf("error acquiring lease of job %s: %v", job.Name, err)
+		return
+	}
+	if !held {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go r.heartbeat(ctx, cancel, l, job)
+
+	if err := job.Run(ctx); err != nil {
+		log.Printf("job %s failed: %v", job.Name, err)
+	}
+}
+
+// heartbeat renews the lease of a running job every third of its lifetime,
+// until ctx is done. Once the lease cannot be renewed, another replica may run
+// the job, so the run is cancelled.
+func (r *Runner) heartbeat(ctx context.Context, cancel context.CancelFunc, l *Lease, job Job) {
+	ticker := time.NewTicker(l.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		held, err := l.Renew(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("error renewing lease of job %s: %v", job.Name, err)
+		}
+		if err != nil || !held {
+			log.Printf("lost lease of job %s, stopping it", job.Name)
+			cancel()
+			return
+		}
+	}
+}
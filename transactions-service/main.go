@@ -2,17 +2,33 @@ package main
 
 import (
 	"context"
+	"fmt"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"log"
+	"net"
 	"os"
+	"shared/auth"
+	"shared/ratelimit"
+	"strconv"
+	"time"
 	"transactions-service/controllers"
 	"transactions-service/ent"
+	"transactions-service/graph"
+	"transactions-service/grpcapi"
+	"transactions-service/jobs"
+	"transactions-service/limitstore"
 	"transactions-service/messaging"
+	"transactions-service/outbox"
+	"transactions-service/services"
+	"transactions-service/stream"
+	"transactions-service/webhooks"
 
 	"github.com/gin-gonic/gin"
 	_ "github.com/lib/pq"
 	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
 	_ "transactions-service/docs"
 )
 
@@ -21,7 +37,17 @@ import (
 // @description This is a sample Transaction Service for a digital wallet.
 
 // @host localhost:8081
-// @BasePath /api/v1
+// @BasePath /api
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Access token issued by user-service, as "Bearer <token>"
+
+// @securityDefinitions.apikey APIKey
+// @in header
+// @name X-API-Key
+// @description API key created in user-service
 func main() {
 	client, err := initializeDatabase()
 	if err != nil {
@@ -35,9 +61,67 @@ func main() {
 	}
 	defer natsConn.Close()
 
-	messaging.SetupNATS(natsConn, client)
+	natsConfig, err := messaging.LoadConfig()
+	if err != nil {
+		log.Fatalf("invalid NATS configuration: %v", err)
+	}
+
+	if err := messaging.SetupNATS(natsConn, client, natsConfig); err != nil {
+		log.Fatalf("failed to subscribe to NATS subjects: %v", err)
+	}
+
+	hub := stream.NewHub(natsConn)
+	if err := hub.Start(); err != nil {
+		log.Fatalf("failed to subscribe to event streams: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := startJobs(ctx, client, natsConn); err != nil {
+		log.Fatalf("failed to start background jobs: %v", err)
+	}
+
+	threshold, err := stepUpThreshold()
+	if err != nil {
+		log.Fatalf("invalid step-up configuration: %v", err)
+	}
+	transactionsService := services.NewTransactionsService(client, threshold, services.NewRemoteAliases(natsConn))
+
+	promotionsWallet, err := promotionsWalletID()
+	if err != nil {
+		log.Fatalf("invalid referral configuration: %v", err)
+	}
+	referralBonuses := services.NewReferralBonuses(transactionsService, promotionsWallet)
+	if err := messaging.SubscribePayReferralBonus(natsConn, referralBonuses, natsConfig); err != nil {
+		log.Fatalf("failed to subscribe to referral bonuses: %v", err)
+	}
+
+	// Sessions revoked in user-service are denied here as well. A deny-list
+	// that cannot be loaded only misses the revocations made before startup.
+	denied := auth.NewDenyList()
+	if err := denied.Subscribe(natsConn); err != nil {
+		log.Fatalf("failed to subscribe to session revocations: %v", err)
+	}
+	if err := denied.Load(ctx, natsConn); err != nil {
+		log.Printf("error loading revoked sessions: %v", err)
+	}
+	verifier := auth.NewVerifier(auth.NewRemoteKeySet(jwksURL()), denied)
+	apiKeys := auth.NewRemoteAPIKeys(natsConn)
+
+	limiter, err := newLimiter(client)
+	if err != nil {
+		log.Fatalf("invalid rate limit configuration: %v", err)
+	}
+
+	go serveGRPC(grpcapi.NewServer(transactionsService, verifier, apiKeys, limiter), ":9091")
 
-	r := setupRouter(client, natsConn)
+	complexityLimit, err := graphQLComplexityLimit()
+	if err != nil {
+		log.Fatalf("invalid GraphQL configuration: %v", err)
+	}
+
+	r := setupRouter(client, transactionsService, hub, verifier, apiKeys, limiter, complexityLimit)
 
 	if err := r.Run(":8081"); err != nil {
 		log.Fatalf("failed to run server: %v", err)
@@ -73,19 +157,209 @@ func initializeNATS() (*nats.Conn, error) {
 	return natsConn, nil
 }
 
+// startJobs Start background jobs, each guarded by a database lease
+func startJobs(ctx context.Context, client *ent.Client, natsConn *nats.Conn) error {
+	retention := 30 * 24 * time.Hour
+	if v := os.Getenv("DEAD_LETTER_RETENTION"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid DEAD_LETTER_RETENTION: %w", err)
+		}
+		retention = d
+	}
+
+	webhookConfig, err := webhooks.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	runner := jobs.NewRunner(client, jobs.InstanceID())
+	runner.Start(ctx, jobs.PurgeDeadLetters(client, retention))
+	runner.Start(ctx, outbox.Relay(client, natsConn))
+	runner.Start(ctx, webhooks.Dispatch(client))
+	runner.Start(ctx, webhooks.Deliver(client, webhookConfig))
+
+	return nil
+}
+
+// graphQLComplexityLimit Read the maximum complexity of a GraphQL query
+func graphQLComplexityLimit() (int, error) {
+	v := os.Getenv("GRAPHQL_COMPLEXITY_LIMIT")
+	if v == "" {
+		return graph.DefaultComplexityLimit, nil
+	}
+	limit, err := strconv.Atoi(v)
+	if err != nil || limit <= 0 {
+		return 0, fmt.Errorf("GRAPHQL_COMPLEXITY_LIMIT must be a positive integer, got %q", v)
+	}
+	return limit, nil
+}
+
+// stepUpThreshold Read the amount above which transfers need a step-up token
+func stepUpThreshold() (float64, error) {
+	v := os.Getenv("STEP_UP_THRESHOLD")
+	switch v {
+	case "":
+		return 1000, nil
+	case "off":
+		return 0, nil
+	}
+	threshold, err := strconv.ParseFloat(v, 64)
+	if err != nil || threshold <= 0 {
+		return 0, fmt.Errorf("STEP_UP_THRESHOLD must be a positive amount or off, got %q", v)
+	}
+	return threshold, nil
+}
+
+// promotionsWalletID Read the wallet referral bonuses are paid from, or 0 when they are disabled
+func promotionsWalletID() (int, error) {
+	v := os.Getenv("PROMOTIONS_WALLET_ID")
+	if v == "" {
+		return 0, nil
+	}
+	id, err := strconv.Atoi(v)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("PROMOTIONS_WALLET_ID must be a wallet ID, got %q", v)
+	}
+	return id, nil
+}
+
+// jwksURL Read where user-service publishes the keys access tokens are signed with
+func jwksURL() string {
+	if v := os.Getenv("JWKS_URL"); v != "" {
+		return v
+	}
+	return "http://user-service:8080" + auth.JWKSPath
+}
+
+// defaultRateLimits caps the calls of every user and key and, more tightly,
+// the transfers of every user.
+var defaultRateLimits = ratelimit.Config{
+	Limits: []ratelimit.Limit{
+		{Route: ratelimit.AllRoutes, Subject: ratelimit.SubjectUser, Rate: 10, Period: time.Second, Burst: 20},
+		{Route: ratelimit.AllRoutes, Subject: ratelimit.SubjectAPIKey, Rate: 20, Period: time.Second, Burst: 40},
+		{Route: "POST /api/v1/transferMoney", Subject: ratelimit.SubjectUser, Rate: 10, Period: time.Minute, Burst: 20},
+		{Route: "POST /api/v2/wallets/:id/transfers", Subject: ratelimit.SubjectUser, Rate: 10, Period: time.Minute, Burst: 20},
+		{Route: "POST /api/v2/wallets/:id/payees/:payeeId/transfers", Subject: ratelimit.SubjectUser, Rate: 10, Period: time.Minute, Burst: 20},
+		{Route: "/transactions.v1.TransactionsService/TransferMoney", Subject: ratelimit.SubjectUser, Rate: 10, Period: time.Minute, Burst: 20},
+	},
+	Quotas: []ratelimit.Quota{
+		{Route: "POST /api/v1/transferMoney", Subject: ratelimit.SubjectUser, Limit: 1000, Window: 24 * time.Hour},
+		{Route: "POST /api/v2/wallets/:id/transfers", Subject: ratelimit.SubjectUser, Limit: 1000, Window: 24 * time.Hour},
+		{Route: "POST /api/v2/wallets/:id/payees/:payeeId/transfers", Subject: ratelimit.SubjectUser, Limit: 1000, Window: 24 * time.Hour},
+		{Route: "/transactions.v1.TransactionsService/TransferMoney", Subject: ratelimit.SubjectUser, Limit: 1000, Window: 24 * time.Hour},
+	},
+}
+
+// newLimiter Create the rate limiter with the configured store
+func newLimiter(client *ent.Client) (*ratelimit.Limiter, error) {
+	config, err := ratelimit.LoadConfig(defaultRateLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	var store ratelimit.Store = ratelimit.NewMemoryStore()
+	if config.Store == ratelimit.StoreDatabase {
+		store = limitstore.New(client)
+	}
+	return ratelimit.New(config, store), nil
+}
+
+// serveGRPC Serve the gRPC API
+func serveGRPC(server *grpc.Server, addr string) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("failed to listen for gRPC: %v", err)
+	}
+	if err := server.Serve(lis); err != nil {
+		log.Fatalf("failed to serve gRPC: %v", err)
+	}
+}
+
 // setupRouter Routing
-func setupRouter(client *ent.Client, natsConn *nats.Conn) *gin.Engine {
+func setupRouter(client *ent.Client, transactionsService *services.TransactionsService, hub *stream.Hub, verifier *auth.Verifier, apiKeys auth.APIKeyResolver, limiter *ratelimit.Limiter, complexityLimit int) *gin.Engine {
 	r := gin.Default()
+	// The IP limits apply to the client address, which only trusted proxies
+	// may forward.
+	if err := r.SetTrustedProxies(limiter.TrustedProxies()); err != nil {
+		log.Fatalf("invalid trusted proxies: %v", err)
+	}
+
+	transactionsController := controllers.NewTransactionsController(transactionsService)
+	deadLettersController := controllers.NewDeadLettersController(client)
+	walletsController := controllers.NewWalletsController(transactionsService)
+	payeesController := controllers.NewPayeesController(transactionsService)
+	webhooksController := controllers.NewWebhooksController(client, webhooks.NewService(client))
+	streamController := controllers.NewStreamController(client, transactionsService, hub)
+	adminController := controllers.NewAdminController(client, services.NewAdminService(client))
+
+	authenticate := auth.Middleware(verifier, apiKeys)
+	// limit follows authenticate, so that it knows the caller.
+	limit := ratelimit.Middleware(limiter)
+	walletRead := auth.Scope(auth.ScopeWalletRead)
+	transferWrite := auth.Scope(auth.ScopeTransferWrite)
+	adminOnly := auth.Scope(auth.ScopeAdmin)
+
+	v1 := r.Group("/api/v1", authenticate, limit)
+	{
+		v1.POST("/addMoney", transferWrite, transactionsController.AddMoney)
+		v1.POST("/transferMoney", transferWrite, transactionsController.TransferMoney)
+	}
+
+	admin := v1.Group("/admin", adminOnly)
+	{
+		admin.GET("/dead-letters", deadLettersController.ListDeadLetters)
+		admin.GET("/dead-letters/:id", deadLettersController.GetDeadLetter)
+		admin.DELETE("/dead-letters/:id", deadLettersController.DiscardDeadLetter)
+	}
+
+	v2 := r.Group("/api/v2", authenticate, limit)
+	{
+		v2.GET("/wallets/:id", walletRead, walletsController.GetWallet)
+		v2.POST("/wallets/:id/deposits", transferWrite, walletsController.Deposit)
+		v2.GET("/wallets/:id/events", walletRead, streamController.StreamWalletEvents)
+		v2.GET("/wallets/:id/transfers", walletRead, walletsController.ListTransfers)
+		v2.POST("/wallets/:id/transfers", transferWrite, walletsController.CreateTransfer)
+		v2.GET("/wallets/:id/payees", walletRead, payeesController.ListPayees)
+		v2.POST("/wallets/:id/payees", transferWrite, payeesController.CreatePayee)
+		v2.GET("/wallets/:id/payees/suggestions", walletRead, payeesController.SuggestPayees)
+		v2.GET("/wallets/:id/payees/:payeeId", walletRead, payeesController.GetPayee)
+		v2.PATCH("/wallets/:id/payees/:payeeId", transferWrite, payeesController.UpdatePayee)
+		v2.DELETE("/wallets/:id/payees/:payeeId", transferWrite, payeesController.DeletePayee)
+		v2.POST("/wallets/:id/payees/:payeeId/transfers", transferWrite, payeesController.PayPayee)
+		v2.GET("/transfers/:id", walletRead, walletsController.GetTransfer)
+	}
 
-	transactionsController := controllers.NewTransactionsController(client, natsConn)
+	webhookRoutes := v2.Group("/webhooks", adminOnly)
+	{
+		webhookRoutes.POST("", webhooksController.RegisterWebhook)
+		webhookRoutes.GET("", webhooksController.ListWebhooks)
+		webhookRoutes.GET("/:id", webhooksController.GetWebhook)
+		webhookRoutes.PATCH("/:id", webhooksController.UpdateWebhook)
+		webhookRoutes.DELETE("/:id", webhooksController.DeleteWebhook)
+		webhookRoutes.GET("/:id/deliveries", webhooksController.ListDeliveries)
+		webhookRoutes.GET("/:id/deliveries/:deliveryId", webhooksController.GetDelivery)
+		webhookRoutes.POST("/:id/deliveries/:deliveryId/redeliver", webhooksController.RedeliverDelivery)
+	}
 
-	v1 := r.Group("/api/v1")
+	// Operators reach the back-office routes through the permissions of their roles.
+	backOffice := v2.Group("/admin")
 	{
-		v1.POST("/addMoney", transactionsController.AddMoney)
-		v1.POST("/transferMoney", transactionsController.TransferMoney)
+		backOffice.GET("/wallets", auth.Permission(auth.PermWalletsRead), adminController.SearchWallets)
+		backOffice.GET("/wallets/:id", auth.Permission(auth.PermWalletsRead), adminController.GetWallet)
+		backOffice.GET("/wallets/:id/ledger", auth.Permission(auth.PermLedgerRead), adminController.GetLedger)
+		backOffice.POST("/wallets/:id/freeze", auth.Permission(auth.PermWalletsFreeze), adminController.FreezeWallet)
+		backOffice.POST("/wallets/:id/unfreeze", auth.Permission(auth.PermWalletsFreeze), adminController.UnfreezeWallet)
+		backOffice.GET("/audit-log", auth.Permission(auth.PermAuditRead), adminController.ListAuditLog)
 	}
 
+	graphQLHandler := gin.WrapH(graph.NewHandler(client, complexityLimit))
+	r.GET("/graphql", authenticate, limit, adminOnly, graphQLHandler)
+	r.POST("/graphql", authenticate, limit, adminOnly, graphQLHandler)
+	r.GET("/graphql/playground", gin.WrapH(graph.NewPlayground("/graphql")))
+
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
 	return r
 }
@@ -0,0 +1,67 @@
+package messaging
+
+import (
+	"fmt"
+	"os"
+	"shared/messages"
+	"strconv"
+	"strings"
+)
+
+// Config holds the worker pool configuration of every subscribed subject.
+type Config struct {
+	Pools map[string]PoolConfig
+}
+
+var defaultPools = map[string]PoolConfig{
+	messages.SubjectUserCreated: {Workers: 4, QueueSize: 64, Policy: PolicyReject},
+	messages.SubjectUserUpdated: {Workers: 4, QueueSize: 64, Policy: PolicyReject},
+	messages.SubjectGetBalance:  {Workers: 8, QueueSize: 128, Policy: PolicyReject},
+	// Snapshots read every wallet, so only a few may run at a time.
+	messages.SubjectBalanceSnapshot: {Workers: 1, QueueSize: 4, Policy: PolicyReject},
+	// Data requests are rare and user-service retries them.
+	messages.SubjectExportUserData: {Workers: 2, QueueSize: 16, Policy: PolicyReject},
+	messages.SubjectEraseUser:      {Workers: 1, QueueSize: 16, Policy: PolicyReject},
+	// Referral bonuses are paid in the background and user-service retries them.
+	messages.SubjectPayReferralBonus: {Workers: 2, QueueSize: 32, Policy: PolicyReject},
+	messages.SubjectListDeposits:     {Workers: 2, QueueSize: 32, Policy: PolicyReject},
+}
+
+// LoadConfig reads the worker pool settings from the environment. Every subject can be
+// tuned with NATS_<SUBJECT>_WORKERS, NATS_<SUBJECT>_QUEUE_SIZE and NATS_<SUBJECT>_POLICY,
+// e.g. NATS_GET_BALANCE_WORKERS=16.
+func LoadConfig() (Config, error) {
+	config := Config{Pools: make(map[string]PoolConfig, len(defaultPools))}
+	for subject, pool := range defaultPools {
+		prefix := "NATS_" + strings.ToUpper(strings.ReplaceAll(subject, "-", "_")) + "_"
+
+		var err error
+		if pool.Workers, err = positiveIntFromEnv(prefix+"WORKERS", pool.Workers); err != nil {
+			return Config{}, err
+		}
+		if pool.QueueSize, err = positiveIntFromEnv(prefix+"QUEUE_SIZE", pool.QueueSize); err != nil {
+			return Config{}, err
+		}
+		if v := os.Getenv(prefix + "POLICY"); v != "" {
+			pool.Policy = Policy(strings.ToLower(v))
+			if pool.Policy != PolicyBlock && pool.Policy != PolicyReject {
+				return Config{}, fmt.Errorf("%sPOLICY must be %q or %q", prefix, PolicyBlock, PolicyReject)
+			}
+		}
+
+		config.Pools[subject] = pool
+	}
+	return config, nil
+}
+
+func positiveIntFromEnv(key string, fallback int) (int, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%s must be a positive integer", key)
+	}
+	return n, nil
+}
@@ -0,0 +1,40 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"shared/messages"
+	"strings"
+	"transactions-service/ent"
+)
+
+// ErrDeadLetterNotPending is returned when discarding a dead letter that was already discarded.
+var ErrDeadLetterNotPending = errors.New("dead letter is not pending")
+
+// recordDeadLetter stores a message that could not be processed together with the reason it failed.
+// Dead letters are kept for inspection and are not replayed: the only subject recorded,
+// user-created, is rolled back by user-service when the wallet cannot be created, so a
+// replay would create the wallet of a user that does not exist.
+// The user a message was about is recorded with it, so that erasing the user finds its letters.
+func recordDeadLetter(ctx context.Context, client *ent.Client, subject string, data []byte, reply messages.Reply) {
+	create := client.DeadLetter.Create().
+		SetSubject(subject).
+		SetData(data).
+		SetError(reply.Message).
+		SetErrorCode(reply.ErrorCode)
+	var user messages.UserCreated
+	if subject == messages.SubjectUserCreated && json.Unmarshal(data, &user) == nil {
+		if user.ID != 0 {
+			create.SetUserID(user.ID)
+		}
+		if user.Email != "" {
+			create.SetEmail(strings.ToLower(user.Email))
+		}
+	}
+	_, err := create.Save(ctx)
+	if err != nil {
+		log.Printf("error storing dead letter for %s: %v", subject, err)
+	}
+}
@@ -0,0 +1,33 @@
+package messaging
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	poolQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "nats_worker_pool_queue_depth",
+		Help: "Number of messages waiting for a free worker.",
+	}, []string{"subject"})
+
+	poolInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "nats_worker_pool_in_flight",
+		Help: "Number of messages currently being handled.",
+	}, []string{"subject"})
+
+	poolWorkers = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "nats_worker_pool_workers",
+		Help: "Number of workers started for the subject.",
+	}, []string{"subject"})
+
+	poolProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "nats_worker_pool_processed_total",
+		Help: "Number of messages handled by a worker.",
+	}, []string{"subject"})
+
+	poolRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "nats_worker_pool_rejected_total",
+		Help: "Number of messages rejected because the pool was saturated.",
+	}, []string{"subject"})
+)
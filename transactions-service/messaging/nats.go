@@ -4,107 +4,277 @@ import (
 	"context"
 	"encoding/json"
 	"log"
-	"strconv"
+	"shared/messages"
 	"time"
 	"transactions-service/ent"
+	"transactions-service/ent/event"
 	"transactions-service/ent/user"
 
 	"github.com/nats-io/nats.go"
 )
 
-func SetupNATS(natsConn *nats.Conn, client *ent.Client) {
-	subscribeUserCreated(natsConn, client)
-	subscribeGetBalance(natsConn, client)
+// QueueGroup is the queue group every replica of transactions-service joins, so
+// that each request is handled by exactly one replica.
+const QueueGroup = "transactions-service"
+
+func SetupNATS(natsConn *nats.Conn, client *ent.Client, config Config) error {
+	if err := subscribeUserCreated(natsConn, client, config.Pools[messages.SubjectUserCreated]); err != nil {
+		return err
+	}
+	if err := subscribeUserUpdated(natsConn, client, config.Pools[messages.SubjectUserUpdated]); err != nil {
+		return err
+	}
+	if err := subscribeGetBalance(natsConn, client, config.Pools[messages.SubjectGetBalance]); err != nil {
+		return err
+	}
+	if err := subscribeBalanceSnapshot(natsConn, client, config.Pools[messages.SubjectBalanceSnapshot]); err != nil {
+		return err
+	}
+	if err := subscribeExportUserData(natsConn, client, config.Pools[messages.SubjectExportUserData]); err != nil {
+		return err
+	}
+	if err := subscribeEraseUser(natsConn, client, config.Pools[messages.SubjectEraseUser]); err != nil {
+		return err
+	}
+	return subscribeListDeposits(natsConn, client, config.Pools[messages.SubjectListDeposits])
+}
+
+func subscribeUserCreated(natsConn *nats.Conn, client *ent.Client, poolConfig PoolConfig) error {
+	pool := NewWorkerPool(messages.SubjectUserCreated, poolConfig, func(m *nats.Msg) {
+		handleUserCreated(natsConn, client, m)
+	}, func(m *nats.Msg) {
+		rejectBusy(natsConn, m)
+	})
+	pool.Start()
+
+	_, err := natsConn.QueueSubscribe(messages.SubjectUserCreated, QueueGroup, pool.Submit)
+	return err
 }
 
-func subscribeUserCreated(natsConn *nats.Conn, client *ent.Client) {
-	natsConn.Subscribe("user-created", func(m *nats.Msg) {
-		go handleUserCreated(natsConn, client, m)
+func subscribeUserUpdated(natsConn *nats.Conn, client *ent.Client, poolConfig PoolConfig) error {
+	pool := NewWorkerPool(messages.SubjectUserUpdated, poolConfig, func(m *nats.Msg) {
+		handleUserUpdated(natsConn, client, m)
+	}, func(m *nats.Msg) {
+		rejectBusy(natsConn, m)
 	})
+	pool.Start()
+
+	_, err := natsConn.QueueSubscribe(messages.SubjectUserUpdated, QueueGroup, pool.Submit)
+	return err
 }
 
-func subscribeGetBalance(natsConn *nats.Conn, client *ent.Client) {
-	natsConn.Subscribe("get-balance", func(m *nats.Msg) {
-		go handleGetBalance(natsConn, client, m)
+func subscribeGetBalance(natsConn *nats.Conn, client *ent.Client, poolConfig PoolConfig) error {
+	pool := NewWorkerPool(messages.SubjectGetBalance, poolConfig, func(m *nats.Msg) {
+		handleGetBalance(natsConn, client, m)
+	}, func(m *nats.Msg) {
+		rejectBusy(natsConn, m)
 	})
+	pool.Start()
+
+	_, err := natsConn.QueueSubscribe(messages.SubjectGetBalance, QueueGroup, pool.Submit)
+	return err
+}
+
+func subscribeBalanceSnapshot(natsConn *nats.Conn, client *ent.Client, poolConfig PoolConfig) error {
+	pool := NewWorkerPool(messages.SubjectBalanceSnapshot, poolConfig, func(m *nats.Msg) {
+		handleBalanceSnapshot(natsConn, client, m)
+	}, func(m *nats.Msg) {
+		rejectBusy(natsConn, m)
+	})
+	pool.Start()
+
+	_, err := natsConn.QueueSubscribe(messages.SubjectBalanceSnapshot, QueueGroup, pool.Submit)
+	return err
 }
 
 func handleUserCreated(natsConn *nats.Conn, client *ent.Client, m *nats.Msg) {
-	var userData map[string]interface{}
-	if err := json.Unmarshal(m.Data, &userData); err != nil {
-		sendErrorResponse(natsConn, m.Reply, "error unmarshalling user-created message: "+err.Error())
-		return
+	ctx := context.Background()
+	reply := processUserCreated(ctx, client, m.Data)
+	if !reply.IsSuccess() {
+		log.Println(reply.Message)
+		recordDeadLetter(ctx, client, m.Subject, m.Data, reply)
 	}
+	sendResponse(natsConn, m.Reply, reply)
+}
 
-	email, ok := userData["email"].(string)
-	if !ok {
-		sendErrorResponse(natsConn, m.Reply, "error: email is not a string")
-		return
+func processUserCreated(ctx context.Context, client *ent.Client, data []byte) messages.Reply {
+	var userData messages.UserCreated
+	if err := json.Unmarshal(data, &userData); err != nil {
+		return messages.Error(messages.ErrorCodeInvalidRequest, "error unmarshalling user-created message: "+err.Error())
 	}
 
-	id, ok := userData["id"].(float64)
-	if !ok {
-		sendErrorResponse(natsConn, m.Reply, "error: id is not a float64")
-		return
+	if userData.ID == 0 || userData.Email == "" {
+		return messages.Error(messages.ErrorCodeInvalidRequest, "error: id and email are required")
 	}
 
-	createdAtStr, ok := userData["created_at"].(string)
-	if !ok {
-		sendErrorResponse(natsConn, m.Reply, "error: created_at is not a string")
-		return
+	_, err := client.User.Create().
+		SetID(userData.ID).
+		SetEmail(userData.Email).
+		SetCreatedAt(userData.CreatedAt).
+		SetBalance(0).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// A redelivered message for a user that was already created is not an error.
+		existing, getErr := client.User.Get(ctx, userData.ID)
+		if getErr == nil && existing.Email == userData.Email {
+			return messages.Success("User already exists in transaction-service")
+		}
+		return messages.Error(messages.ErrorCodeUserAlreadyExists, "error creating user: "+err.Error())
 	}
-
-	createdAt, err := time.Parse(time.RFC3339, createdAtStr)
 	if err != nil {
-		sendErrorResponse(natsConn, m.Reply, "error parsing created_at: "+err.Error())
-		return
+		return messages.Error(messages.ErrorCodeInternal, "error creating user: "+err.Error())
 	}
 
-	_, err = client.User.Create().
-		SetID(int(id)).
-		SetEmail(email).
-		SetCreatedAt(createdAt).
-		SetBalance(0).
-		Save(context.Background())
-	if err != nil {
-		sendErrorResponse(natsConn, m.Reply, "error creating user: "+err.Error())
-		return
+	return messages.Success("User created successfully in transaction-service")
+}
+
+func handleUserUpdated(natsConn *nats.Conn, client *ent.Client, m *nats.Msg) {
+	reply := processUserUpdated(context.Background(), client, m.Data)
+	if !reply.IsSuccess() {
+		log.Println(reply.Message)
 	}
+	sendResponse(natsConn, m.Reply, reply)
+}
 
-	response := map[string]interface{}{
-		"status":  "success",
-		"message": "User created successfully in transaction-service",
+func processUserUpdated(ctx context.Context, client *ent.Client, data []byte) messages.Reply {
+	var userData messages.UserUpdated
+	if err := json.Unmarshal(data, &userData); err != nil {
+		return messages.Error(messages.ErrorCodeInvalidRequest, "error unmarshalling user-updated message: "+err.Error())
+	}
+
+	if userData.ID == 0 || userData.Email == "" || userData.UpdatedAt.IsZero() {
+		return messages.Error(messages.ErrorCodeInvalidRequest, "error: id, email and updated_at are required")
+	}
+
+	update := client.User.Update().
+		Where(
+			user.IDEQ(userData.ID),
+			user.Or(user.EmailUpdatedAtIsNil(), user.EmailUpdatedAtLT(userData.UpdatedAt)),
+		).
+		SetEmail(userData.Email).
+		SetEmailUpdatedAt(userData.UpdatedAt)
+	if userData.VerifiedAt != nil {
+		update = update.SetVerifiedAt(*userData.VerifiedAt)
+	} else {
+		update = update.ClearVerifiedAt()
+	}
+	n, err := update.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return messages.Error(messages.ErrorCodeEmailTaken, "error updating user: email "+userData.Email+" is used by another wallet")
 	}
-	responseData, err := json.Marshal(response)
 	if err != nil {
-		sendErrorResponse(natsConn, m.Reply, "error marshalling response: "+err.Error())
-		return
+		return messages.Error(messages.ErrorCodeInternal, "error updating user: "+err.Error())
 	}
-	natsConn.Publish(m.Reply, responseData)
+
+	if n == 0 {
+		exists, err := client.User.Query().Where(user.IDEQ(userData.ID)).Exist(ctx)
+		if err != nil {
+			return messages.Error(messages.ErrorCodeInternal, "error querying user: "+err.Error())
+		}
+		if !exists {
+			return messages.Error(messages.ErrorCodeUserNotFound, "user not found")
+		}
+		// A redelivered or overtaken update is not an error.
+		return messages.Success("User already up to date in transaction-service")
+	}
+
+	return messages.Success("User updated successfully in transaction-service")
 }
 
 func handleGetBalance(natsConn *nats.Conn, client *ent.Client, m *nats.Msg) {
 	email := string(m.Data)
 	u, err := client.User.Query().Where(user.EmailEQ(email)).Only(context.Background())
+	if ent.IsNotFound(err) {
+		sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeUserNotFound, "user not found")
+		return
+	}
 	if err != nil {
-		sendErrorResponse(natsConn, m.Reply, "error querying user: "+err.Error())
+		sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeInternal, "error querying user: "+err.Error())
 		return
 	}
 
-	balanceStr := strconv.FormatFloat(float64(u.Balance), 'f', -1, 32)
-	natsConn.Publish(m.Reply, []byte(balanceStr))
+	sendResponse(natsConn, m.Reply, messages.BalanceReply(u.Balance))
 }
 
-func sendErrorResponse(natsConn *nats.Conn, reply string, errMsg string) {
-	log.Println(errMsg)
-	response := map[string]interface{}{
-		"status":  "error",
-		"message": errMsg,
+func handleBalanceSnapshot(natsConn *nats.Conn, client *ent.Client, m *nats.Msg) {
+	ctx := context.Background()
+	takenAt := time.Now()
+
+	// Sequences are read before balances, so every balance already includes the
+	// event it is reported with; a newer event is applied on top of it later.
+	lastSequence, err := client.Event.Query().Order(ent.Desc(event.FieldID)).FirstID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeInternal, "error querying the last event: "+err.Error())
+		return
+	}
+
+	var sequences []struct {
+		UserID int `json:"user_id"`
+		Max    int `json:"max"`
+	}
+	err = client.Event.Query().
+		Where(event.TypeEQ(messages.EventBalanceChanged)).
+		GroupBy(event.FieldUserID).
+		Aggregate(ent.Max(event.FieldID)).
+		Scan(ctx, &sequences)
+	if err != nil {
+		sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeInternal, "error querying event sequences: "+err.Error())
+		return
+	}
+	walletSequence := make(map[int]int, len(sequences))
+	for _, s := range sequences {
+		walletSequence[s.UserID] = s.Max
+	}
+
+	users, err := client.User.Query().All(ctx)
+	if err != nil {
+		sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeInternal, "error querying users: "+err.Error())
+		return
+	}
+
+	snapshot := messages.BalanceSnapshot{
+		Reply:        messages.Success("balance snapshot"),
+		LastSequence: lastSequence,
+		TakenAt:      takenAt,
+		Balances:     make([]messages.BalanceSnapshotEntry, 0, len(users)),
+	}
+	for _, u := range users {
+		snapshot.Balances = append(snapshot.Balances, messages.BalanceSnapshotEntry{
+			BalanceChanged: messages.BalanceChanged{
+				UserID:   u.ID,
+				Email:    u.Email,
+				Balance:  u.Balance,
+				Currency: messages.DefaultCurrency,
+			},
+			Sequence: walletSequence[u.ID],
+		})
+	}
+
+	publishReply(natsConn, m.Reply, snapshot)
+}
+
+// rejectBusy answers a message the worker pool of its subject had no room for.
+func rejectBusy(natsConn *nats.Conn, m *nats.Msg) {
+	sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeServiceBusy, m.Subject+" workers are saturated, retry later")
+}
+
+func sendResponse(natsConn *nats.Conn, reply string, response messages.Reply) {
+	publishReply(natsConn, reply, response)
+}
+
+func publishReply(natsConn *nats.Conn, reply string, response any) {
+	if reply == "" {
+		return
 	}
 	responseData, err := json.Marshal(response)
 	if err != nil {
-		log.Printf("error marshalling error response: %v", err)
+		log.Printf("error marshalling response: %v", err)
 		return
 	}
 	natsConn.Publish(reply, responseData)
 }
+
+func sendErrorResponse(natsConn *nats.Conn, reply string, code string, errMsg string) {
+	log.Println(errMsg)
+	sendResponse(natsConn, reply, messages.Error(code, errMsg))
+}
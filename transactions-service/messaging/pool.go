@@ -0,0 +1,93 @@
+package messaging
+
+import (
+	"sync"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Policy decides what happens to a message when a worker pool's queue is full.
+type Policy string
+
+const (
+	// PolicyBlock holds the subscription callback until a queue slot frees up, so
+	// further messages back up in the NATS client's pending buffer.
+	PolicyBlock Policy = "block"
+	// PolicyReject answers the message straight away with a SERVICE_BUSY reply.
+	PolicyReject Policy = "reject"
+)
+
+// PoolConfig sizes the worker pool of a subject.
+type PoolConfig struct {
+	Workers   int
+	QueueSize int
+	Policy    Policy
+}
+
+// WorkerPool handles the messages of one subject with a fixed number of workers
+// reading from a bounded queue.
+type WorkerPool struct {
+	subject string
+	config  PoolConfig
+	queue   chan *nats.Msg
+	handle  func(*nats.Msg)
+	reject  func(*nats.Msg)
+	wg      sync.WaitGroup
+}
+
+// NewWorkerPool creates a pool that runs handle for every accepted message and
+// reject for every message turned away under PolicyReject.
+func NewWorkerPool(subject string, config PoolConfig, handle func(*nats.Msg), reject func(*nats.Msg)) *WorkerPool {
+	return &WorkerPool{
+		subject: subject,
+		config:  config,
+		queue:   make(chan *nats.Msg, config.QueueSize),
+		handle:  handle,
+		reject:  reject,
+	}
+}
+
+// Start launches the workers.
+func (p *WorkerPool) Start() {
+	poolWorkers.WithLabelValues(p.subject).Set(float64(p.config.Workers))
+	for i := 0; i < p.config.Workers; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+}
+
+// Stop stops accepting messages and waits for queued ones to be handled.
+func (p *WorkerPool) Stop() {
+	close(p.queue)
+	p.wg.Wait()
+}
+
+// Submit queues a message, applying the pool's policy when the queue is full.
+func (p *WorkerPool) Submit(m *nats.Msg) {
+	if p.config.Policy == PolicyBlock {
+		p.queue <- m
+		poolQueueDepth.WithLabelValues(p.subject).Set(float64(len(p.queue)))
+		return
+	}
+
+	select {
+	case p.queue <- m:
+		poolQueueDepth.WithLabelValues(p.subject).Set(float64(len(p.queue)))
+	default:
+		poolRejected.WithLabelValues(p.subject).Inc()
+		p.reject(m)
+	}
+}
+
+func (p *WorkerPool) work() {
+	defer p.wg.Done()
+
+	inFlight := poolInFlight.WithLabelValues(p.subject)
+	for m := range p.queue {
+		poolQueueDepth.WithLabelValues(p.subject).Set(float64(len(p.queue)))
+		inFlight.Inc()
+		p.handle(m)
+		inFlight.Dec()
+		poolProcessed.WithLabelValues(p.subject).Inc()
+	}
+}
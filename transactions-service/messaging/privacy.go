@@ -0,0 +1,327 @@
+package messaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"shared/messages"
+	"strings"
+	"transactions-service/ent"
+	"transactions-service/ent/deadletter"
+	"transactions-service/ent/event"
+	"transactions-service/ent/payee"
+	"transactions-service/ent/transaction"
+	"transactions-service/ent/user"
+	"transactions-service/ent/webhookdelivery"
+
+	"github.com/nats-io/nats.go"
+)
+
+// maxExportPage caps the transactions of an export-user-data reply, so that
+// replies stay below the NATS payload limit.
+const maxExportPage = 1000
+
+// erasedReason is the freeze reason of erased wallets.
+const erasedReason = "user erased"
+
+func subscribeExportUserData(natsConn *nats.Conn, client *ent.Client, poolConfig PoolConfig) error {
+	pool := NewWorkerPool(messages.SubjectExportUserData, poolConfig, func(m *nats.Msg) {
+		handleExportUserData(natsConn, client, m)
+	}, func(m *nats.Msg) {
+		rejectBusy(natsConn, m)
+	})
+	pool.Start()
+
+	_, err := natsConn.QueueSubscribe(messages.SubjectExportUserData, QueueGroup, pool.Submit)
+	return err
+}
+
+func subscribeEraseUser(natsConn *nats.Conn, client *ent.Client, poolConfig PoolConfig) error {
+	pool := NewWorkerPool(messages.SubjectEraseUser, poolConfig, func(m *nats.Msg) {
+		handleEraseUser(natsConn, client, m)
+	}, func(m *nats.Msg) {
+		rejectBusy(natsConn, m)
+	})
+	pool.Start()
+
+	_, err := natsConn.QueueSubscribe(messages.SubjectEraseUser, QueueGroup, pool.Submit)
+	return err
+}
+
+func handleExportUserData(natsConn *nats.Conn, client *ent.Client, m *nats.Msg) {
+	ctx := context.Background()
+
+	var request messages.ExportUserData
+	if err := json.Unmarshal(m.Data, &request); err != nil || request.UserID == 0 {
+		sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeInvalidRequest, "error: export-user-data needs a user_id")
+		return
+	}
+	if request.Limit <= 0 || request.Limit > maxExportPage {
+		request.Limit = maxExportPage
+	}
+
+	export := messages.UserDataExport{Reply: messages.Success("user data")}
+	u, err := client.User.Get(ctx, request.UserID)
+	if ent.IsNotFound(err) {
+		// Users whose wallet was never created have nothing to export here.
+		publishReply(natsConn, m.Reply, export)
+		return
+	}
+	if err != nil {
+		sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeInternal, "error querying user: "+err.Error())
+		return
+	}
+	export.Wallet = &messages.WalletData{
+		ID:           u.ID,
+		Email:        u.Email,
+		Balance:      u.Balance,
+		Currency:     messages.DefaultCurrency,
+		CreatedAt:    u.CreatedAt,
+		VerifiedAt:   u.VerifiedAt,
+		FrozenAt:     u.FrozenAt,
+		FrozenReason: u.FrozenReason,
+		ErasedAt:     u.ErasedAt,
+	}
+
+	if request.AfterID == 0 {
+		payees, err := client.Payee.Query().
+			Where(payee.OwnerID(u.ID)).
+			Order(ent.Asc(payee.FieldID)).
+			All(ctx)
+		if err != nil {
+			sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeInternal, "error querying payees: "+err.Error())
+			return
+		}
+		export.Payees = make([]messages.PayeeData, len(payees))
+		for i, p := range payees {
+			export.Payees[i] = messages.PayeeData{
+				WalletID:      p.WalletID,
+				Alias:         p.Alias,
+				Nickname:      p.Nickname,
+				DefaultAmount: p.DefaultAmount,
+				Memo:          p.Memo,
+				LastPaidAt:    p.LastPaidAt,
+				CreatedAt:     p.CreatedAt,
+			}
+		}
+	}
+
+	transactions, err := client.Transaction.Query().
+		Where(transaction.HasUserWith(user.IDEQ(u.ID)), transaction.IDGT(request.AfterID)).
+		Order(ent.Asc(transaction.FieldID)).
+		Limit(request.Limit).
+		All(ctx)
+	if err != nil {
+		sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeInternal, "error querying transactions: "+err.Error())
+		return
+	}
+	export.Transactions = make([]messages.TransactionCreated, len(transactions))
+	for i, t := range transactions {
+		export.Transactions[i] = messages.TransactionCreated{
+			ID:        t.ID,
+			UserID:    u.ID,
+			Amount:    t.Amount,
+			Currency:  messages.DefaultCurrency,
+			Type:      string(t.Type),
+			RequestID: t.RequestID.String(),
+			CreatedAt: t.CreatedAt,
+		}
+	}
+
+	publishReply(natsConn, m.Reply, export)
+}
+
+func handleEraseUser(natsConn *nats.Conn, client *ent.Client, m *nats.Msg) {
+	reply := processEraseUser(context.Background(), client, m.Data)
+	if !reply.IsSuccess() {
+		log.Println(reply.Message)
+	}
+	sendResponse(natsConn, m.Reply, reply)
+}
+
+// processEraseUser replaces the email of a wallet with a pseudonym, in the
+// wallet and in the events and webhook deliveries that carry it, deletes the
+// failed messages that carry it and freezes the wallet. The transactions are
+// kept, as the ledger must be retained; they only refer to the wallet by ID.
+// Only empty wallets can be erased.
+func processEraseUser(ctx context.Context, client *ent.Client, data []byte) messages.Reply {
+	var request messages.EraseUser
+	if err := json.Unmarshal(data, &request); err != nil {
+		return messages.Error(messages.ErrorCodeInvalidRequest, "error unmarshalling erase-user message: "+err.Error())
+	}
+	if request.UserID == 0 || request.Email == "" || request.ErasedAt.IsZero() {
+		return messages.Error(messages.ErrorCodeInvalidRequest, "error: user_id, email and erased_at are required")
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return messages.Error(messages.ErrorCodeInternal, "error starting transaction: "+err.Error())
+	}
+	reply := eraseUser(ctx, tx, request)
+	if !reply.IsSuccess() {
+		tx.Rollback()
+		return reply
+	}
+	if err := tx.Commit(); err != nil {
+		return messages.Error(messages.ErrorCodeInternal, "error committing transaction: "+err.Error())
+	}
+	return reply
+}
+
+func eraseUser(ctx context.Context, tx *ent.Tx, request messages.EraseUser) messages.Reply {
+	u, err := tx.User.Get(ctx, request.UserID)
+	if ent.IsNotFound(err) {
+		return messages.Success("User has no wallet in transaction-service")
+	}
+	if err != nil {
+		return messages.Error(messages.ErrorCodeInternal, "error querying user: "+err.Error())
+	}
+	if u.ErasedAt != nil && u.Email == request.Email {
+		// A redelivered erasure is not an error.
+		return messages.Success("User already erased in transaction-service")
+	}
+
+	// The balance is checked in the update itself, so that a concurrent
+	// deposit cannot be erased with the wallet.
+	n, err := tx.User.Update().
+		Where(user.IDEQ(u.ID), user.BalanceEQ(0)).
+		SetEmail(request.Email).
+		SetEmailUpdatedAt(request.ErasedAt).
+		ClearVerifiedAt().
+		SetFrozenAt(request.ErasedAt).
+		SetFrozenReason(erasedReason).
+		SetErasedAt(request.ErasedAt).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return messages.Error(messages.ErrorCodeEmailTaken, "error erasing user: email "+request.Email+" is used by another wallet")
+	}
+	if err != nil {
+		return messages.Error(messages.ErrorCodeInternal, "error erasing user: "+err.Error())
+	}
+	if n == 0 {
+		return messages.Error(messages.ErrorCodeWalletNotEmpty, fmt.Sprintf("wallet %d still holds money; pay it out before erasing the user", u.ID))
+	}
+
+	if err := pseudonymizeEvents(ctx, tx, u.ID, request.Email); err != nil {
+		return messages.Error(messages.ErrorCodeInternal, "error pseudonymizing events: "+err.Error())
+	}
+	if err := erasePayees(ctx, tx, u.ID); err != nil {
+		return messages.Error(messages.ErrorCodeInternal, "error erasing payees: "+err.Error())
+	}
+	if err := eraseDeadLetters(ctx, tx, u.ID, u.Email); err != nil {
+		return messages.Error(messages.ErrorCodeInternal, "error erasing dead letters: "+err.Error())
+	}
+	return messages.Success("User erased in transaction-service")
+}
+
+// erasePayees deletes the payees a user saved and removes their alias from
+// the payees other users saved them as. Those payees are kept, closed, so
+// that their owners see why they can no longer pay them.
+func erasePayees(ctx context.Context, tx *ent.Tx, userID int) error {
+	if _, err := tx.Payee.Delete().Where(payee.OwnerID(userID)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.Payee.Update().Where(payee.WalletID(userID)).ClearAlias().Save(ctx)
+	return err
+}
+
+// eraseDeadLetters deletes the failed user-created messages of a user, found
+// by ID or by their email, as the user may have signed up again after one
+// failed. They cannot be replayed, so nothing is lost. The few letters
+// recorded without a user, whose payload did not decode, are deleted when the
+// email appears in them at all.
+func eraseDeadLetters(ctx context.Context, tx *ent.Tx, userID int, email string) error {
+	_, err := tx.DeadLetter.Delete().
+		Where(deadletter.Or(deadletter.UserID(userID), deadletter.Email(strings.ToLower(email)))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	unattributed, err := tx.DeadLetter.Query().
+		Where(
+			deadletter.SubjectEQ(messages.SubjectUserCreated),
+			deadletter.UserIDIsNil(),
+			deadletter.EmailIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	var ids []int
+	for _, dl := range unattributed {
+		if bytes.Contains(bytes.ToLower(dl.Data), []byte(strings.ToLower(email))) {
+			ids = append(ids, dl.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err = tx.DeadLetter.Delete().Where(deadletter.IDIn(ids...)).Exec(ctx)
+	return err
+}
+
+// pseudonymizeEvents replaces the email in the events of a user and in the
+// webhook deliveries of those events.
+func pseudonymizeEvents(ctx context.Context, tx *ent.Tx, userID int, email string) error {
+	events, err := tx.Event.Query().Where(event.UserID(userID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]int, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+		data, changed, err := replaceEmail(e.Data, email)
+		if err != nil {
+			return fmt.Errorf("event %d: %w", e.ID, err)
+		}
+		if changed {
+			if err := tx.Event.UpdateOne(e).SetData(data).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	deliveries, err := tx.WebhookDelivery.Query().Where(webhookdelivery.EventIDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, d := range deliveries {
+		var envelope messages.Event
+		if err := json.Unmarshal(d.Payload, &envelope); err != nil {
+			return fmt.Errorf("webhook delivery %d: %w", d.ID, err)
+		}
+		data, changed, err := replaceEmail(envelope.Data, email)
+		if err != nil {
+			return fmt.Errorf("webhook delivery %d: %w", d.ID, err)
+		}
+		if !changed {
+			continue
+		}
+		envelope.Data = data
+		payload, err := json.Marshal(envelope)
+		if err != nil {
+			return err
+		}
+		if err := tx.WebhookDelivery.UpdateOne(d).SetPayload(payload).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceEmail sets the email field of the JSON object data, if it has one.
+func replaceEmail(data []byte, email string) ([]byte, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, false, err
+	}
+	if _, ok := fields["email"]; !ok {
+		return data, false, nil
+	}
+	fields["email"], _ = json.Marshal(email)
+	data, err := json.Marshal(fields)
+	return data, err == nil, err
+}
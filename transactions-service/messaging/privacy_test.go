@@ -0,0 +1,54 @@
+package messaging
+
+import (
+	"context"
+	"shared/messages"
+	"slices"
+	"testing"
+	"transactions-service/ent/deadletter"
+	"transactions-service/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestEraseDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	failed := messages.Error(messages.ErrorCodeInternal, "error creating user")
+	letters := []struct {
+		data  string
+		erase bool
+	}{
+		{data: `{"id": 1, "email": "ada@example.com"}`, erase: true},
+		{data: `{"id": 2, "email": "Ada@Example.com"}`, erase: true},
+		{data: `{"id": 1, "email": "old@example.com"}`, erase: true},
+		{data: `{"id": 3, "email": "bob@example.com"}`},
+		{data: `{"id": "ada@example.com"`, erase: true},
+		{data: `{"id": "bob@example.com"`},
+	}
+	var kept []int
+	for i, l := range letters {
+		recordDeadLetter(ctx, client, messages.SubjectUserCreated, []byte(l.data), failed)
+		if !l.erase {
+			kept = append(kept, i+1)
+		}
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := eraseDeadLetters(ctx, tx, 1, "ada@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := client.DeadLetter.Query().Order(deadletter.ByID()).IDsX(ctx)
+	if !slices.Equal(ids, kept) {
+		t.Fatalf("kept dead letters %v, want %v", ids, kept)
+	}
+}
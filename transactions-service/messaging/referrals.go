@@ -0,0 +1,151 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"shared/messages"
+	"transactions-service/ent"
+	"transactions-service/ent/transaction"
+	"transactions-service/ent/user"
+	"transactions-service/services"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+// SubscribePayReferralBonus pays the referral bonuses user-service asks for.
+// It is set up apart from SetupNATS, as it needs the business logic of
+// transfers.
+func SubscribePayReferralBonus(natsConn *nats.Conn, bonuses *services.ReferralBonuses, config Config) error {
+	pool := NewWorkerPool(messages.SubjectPayReferralBonus, config.Pools[messages.SubjectPayReferralBonus], func(m *nats.Msg) {
+		handlePayReferralBonus(natsConn, bonuses, m)
+	}, func(m *nats.Msg) {
+		rejectBusy(natsConn, m)
+	})
+	pool.Start()
+
+	_, err := natsConn.QueueSubscribe(messages.SubjectPayReferralBonus, QueueGroup, pool.Submit)
+	return err
+}
+
+func handlePayReferralBonus(natsConn *nats.Conn, bonuses *services.ReferralBonuses, m *nats.Msg) {
+	reply := processPayReferralBonus(context.Background(), bonuses, m.Data)
+	if !reply.IsSuccess() {
+		log.Println(reply.Message)
+	}
+	sendResponse(natsConn, m.Reply, reply)
+}
+
+func processPayReferralBonus(ctx context.Context, bonuses *services.ReferralBonuses, data []byte) messages.Reply {
+	var request messages.PayReferralBonus
+	if err := json.Unmarshal(data, &request); err != nil {
+		return messages.Error(messages.ErrorCodeInvalidRequest, "error unmarshalling pay-referral-bonus message: "+err.Error())
+	}
+	if request.ReferralID == 0 || request.ReferrerID == 0 || request.ReferredID == 0 {
+		return messages.Error(messages.ErrorCodeInvalidRequest, "error: referral_id, referrer_id and referred_id are required")
+	}
+
+	err := bonuses.Pay(ctx, request)
+	switch {
+	case err == nil:
+		return messages.Success("Referral bonuses paid")
+	case errors.Is(err, services.ErrReferralBonusesDisabled):
+		return messages.Error(messages.ErrorCodeServiceUnavailable, err.Error())
+	case errors.Is(err, services.ErrUserNotFound):
+		return messages.Error(messages.ErrorCodeUserNotFound, "error paying referral bonuses: "+err.Error())
+	default:
+		return messages.Error(messages.ErrorCodeInternal, "error paying referral bonuses: "+err.Error())
+	}
+}
+
+// maxDeposits caps the deposits of a list-deposits reply; the first one that
+// qualifies a referral is enough.
+const maxDeposits = 100
+
+func subscribeListDeposits(natsConn *nats.Conn, client *ent.Client, poolConfig PoolConfig) error {
+	pool := NewWorkerPool(messages.SubjectListDeposits, poolConfig, func(m *nats.Msg) {
+		handleListDeposits(natsConn, client, m)
+	}, func(m *nats.Msg) {
+		rejectBusy(natsConn, m)
+	})
+	pool.Start()
+
+	_, err := natsConn.QueueSubscribe(messages.SubjectListDeposits, QueueGroup, pool.Submit)
+	return err
+}
+
+// handleListDeposits answers with the deposits of a wallet.
+func handleListDeposits(natsConn *nats.Conn, client *ent.Client, m *nats.Msg) {
+	var request messages.ListDeposits
+	if err := json.Unmarshal(m.Data, &request); err != nil || request.UserID == 0 {
+		sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeInvalidRequest, "error: list-deposits needs a user_id")
+		return
+	}
+
+	deposits, err := listDeposits(context.Background(), client, request)
+	if err != nil {
+		sendErrorResponse(natsConn, m.Reply, messages.ErrorCodeInternal, "error querying deposits: "+err.Error())
+		return
+	}
+	publishReply(natsConn, m.Reply, messages.DepositList{Reply: messages.Success("deposits"), Deposits: deposits})
+}
+
+// listDeposits returns the deposits matching request, up to maxDeposits.
+// Deposits are not marked as such: they are the credits that no debit shares
+// a request ID with, as the credits of transfers have one.
+func listDeposits(ctx context.Context, client *ent.Client, request messages.ListDeposits) ([]messages.TransactionCreated, error) {
+	credits, err := client.Transaction.Query().
+		Where(
+			transaction.HasUserWith(user.IDEQ(request.UserID)),
+			transaction.TypeEQ(transaction.TypeCredit),
+			transaction.AmountGTE(request.MinAmount),
+			transaction.CreatedAtGTE(request.Since),
+		).
+		Order(ent.Asc(transaction.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestIDs := make([]uuid.UUID, 0, len(credits))
+	for _, t := range credits {
+		requestIDs = append(requestIDs, t.RequestID)
+	}
+	var transfers []struct {
+		RequestID uuid.UUID `json:"request_id"`
+	}
+	err = client.Transaction.Query().
+		Where(transaction.RequestIDIn(requestIDs...), transaction.TypeEQ(transaction.TypeDebit)).
+		Select(transaction.FieldRequestID).
+		Scan(ctx, &transfers)
+	if err != nil {
+		return nil, err
+	}
+	transferred := make(map[uuid.UUID]bool, len(transfers))
+	for _, t := range transfers {
+		transferred[t.RequestID] = true
+	}
+
+	var deposits []messages.TransactionCreated
+	for _, t := range credits {
+		if transferred[t.RequestID] {
+			continue
+		}
+		deposits = append(deposits, messages.TransactionCreated{
+			ID:        t.ID,
+			UserID:    request.UserID,
+			Amount:    t.Amount,
+			Currency:  messages.DefaultCurrency,
+			Type:      string(t.Type),
+			Deposit:   true,
+			RequestID: t.RequestID.String(),
+			CreatedAt: t.CreatedAt,
+		})
+		if len(deposits) == maxDeposits {
+			break
+		}
+	}
+	return deposits, nil
+}
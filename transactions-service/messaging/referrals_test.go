@@ -0,0 +1,47 @@
+package messaging
+
+import (
+	"context"
+	"shared/messages"
+	"testing"
+	"time"
+	"transactions-service/ent/enttest"
+	"transactions-service/ent/transaction"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestListDeposits(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	since := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	client.User.Create().SetID(1).SetEmail("ada@example.com").ExecX(ctx)
+	client.User.Create().SetID(2).SetEmail("bob@example.com").ExecX(ctx)
+	create := func(userID int, amount float64, typ transaction.Type, requestID uuid.UUID, at time.Time) int {
+		return client.Transaction.Create().
+			SetUserID(userID).
+			SetAmount(amount).
+			SetType(typ).
+			SetRequestID(requestID).
+			SetCreatedAt(at).
+			SaveX(ctx).ID
+	}
+
+	deposit := create(1, 50, transaction.TypeCredit, uuid.New(), since.Add(time.Hour))
+	transfer := uuid.New()
+	create(2, 50, transaction.TypeDebit, transfer, since.Add(time.Hour))
+	create(1, 50, transaction.TypeCredit, transfer, since.Add(time.Hour))
+	create(1, 5, transaction.TypeCredit, uuid.New(), since.Add(time.Hour))
+	create(1, 50, transaction.TypeCredit, uuid.New(), since.Add(-time.Hour))
+
+	deposits, err := listDeposits(ctx, client, messages.ListDeposits{UserID: 1, Since: since, MinAmount: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deposits) != 1 || deposits[0].ID != deposit || !deposits[0].Deposit {
+		t.Fatalf("got deposits %+v, want only transaction %d", deposits, deposit)
+	}
+}
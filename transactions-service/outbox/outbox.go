@@ -0,0 +1,60 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"shared/messages"
+	"transactions-service/ent"
+	"transactions-service/ent/event"
+)
+
+// Record stores an event in the outbox as part of tx, so the event is
+// published if and only if tx commits.
+func Record(ctx context.Context, tx *ent.Tx, eventType string, userID int, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return tx.Event.Create().
+		SetType(eventType).
+		SetUserID(userID).
+		SetData(payload).
+		Exec(ctx)
+}
+
+// RecordBalanceChanged stores a balance-changed event for the updated user,
+// chained to the user's previous one. tx already updated the user, whose row
+// lock orders the events of a wallet.
+func RecordBalanceChanged(ctx context.Context, tx *ent.Tx, u *ent.User) error {
+	previous, err := tx.Event.Query().
+		Where(event.UserID(u.ID), event.TypeEQ(messages.EventBalanceChanged)).
+		Order(ent.Desc(event.FieldID)).
+		FirstID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	return Record(ctx, tx, messages.EventBalanceChanged, u.ID, messages.BalanceChanged{
+		UserID:           u.ID,
+		Email:            u.Email,
+		Balance:          u.Balance,
+		Currency:         messages.DefaultCurrency,
+		PreviousSequence: previous,
+	})
+}
+
+// RecordTransactionCreated stores a transaction-created event for a new
+// transaction record, which is the credit of a top-up when deposit is set.
+func RecordTransactionCreated(ctx context.Context, tx *ent.Tx, userID int, t *ent.Transaction, deposit bool) error {
+	return Record(ctx, tx, messages.EventTransactionCreated, userID, messages.TransactionCreated{
+		ID:        t.ID,
+		UserID:    userID,
+		Amount:    t.Amount,
+		Currency:  messages.DefaultCurrency,
+		Type:      t.Type.String(),
+		Deposit:   deposit,
+		RequestID: t.RequestID.String(),
+		CreatedAt: t.CreatedAt,
+	})
+}
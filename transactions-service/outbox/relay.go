@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"shared/messages"
+	"time"
+	"transactions-service/ent"
+	"transactions-service/ent/event"
+	"transactions-service/jobs"
+
+	"github.com/nats-io/nats.go"
+)
+
+const relayBatchSize = 100
+
+// Relay returns a job that publishes unpublished outbox events to NATS in
+// sequence order. Each event goes to the subject named after its type.
+func Relay(client *ent.Client, natsConn *nats.Conn) jobs.Job {
+	return jobs.Job{
+		Name:     "outbox-relay",
+		Interval: time.Second,
+		Run: func(ctx context.Context) error {
+			for {
+				n, err := relayBatch(ctx, client, natsConn)
+				if err != nil || n < relayBatchSize {
+					return err
+				}
+			}
+		},
+	}
+}
+
+func relayBatch(ctx context.Context, client *ent.Client, natsConn *nats.Conn) (int, error) {
+	events, err := client.Event.Query().
+		Where(event.PublishedAtIsNil()).
+		Order(ent.Asc(event.FieldID)).
+		Limit(relayBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, e := range events {
+		data, err := json.Marshal(Envelope(e))
+		if err != nil {
+			return 0, err
+		}
+		if err := natsConn.Publish(e.Type, data); err != nil {
+			return 0, err
+		}
+		if err := client.Event.UpdateOne(e).SetPublishedAt(time.Now()).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(events), natsConn.Flush()
+}
+
+// Envelope wraps a stored event in the envelope it is published with.
+func Envelope(e *ent.Event) messages.Event {
+	return messages.Event{
+		Sequence:   e.ID,
+		Type:       e.Type,
+		OccurredAt: e.CreatedAt,
+		Data:       e.Data,
+	}
+}
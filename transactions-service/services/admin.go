@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+	"transactions-service/audit"
+	"transactions-service/ent"
+	"transactions-service/ent/transaction"
+	"transactions-service/ent/user"
+)
+
+var ErrMissingReason = errors.New("reason is required")
+
+// WalletSearch selects wallets on the admin API; zero fields match every wallet.
+type WalletSearch struct {
+	// Email matches wallets whose email contains it, ignoring case.
+	Email      string
+	Frozen     *bool
+	MinBalance *float64
+	MaxBalance *float64
+}
+
+// AdminService holds the back-office operations on wallets. Every change is
+// recorded in the audit log in the transaction that makes it.
+type AdminService struct {
+	client *ent.Client
+}
+
+func NewAdminService(client *ent.Client) *AdminService {
+	return &AdminService{client: client}
+}
+
+// SearchWallets returns a page of the wallets matching search, ordered by ID,
+// and the number of matching wallets.
+func (s *AdminService) SearchWallets(ctx context.Context, search WalletSearch, limit, offset int) ([]*ent.User, int, error) {
+	query := s.client.User.Query()
+	if search.Email != "" {
+		query = query.Where(user.EmailContainsFold(search.Email))
+	}
+	if search.Frozen != nil {
+		if *search.Frozen {
+			query = query.Where(user.FrozenAtNotNil())
+		} else {
+			query = query.Where(user.FrozenAtIsNil())
+		}
+	}
+	if search.MinBalance != nil {
+		query = query.Where(user.BalanceGTE(*search.MinBalance))
+	}
+	if search.MaxBalance != nil {
+		query = query.Where(user.BalanceLTE(*search.MaxBalance))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	wallets, err := query.
+		Order(ent.Asc(user.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return wallets, total, nil
+}
+
+// Ledger returns a page of every transaction record of a wallet, newest first,
+// and the number of its records.
+func (s *AdminService) Ledger(ctx context.Context, userID int, limit, offset int) ([]*ent.Transaction, int, error) {
+	exists, err := s.client.User.Query().Where(user.IDEQ(userID)).Exist(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !exists {
+		return nil, 0, fmt.Errorf("%w: id %d", ErrUserNotFound, userID)
+	}
+
+	query := s.client.Transaction.Query().Where(transaction.HasUserWith(user.IDEQ(userID)))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	records, err := query.
+		Order(ent.Desc(transaction.FieldCreatedAt), ent.Desc(transaction.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return records, total, nil
+}
+
+// FreezeWallet stops a wallet from sending or receiving money. Freezing a
+// frozen wallet keeps the original freeze.
+func (s *AdminService) FreezeWallet(ctx context.Context, userID int, reason string) (*ent.User, error) {
+	if reason == "" {
+		return nil, ErrMissingReason
+	}
+	return s.setFrozen(ctx, userID, true, reason, audit.ActionWalletFreeze)
+}
+
+// UnfreezeWallet lets a frozen wallet send and receive money again.
+func (s *AdminService) UnfreezeWallet(ctx context.Context, userID int, reason string) (*ent.User, error) {
+	if reason == "" {
+		return nil, ErrMissingReason
+	}
+	return s.setFrozen(ctx, userID, false, reason, audit.ActionWalletUnfreeze)
+}
+
+func (s *AdminService) setFrozen(ctx context.Context, userID int, frozen bool, reason string, action string) (*ent.User, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	u, err := tx.User.Get(ctx, userID)
+	if ent.IsNotFound(err) {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: id %d", ErrUserNotFound, userID)
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if (u.FrozenAt != nil) == frozen {
+		tx.Rollback()
+		return u, nil
+	}
+	if u.ErasedAt != nil {
+		// The wallet of an erased user stays frozen.
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: id %d", ErrUserErased, userID)
+	}
+
+	update := tx.User.UpdateOne(u)
+	if frozen {
+		update = update.SetFrozenAt(time.Now()).SetFrozenReason(reason)
+	} else {
+		update = update.ClearFrozenAt().ClearFrozenReason()
+	}
+	u, err = update.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = audit.Record(ctx, tx.Client(), action, audit.TargetWallet, strconv.Itoa(userID), map[string]any{
+		"reason": reason,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("recording audit log: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return u, nil
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"shared/messages"
+	"time"
+	"transactions-service/common/problems"
+
+	"github.com/nats-io/nats.go"
+)
+
+const aliasRequestTimeout = 5 * time.Second
+
+var (
+	ErrAliasNotFound      = errors.New("alias not found")
+	ErrMissingRecipient   = errors.New("a recipient wallet ID or alias is required")
+	ErrAmbiguousRecipient = errors.New("set either the recipient wallet ID or the alias, not both")
+)
+
+// AliasResolver finds the user a payment alias belongs to.
+type AliasResolver interface {
+	// ResolveAlias returns the recipient of a handle, email or phone number,
+	// or ErrAliasNotFound.
+	ResolveAlias(ctx context.Context, alias string) (*messages.Recipient, error)
+}
+
+// RemoteAliases is an AliasResolver that asks user-service, which keeps the
+// alias directory, over NATS. Aliases are not cached, so a transfer never
+// reaches a user who gave their alias up.
+type RemoteAliases struct {
+	natsConn *nats.Conn
+}
+
+func NewRemoteAliases(natsConn *nats.Conn) *RemoteAliases {
+	return &RemoteAliases{natsConn: natsConn}
+}
+
+// ResolveAlias implements AliasResolver.
+func (r *RemoteAliases) ResolveAlias(ctx context.Context, alias string) (*messages.Recipient, error) {
+	data, err := json.Marshal(messages.ResolveAlias{Alias: alias})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, aliasRequestTimeout)
+	defer cancel()
+	msg, err := r.natsConn.RequestWithContext(ctx, messages.SubjectResolveAlias, data)
+	switch {
+	case errors.Is(err, nats.ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return nil, problems.New(problems.CodeServiceTimeout, "resolving the alias timed out")
+	case errors.Is(err, nats.ErrNoResponders):
+		return nil, problems.New(problems.CodeServiceUnavailable, "user-service cannot resolve aliases")
+	case err != nil:
+		return nil, fmt.Errorf("resolving alias: %w", err)
+	}
+
+	var reply messages.AliasResolution
+	if err := json.Unmarshal(msg.Data, &reply); err != nil {
+		return nil, fmt.Errorf("decoding alias resolution: %w", err)
+	}
+	switch {
+	case reply.ErrorCode == messages.ErrorCodeAliasNotFound:
+		return nil, ErrAliasNotFound
+	case reply.ErrorCode == messages.ErrorCodeInvalidRequest:
+		return nil, problems.New(problems.CodeValidationFailed, reply.Message)
+	case !reply.IsSuccess() || reply.Recipient == nil:
+		return nil, fmt.Errorf("resolving alias: %s", reply.Message)
+	}
+	return reply.Recipient, nil
+}
+
+// Recipient returns the wallet a transfer goes to, given either its ID or
+// an alias of its owner.
+func (s *TransactionsService) Recipient(ctx context.Context, walletID int, alias string) (int, error) {
+	walletID, _, err := s.recipient(ctx, walletID, alias)
+	return walletID, err
+}
+
+// recipient is Recipient that also returns the normalized alias, if one was
+// given.
+func (s *TransactionsService) recipient(ctx context.Context, walletID int, alias string) (int, string, error) {
+	switch {
+	case alias != "" && walletID != 0:
+		return 0, "", ErrAmbiguousRecipient
+	case alias != "":
+		recipient, err := s.aliases.ResolveAlias(ctx, alias)
+		if err != nil {
+			return 0, "", err
+		}
+		return recipient.UserID, recipient.Alias, nil
+	case walletID == 0:
+		return 0, "", ErrMissingRecipient
+	default:
+		return walletID, "", nil
+	}
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"transactions-service/common/problems"
+	"transactions-service/ent"
+)
+
+// problemCodes maps domain errors to the problem codes they are reported with.
+var problemCodes = []struct {
+	err  error
+	code problems.Code
+}{
+	{ErrUserNotFound, problems.CodeUserNotFound},
+	{ErrInsufficientFunds, problems.CodeInsufficientFunds},
+	{ErrDuplicateRequest, problems.CodeDuplicateRequest},
+	{ErrTransferNotFound, problems.CodeTransferNotFound},
+	{ErrInvalidAmount, problems.CodeValidationFailed},
+	{ErrSameUser, problems.CodeValidationFailed},
+	{ErrMissingRequestID, problems.CodeValidationFailed},
+	{ErrWalletFrozen, problems.CodeWalletFrozen},
+	{ErrEmailNotVerified, problems.CodeEmailNotVerified},
+	{ErrUserErased, problems.CodeUserErased},
+	{ErrMissingReason, problems.CodeValidationFailed},
+	{ErrAliasNotFound, problems.CodeAliasNotFound},
+	{ErrMissingRecipient, problems.CodeValidationFailed},
+	{ErrAmbiguousRecipient, problems.CodeValidationFailed},
+	{ErrPayeeNotFound, problems.CodePayeeNotFound},
+	{ErrPayeeExists, problems.CodePayeeExists},
+	{ErrInvalidNickname, problems.CodeValidationFailed},
+	{ErrInvalidMemo, problems.CodeValidationFailed},
+	{ErrInvalidDefaultAmount, problems.CodeValidationFailed},
+	{ErrMissingAmount, problems.CodeValidationFailed},
+}
+
+// ProblemFor converts an error returned by the business logic into a problem,
+// which every API reports in its own way. Errors without a known code are
+// logged and reported as internal errors without leaking their details.
+func ProblemFor(err error) *problems.Problem {
+	if p, ok := problems.As(err); ok {
+		return p
+	}
+	for _, pc := range problemCodes {
+		if errors.Is(err, pc.err) {
+			return problems.New(pc.code, err.Error())
+		}
+	}
+	if ent.IsValidationError(err) {
+		return problems.New(problems.CodeValidationFailed, err.Error())
+	}
+
+	log.Printf("internal error: %v", err)
+	return problems.New(problems.CodeInternal, "")
+}
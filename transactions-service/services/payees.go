@@ -0,0 +1,409 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"shared/auth"
+	"sort"
+	"strings"
+	"time"
+	"transactions-service/ent"
+	"transactions-service/ent/payee"
+	"transactions-service/ent/transaction"
+	"transactions-service/ent/user"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Payee statuses, revalidated against the paid wallet whenever a payee is
+// read or paid. Erased wallets are closed.
+const (
+	PayeeActive = "active"
+	PayeeFrozen = "frozen"
+	PayeeClosed = "closed"
+)
+
+const (
+	maxNicknameLength = 100
+	maxMemoLength     = 140
+
+	// suggestionWindow is how far back sent transfers are looked at to
+	// suggest payees, and suggestionTransfers how many of them at most.
+	suggestionWindow    = 90 * 24 * time.Hour
+	suggestionTransfers = 500
+	maxSuggestions      = 10
+)
+
+var (
+	ErrPayeeNotFound        = errors.New("payee not found")
+	ErrPayeeExists          = errors.New("wallet is already a saved payee")
+	ErrInvalidNickname      = errors.New("nickname must be 1 to 100 characters")
+	ErrInvalidMemo          = errors.New("memo must be at most 140 characters")
+	ErrInvalidDefaultAmount = errors.New("default_amount must be greater than zero")
+	ErrMissingAmount        = errors.New("amount is required, as the payee has no default amount")
+)
+
+// Payee is a saved payee together with the status of the wallet it pays.
+type Payee struct {
+	*ent.Payee
+	Status string
+}
+
+// NewPayee holds the fields of a payee to save. The paid wallet is named by
+// WalletID or by Alias, a handle, email or phone number of its owner.
+type NewPayee struct {
+	WalletID      int
+	Alias         string
+	Nickname      string
+	DefaultAmount *float64
+	Memo          string
+}
+
+// PayeeUpdate holds the fields of a payee to change; nil fields are kept. A
+// zero DefaultAmount and an empty Memo remove them.
+type PayeeUpdate struct {
+	Nickname      *string
+	DefaultAmount *float64
+	Memo          *string
+}
+
+// PayeeSuggestion is a wallet the owner recently sent money to and has not
+// saved as a payee.
+type PayeeSuggestion struct {
+	WalletID       int
+	TransferCount  int
+	LastAmount     float64
+	LastTransferAt time.Time
+}
+
+// ListPayees returns the saved payees of a wallet ordered by nickname.
+func (s *TransactionsService) ListPayees(ctx context.Context, ownerID int) ([]*Payee, error) {
+	if _, err := s.GetWallet(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
+	payees, err := s.client.Payee.Query().
+		Where(payee.OwnerID(ownerID)).
+		Order(ent.Asc(payee.FieldNickname), ent.Asc(payee.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.revalidate(ctx, payees...)
+}
+
+// GetPayee returns a saved payee of a wallet.
+func (s *TransactionsService) GetPayee(ctx context.Context, ownerID, payeeID int) (*Payee, error) {
+	p, err := s.payee(ctx, ownerID, payeeID)
+	if err != nil {
+		return nil, err
+	}
+	payees, err := s.revalidate(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	return payees[0], nil
+}
+
+// CreatePayee saves a payee in the contact book of a wallet. Closed wallets
+// cannot be saved; frozen ones can, but cannot be paid while frozen. A payee
+// is where later payouts go, so saving one needs a step-up; ctx carries the
+// principal of the request.
+func (s *TransactionsService) CreatePayee(ctx context.Context, ownerID int, p NewPayee) (*Payee, error) {
+	nickname, err := normalizeNickname(p.Nickname)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePayee(p.DefaultAmount, p.Memo); err != nil {
+		return nil, err
+	}
+	if err := auth.RequireStepUp(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.GetWallet(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
+	walletID, alias, err := s.recipient(ctx, p.WalletID, strings.TrimSpace(p.Alias))
+	if err != nil {
+		return nil, err
+	}
+	if walletID == ownerID {
+		return nil, ErrSameUser
+	}
+	target, err := s.GetWallet(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	if target.ErasedAt != nil {
+		return nil, fmt.Errorf("%w: id %d", ErrUserErased, walletID)
+	}
+
+	create := s.client.Payee.Create().
+		SetOwnerID(ownerID).
+		SetWalletID(walletID).
+		SetNickname(nickname).
+		SetAlias(alias).
+		SetMemo(strings.TrimSpace(p.Memo))
+	if p.DefaultAmount != nil {
+		create = create.SetDefaultAmount(*p.DefaultAmount)
+	}
+	saved, err := create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, ErrPayeeExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Payee{Payee: saved, Status: payeeStatus(target)}, nil
+}
+
+// UpdatePayee changes the nickname, default amount or memo of a payee. The
+// paid wallet cannot be changed, so that a payee is never retargeted without
+// the step-up of saving a new one.
+func (s *TransactionsService) UpdatePayee(ctx context.Context, ownerID, payeeID int, update PayeeUpdate) (*Payee, error) {
+	p, err := s.payee(ctx, ownerID, payeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	mutation := p.Update()
+	if update.Nickname != nil {
+		nickname, err := normalizeNickname(*update.Nickname)
+		if err != nil {
+			return nil, err
+		}
+		mutation = mutation.SetNickname(nickname)
+	}
+	if update.DefaultAmount != nil {
+		switch {
+		case *update.DefaultAmount == 0:
+			mutation = mutation.ClearDefaultAmount()
+		case *update.DefaultAmount < 0:
+			return nil, ErrInvalidDefaultAmount
+		default:
+			mutation = mutation.SetDefaultAmount(*update.DefaultAmount)
+		}
+	}
+	if update.Memo != nil {
+		if err := validatePayee(nil, *update.Memo); err != nil {
+			return nil, err
+		}
+		mutation = mutation.SetMemo(strings.TrimSpace(*update.Memo))
+	}
+
+	p, err = mutation.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payees, err := s.revalidate(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	return payees[0], nil
+}
+
+// DeletePayee removes a payee from the contact book of a wallet.
+func (s *TransactionsService) DeletePayee(ctx context.Context, ownerID, payeeID int) error {
+	n, err := s.client.Payee.Delete().
+		Where(payee.IDEQ(payeeID), payee.OwnerID(ownerID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: id %d", ErrPayeeNotFound, payeeID)
+	}
+	return nil
+}
+
+// PayPayee transfers money to a saved payee, by default its default amount.
+// The payee is revalidated first, so frozen and closed wallets are reported
+// as such. ctx carries the principal of the request for the step-up check.
+func (s *TransactionsService) PayPayee(ctx context.Context, ownerID, payeeID int, amount *float64, requestID uuid.UUID) (*Transfer, error) {
+	p, err := s.GetPayee(ctx, ownerID, payeeID)
+	if err != nil {
+		return nil, err
+	}
+	switch p.Status {
+	case PayeeFrozen:
+		return nil, fmt.Errorf("%w: payee %d", ErrWalletFrozen, payeeID)
+	case PayeeClosed:
+		return nil, fmt.Errorf("%w: payee %d", ErrUserErased, payeeID)
+	}
+
+	if amount == nil {
+		amount = p.DefaultAmount
+	}
+	if amount == nil {
+		return nil, ErrMissingAmount
+	}
+	if err := s.CheckStepUp(ctx, *amount); err != nil {
+		return nil, err
+	}
+
+	t, err := s.TransferMoney(ctx, ownerID, p.WalletID, *amount, requestID)
+	if err != nil {
+		return nil, err
+	}
+	// The transfer is done; a stale last_paid_at only affects the display.
+	if err := s.client.Payee.UpdateOneID(p.ID).SetLastPaidAt(t.CreatedAt).Exec(ctx); err != nil {
+		log.Printf("error recording payment of payee %d: %v", p.ID, err)
+	}
+	return t, nil
+}
+
+// SuggestPayees returns the wallets the owner sent money to recently and has
+// not saved yet, most frequently paid first.
+func (s *TransactionsService) SuggestPayees(ctx context.Context, ownerID int) ([]PayeeSuggestion, error) {
+	if _, err := s.GetWallet(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
+	var sent []struct {
+		RequestID uuid.UUID `json:"request_id"`
+	}
+	err := s.client.Transaction.Query().
+		Where(
+			transaction.HasUserWith(user.IDEQ(ownerID)),
+			transaction.TypeEQ(transaction.TypeDebit),
+			transaction.CreatedAtGTE(time.Now().Add(-suggestionWindow)),
+		).
+		Order(ent.Desc(transaction.FieldID)).
+		Limit(suggestionTransfers).
+		Select(transaction.FieldRequestID).
+		Scan(ctx, &sent)
+	if err != nil {
+		return nil, err
+	}
+	requestIDs := make([]uuid.UUID, 0, len(sent))
+	for _, t := range sent {
+		requestIDs = append(requestIDs, t.RequestID)
+	}
+
+	// The credits of the sent transfers name their recipients; the newest
+	// comes first, so the first credit per wallet is its last transfer.
+	credits, err := s.client.Transaction.Query().
+		Where(
+			transaction.RequestIDIn(requestIDs...),
+			transaction.TypeEQ(transaction.TypeCredit),
+			transaction.HasUserWith(user.IDNEQ(ownerID), user.ErasedAtIsNil()),
+		).
+		Order(ent.Desc(transaction.FieldID)).
+		WithUser(selectUserID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved []int
+	err = s.client.Payee.Query().
+		Where(payee.OwnerID(ownerID)).
+		Select(payee.FieldWalletID).
+		Scan(ctx, &saved)
+	if err != nil {
+		return nil, err
+	}
+	skip := make(map[int]bool, len(saved))
+	for _, id := range saved {
+		skip[id] = true
+	}
+
+	byWallet := make(map[int]*PayeeSuggestion)
+	var suggestions []*PayeeSuggestion
+	for _, credit := range credits {
+		if credit.Edges.User == nil || skip[credit.Edges.User.ID] {
+			continue
+		}
+		walletID := credit.Edges.User.ID
+		suggestion, ok := byWallet[walletID]
+		if !ok {
+			suggestion = &PayeeSuggestion{WalletID: walletID, LastAmount: credit.Amount, LastTransferAt: credit.CreatedAt}
+			byWallet[walletID] = suggestion
+			suggestions = append(suggestions, suggestion)
+		}
+		suggestion.TransferCount++
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].TransferCount > suggestions[j].TransferCount
+	})
+	if len(suggestions) > maxSuggestions {
+		suggestions = suggestions[:maxSuggestions]
+	}
+	result := make([]PayeeSuggestion, len(suggestions))
+	for i, suggestion := range suggestions {
+		result[i] = *suggestion
+	}
+	return result, nil
+}
+
+// payee returns a payee of the given owner.
+func (s *TransactionsService) payee(ctx context.Context, ownerID, payeeID int) (*ent.Payee, error) {
+	p, err := s.client.Payee.Query().
+		Where(payee.IDEQ(payeeID), payee.OwnerID(ownerID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: id %d", ErrPayeeNotFound, payeeID)
+	}
+	return p, err
+}
+
+// revalidate looks up the wallets the payees pay and sets their status.
+func (s *TransactionsService) revalidate(ctx context.Context, payees ...*ent.Payee) ([]*Payee, error) {
+	ids := make([]int, 0, len(payees))
+	for _, p := range payees {
+		ids = append(ids, p.WalletID)
+	}
+	wallets, err := s.client.User.Query().
+		Where(user.IDIn(ids...)).
+		Select(user.FieldID, user.FieldFrozenAt, user.FieldErasedAt).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*ent.User, len(wallets))
+	for _, w := range wallets {
+		byID[w.ID] = w
+	}
+
+	result := make([]*Payee, len(payees))
+	for i, p := range payees {
+		result[i] = &Payee{Payee: p, Status: payeeStatus(byID[p.WalletID])}
+	}
+	return result, nil
+}
+
+// payeeStatus is the status of a payee paying the given wallet, which is nil
+// when it no longer exists.
+func payeeStatus(wallet *ent.User) string {
+	switch {
+	case wallet == nil || wallet.ErasedAt != nil:
+		return PayeeClosed
+	case wallet.FrozenAt != nil:
+		return PayeeFrozen
+	default:
+		return PayeeActive
+	}
+}
+
+func normalizeNickname(nickname string) (string, error) {
+	nickname = strings.TrimSpace(nickname)
+	if nickname == "" || utf8.RuneCountInString(nickname) > maxNicknameLength {
+		return "", ErrInvalidNickname
+	}
+	return nickname, nil
+}
+
+func validatePayee(defaultAmount *float64, memo string) error {
+	if defaultAmount != nil && *defaultAmount <= 0 {
+		return ErrInvalidDefaultAmount
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(memo)) > maxMemoLength {
+		return ErrInvalidMemo
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"shared/messages"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+var ErrReferralBonusesDisabled = errors.New("referral bonuses are disabled: no promotions wallet is configured")
+
+// referralBonusNamespace derives the request IDs of referral bonuses, so that
+// each bonus has a request ID of its own that does not change between
+// attempts.
+var referralBonusNamespace = uuid.MustParse("5d0b7a5e-2c4f-4b8e-9b8a-6f1d2e3c4b5a")
+
+// ReferralBonuses pays the bonuses of qualified referrals from the
+// promotions wallet.
+type ReferralBonuses struct {
+	transactions *TransactionsService
+	// promotionsWalletID is the wallet bonuses are paid from, or 0 when
+	// bonuses are disabled.
+	promotionsWalletID int
+}
+
+func NewReferralBonuses(transactions *TransactionsService, promotionsWalletID int) *ReferralBonuses {
+	return &ReferralBonuses{transactions: transactions, promotionsWalletID: promotionsWalletID}
+}
+
+// Pay transfers the bonuses of a referral from the promotions wallet to the
+// referrer and the referred user. Every bonus is a transfer with a request ID
+// derived from the referral, which the transactions table keeps unique, so a
+// bonus paid by an earlier or a concurrent attempt is not paid again.
+// Bonuses are not paid into erased wallets.
+func (b *ReferralBonuses) Pay(ctx context.Context, bonus messages.PayReferralBonus) error {
+	if b.promotionsWalletID == 0 {
+		return ErrReferralBonusesDisabled
+	}
+
+	parties := []struct {
+		role     string
+		walletID int
+		amount   float64
+	}{
+		{"referrer", bonus.ReferrerID, bonus.ReferrerBonus},
+		{"referred", bonus.ReferredID, bonus.ReferredBonus},
+	}
+	for _, p := range parties {
+		if p.amount <= 0 {
+			continue
+		}
+
+		wallet, err := b.transactions.GetWallet(ctx, p.walletID)
+		if err != nil {
+			return err
+		}
+		if wallet.ErasedAt != nil {
+			continue
+		}
+
+		_, err = b.transactions.TransferMoney(ctx, b.promotionsWalletID, p.walletID, p.amount, referralBonusRequestID(bonus.ReferralID, p.role))
+		if err != nil && !errors.Is(err, ErrDuplicateRequest) {
+			return fmt.Errorf("paying %s bonus of referral %d: %w", p.role, bonus.ReferralID, err)
+		}
+	}
+	return nil
+}
+
+// referralBonusRequestID returns the request ID of the bonus paid to the
+// given party of a referral.
+func referralBonusRequestID(referralID int, role string) uuid.UUID {
+	return uuid.NewSHA1(referralBonusNamespace, []byte(strconv.Itoa(referralID)+"/"+role))
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"shared/messages"
+	"testing"
+	"time"
+	"transactions-service/ent/enttest"
+	"transactions-service/ent/transaction"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestReferralBonusPayIdempotent(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	const promotionsWalletID = 100
+	client.User.Create().SetID(promotionsWalletID).SetEmail("promotions@example.com").SetVerifiedAt(time.Now()).SetBalance(1000).ExecX(ctx)
+	client.User.Create().SetID(1).SetEmail("ada@example.com").ExecX(ctx)
+	client.User.Create().SetID(2).SetEmail("bob@example.com").ExecX(ctx)
+	transactions := NewTransactionsService(client, 0, nil)
+	bonuses := NewReferralBonuses(transactions, promotionsWalletID)
+
+	bonus := messages.PayReferralBonus{ReferralID: 7, ReferrerID: 1, ReferredID: 2, ReferrerBonus: 10, ReferredBonus: 5}
+	for attempt := 1; attempt <= 3; attempt++ {
+		if err := bonuses.Pay(ctx, bonus); err != nil {
+			t.Fatalf("Pay attempt %d: %v", attempt, err)
+		}
+	}
+
+	for id, want := range map[int]float64{promotionsWalletID: 985, 1: 10, 2: 5} {
+		if got := client.User.GetX(ctx, id).Balance; got != want {
+			t.Errorf("balance of wallet %d = %v, want %v", id, got, want)
+		}
+	}
+	for _, role := range []string{"referrer", "referred"} {
+		requestID := referralBonusRequestID(bonus.ReferralID, role)
+		if n := client.Transaction.Query().Where(transaction.RequestIDEQ(requestID)).CountX(ctx); n != 2 {
+			t.Errorf("%s bonus has %d transactions, want a debit and a credit", role, n)
+		}
+	}
+
+	// A concurrent attempt passes checkRequestNotProcessed and is stopped by
+	// the unique index on the request ID and type.
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+	_, err = createTransactionRecord(ctx, tx, 1, 10, referralBonusRequestID(bonus.ReferralID, "referrer"), false)
+	if !errors.Is(err, ErrDuplicateRequest) {
+		t.Errorf("second credit of a bonus = %v, want %v", err, ErrDuplicateRequest)
+	}
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"shared/auth"
+	"time"
+	"transactions-service/ent"
+	"transactions-service/ent/transaction"
+	"transactions-service/ent/user"
+	"transactions-service/outbox"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUserNotFound      = errors.New("user not found")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrDuplicateRequest  = errors.New("request already processed")
+	ErrInvalidAmount     = errors.New("amount must be greater than zero")
+	ErrSameUser          = errors.New("cannot transfer money to the same user")
+	ErrMissingRequestID  = errors.New("request_id is required")
+	ErrWalletFrozen      = errors.New("wallet is frozen")
+	ErrEmailNotVerified  = errors.New("email is not verified")
+	ErrUserErased        = errors.New("user was erased")
+)
+
+// TransactionsService holds the business logic of wallet operations shared by every API.
+type TransactionsService struct {
+	client *ent.Client
+	// stepUpThreshold is the amount above which transfers need a step-up, or 0
+	// when none do.
+	stepUpThreshold float64
+	aliases         AliasResolver
+}
+
+func NewTransactionsService(client *ent.Client, stepUpThreshold float64, aliases AliasResolver) *TransactionsService {
+	return &TransactionsService{client: client, stepUpThreshold: stepUpThreshold, aliases: aliases}
+}
+
+// CheckStepUp checks that the caller stepped up with their second factor when
+// sending amount is above the step-up threshold. ctx carries the principal of
+// the request.
+func (s *TransactionsService) CheckStepUp(ctx context.Context, amount float64) error {
+	if s.stepUpThreshold <= 0 || amount <= s.stepUpThreshold {
+		return nil
+	}
+	return auth.RequireStepUp(ctx)
+}
+
+// AddMoney credits amount to the user's wallet and returns the updated user.
+func (s *TransactionsService) AddMoney(ctx context.Context, userID int, amount float64, requestID uuid.UUID) (*ent.User, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if requestID == uuid.Nil {
+		return nil, ErrMissingRequestID
+	}
+
+	var updated *ent.User
+	err := s.withTx(ctx, func(tx *ent.Tx) error {
+		if err := checkRequestNotProcessed(ctx, tx, requestID); err != nil {
+			return err
+		}
+
+		u, err := updateUserBalance(ctx, tx, userID, amount)
+		if err != nil {
+			return err
+		}
+
+		if _, err := createTransactionRecord(ctx, tx, userID, amount, requestID, true); err != nil {
+			return fmt.Errorf("creating transaction record: %w", err)
+		}
+
+		if err := outbox.RecordBalanceChanged(ctx, tx, u); err != nil {
+			return fmt.Errorf("recording balance event: %w", err)
+		}
+
+		updated = u
+		return nil
+	})
+	return updated, err
+}
+
+// TransferMoney moves amount from one user's wallet to another's and returns the transfer.
+func (s *TransactionsService) TransferMoney(ctx context.Context, fromUserID int, toUserID int, amount float64, requestID uuid.UUID) (*Transfer, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if fromUserID == toUserID {
+		return nil, ErrSameUser
+	}
+	if requestID == uuid.Nil {
+		return nil, ErrMissingRequestID
+	}
+
+	var transfer *Transfer
+	err := s.withTx(ctx, func(tx *ent.Tx) error {
+		if err := checkRequestNotProcessed(ctx, tx, requestID); err != nil {
+			return err
+		}
+
+		fromUser, err := updateUserBalance(ctx, tx, fromUserID, -amount)
+		if err != nil {
+			return fmt.Errorf("updating from user balance: %w", err)
+		}
+
+		toUser, err := updateUserBalance(ctx, tx, toUserID, amount)
+		if err != nil {
+			return fmt.Errorf("updating to user balance: %w", err)
+		}
+
+		if _, err := createTransactionRecord(ctx, tx, fromUserID, -amount, requestID, false); err != nil {
+			return fmt.Errorf("creating from user transaction record: %w", err)
+		}
+
+		credit, err := createTransactionRecord(ctx, tx, toUserID, amount, requestID, false)
+		if err != nil {
+			return fmt.Errorf("creating to user transaction record: %w", err)
+		}
+
+		for _, u := range []*ent.User{fromUser, toUser} {
+			if err := outbox.RecordBalanceChanged(ctx, tx, u); err != nil {
+				return fmt.Errorf("recording balance event: %w", err)
+			}
+		}
+
+		transfer = &Transfer{
+			ID:           requestID,
+			FromWalletID: fromUserID,
+			ToWalletID:   toUserID,
+			Amount:       amount,
+			CreatedAt:    credit.CreatedAt,
+		}
+		return nil
+	})
+	return transfer, err
+}
+
+// GetWallet returns the user owning the wallet.
+func (s *TransactionsService) GetWallet(ctx context.Context, userID int) (*ent.User, error) {
+	u, err := s.client.User.Get(ctx, userID)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: id %d", ErrUserNotFound, userID)
+	}
+	return u, err
+}
+
+// withTx runs fn in a transaction that is committed when fn succeeds and rolled back otherwise.
+func (s *TransactionsService) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// updateUserBalance adds amount to the user's balance. Debits only apply when
+// the balance covers them and the email is verified, and frozen wallets are
+// never changed, all checked in the same statement as the update.
+func updateUserBalance(ctx context.Context, tx *ent.Tx, userID int, amount float64) (*ent.User, error) {
+	update := tx.User.Update().Where(user.IDEQ(userID), user.FrozenAtIsNil())
+	if amount < 0 {
+		update = update.Where(user.BalanceGTE(-amount), user.VerifiedAtNotNil())
+	}
+
+	n, err := update.AddBalance(amount).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		u, err := tx.User.Get(ctx, userID)
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: id %d", ErrUserNotFound, userID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if u.FrozenAt != nil {
+			return nil, fmt.Errorf("%w: id %d", ErrWalletFrozen, userID)
+		}
+		if amount < 0 && u.VerifiedAt == nil {
+			return nil, fmt.Errorf("%w: id %d", ErrEmailNotVerified, userID)
+		}
+		return nil, ErrInsufficientFunds
+	}
+
+	return tx.User.Get(ctx, userID)
+}
+
+// createTransactionRecord stores a transaction record together with its
+// transaction-created event. deposit marks the credit of a top-up.
+func createTransactionRecord(ctx context.Context, tx *ent.Tx, userID int, amount float64, requestId uuid.UUID, deposit bool) (*ent.Transaction, error) {
+	var t transaction.Type
+	if amount > 0 {
+		t = transaction.TypeCredit
+	} else {
+		t = transaction.TypeDebit
+	}
+
+	record, err := tx.Transaction.Create().
+		SetUserID(userID).
+		SetAmount(amount).
+		SetCreatedAt(time.Now()).
+		SetRequestID(requestId).
+		SetType(t).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, ErrDuplicateRequest
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := outbox.RecordTransactionCreated(ctx, tx, userID, record, deposit); err != nil {
+		return nil, fmt.Errorf("recording transaction event: %w", err)
+	}
+	return record, nil
+}
+
+// checkRequestNotProcessed refuses a request that was already applied. A
+// retry running at the same time as the first attempt passes it, and is
+// refused by the unique index on the request ID and type of transactions.
+func checkRequestNotProcessed(ctx context.Context, tx *ent.Tx, requestID uuid.UUID) error {
+	exists, err := tx.Transaction.Query().Where(transaction.RequestIDEQ(requestID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("checking request id: %w", err)
+	}
+	if exists {
+		return ErrDuplicateRequest
+	}
+	return nil
+}
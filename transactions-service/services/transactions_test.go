@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"shared/auth"
+	"shared/problems"
+	"testing"
+	"time"
+)
+
+func TestCheckStepUp(t *testing.T) {
+	now := time.Now()
+	user := &auth.Principal{UserID: 1, Scopes: auth.UserScopes}
+	steppedUp := &auth.Principal{UserID: 1, Scopes: auth.UserScopes, SteppedUpAt: &now}
+
+	tests := []struct {
+		name      string
+		threshold float64
+		amount    float64
+		principal *auth.Principal
+		wantErr   bool
+	}{
+		{name: "no threshold", amount: 1e6, principal: user},
+		{name: "below threshold", threshold: 500, amount: 499.99, principal: user},
+		{name: "at threshold", threshold: 500, amount: 500, principal: user},
+		{name: "above threshold", threshold: 500, amount: 500.01, principal: user, wantErr: true},
+		{name: "above threshold after step-up", threshold: 500, amount: 1e6, principal: steppedUp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewTransactionsService(nil, tt.threshold, nil)
+			err := s.CheckStepUp(auth.WithPrincipal(context.Background(), tt.principal), tt.amount)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("CheckStepUp: %v", err)
+				}
+				return
+			}
+			if p, ok := problems.As(err); !ok || p.Code != problems.CodeStepUpRequired {
+				t.Fatalf("CheckStepUp error = %v, want %s", err, problems.CodeStepUpRequired)
+			}
+		})
+	}
+}
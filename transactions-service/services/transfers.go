@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactions-service/ent"
+	"transactions-service/ent/predicate"
+	"transactions-service/ent/transaction"
+	"transactions-service/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+var ErrTransferNotFound = errors.New("transfer not found")
+
+// Transfer is money moved from one wallet to another. It is identified by the
+// request ID it was made with, which its debit and credit records share.
+type Transfer struct {
+	ID           uuid.UUID
+	FromWalletID int
+	ToWalletID   int
+	Amount       float64
+	CreatedAt    time.Time
+}
+
+// GetTransfer returns the transfer made with the given request ID.
+func (s *TransactionsService) GetTransfer(ctx context.Context, id uuid.UUID) (*Transfer, error) {
+	records, err := s.client.Transaction.Query().
+		Where(transaction.RequestIDEQ(id)).
+		WithUser(selectUserID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := transferFromRecords(records)
+	if !ok {
+		return nil, fmt.Errorf("%w: id %s", ErrTransferNotFound, id)
+	}
+	return t, nil
+}
+
+// ListTransfers returns a page of the transfers sent or received by a wallet,
+// newest first, together with the total number of its transfers.
+func (s *TransactionsService) ListTransfers(ctx context.Context, walletID int, limit int, offset int) ([]*Transfer, int, error) {
+	if _, err := s.GetWallet(ctx, walletID); err != nil {
+		return nil, 0, err
+	}
+
+	query := s.client.Transaction.Query().
+		Where(transaction.HasUserWith(user.IDEQ(walletID)), isTransfer())
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var page []struct {
+		RequestID uuid.UUID `json:"request_id"`
+	}
+	err = query.
+		Order(ent.Desc(transaction.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		Select(transaction.FieldRequestID).
+		Scan(ctx, &page)
+	if err != nil {
+		return nil, 0, err
+	}
+	requestIDs := make([]uuid.UUID, 0, len(page))
+	for _, p := range page {
+		requestIDs = append(requestIDs, p.RequestID)
+	}
+
+	records, err := s.client.Transaction.Query().
+		Where(transaction.RequestIDIn(requestIDs...)).
+		WithUser(selectUserID).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	byRequest := make(map[uuid.UUID][]*ent.Transaction, len(requestIDs))
+	for _, r := range records {
+		byRequest[r.RequestID] = append(byRequest[r.RequestID], r)
+	}
+
+	transfers := make([]*Transfer, 0, len(requestIDs))
+	for _, id := range requestIDs {
+		if t, ok := transferFromRecords(byRequest[id]); ok {
+			transfers = append(transfers, t)
+		}
+	}
+	return transfers, total, nil
+}
+
+// isTransfer matches the records of transfers, whose request ID is shared by
+// a debit and a credit. Deposits have a single record.
+func isTransfer() predicate.Transaction {
+	return func(s *sql.Selector) {
+		t := sql.Table(transaction.Table).As("transfers")
+		s.Where(sql.In(s.C(transaction.FieldRequestID),
+			sql.Select(t.C(transaction.FieldRequestID)).
+				From(t).
+				GroupBy(t.C(transaction.FieldRequestID)).
+				Having(sql.GT(sql.Count("*"), 1)),
+		))
+	}
+}
+
+func selectUserID(q *ent.UserQuery) {
+	q.Select(user.FieldID)
+}
+
+// transferFromRecords builds a transfer from its debit and credit records.
+func transferFromRecords(records []*ent.Transaction) (*Transfer, bool) {
+	var debit, credit *ent.Transaction
+	for _, r := range records {
+		switch r.Type {
+		case transaction.TypeDebit:
+			debit = r
+		case transaction.TypeCredit:
+			credit = r
+		}
+	}
+	if len(records) != 2 || debit == nil || credit == nil || debit.Edges.User == nil || credit.Edges.User == nil {
+		return nil, false
+	}
+
+	return &Transfer{
+		ID:           credit.RequestID,
+		FromWalletID: debit.Edges.User.ID,
+		ToWalletID:   credit.Edges.User.ID,
+		Amount:       credit.Amount,
+		CreatedAt:    credit.CreatedAt,
+	}, true
+}
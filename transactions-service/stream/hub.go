@@ -0,0 +1,134 @@
+// Package stream pushes the events of a wallet to its connected clients as
+// they are published, on every replica.
+package stream
+
+import (
+	"encoding/json"
+	"log"
+	"shared/messages"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// EventTypes lists the event types pushed to streams.
+var EventTypes = []string{
+	messages.EventBalanceChanged,
+	messages.EventTransactionCreated,
+}
+
+// subscriptionBuffer is how many events a slow client may lag behind before
+// its subscription is dropped.
+const subscriptionBuffer = 64
+
+var subscribers = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "stream_subscribers",
+	Help: "Number of clients connected to a wallet event stream.",
+})
+
+// Hub fans the events published on NATS out to the subscriptions of the
+// wallet they belong to. Every replica subscribes without a queue group, so
+// each one receives every event and serves its own clients.
+type Hub struct {
+	natsConn *nats.Conn
+
+	mu   sync.Mutex
+	subs map[int]map[*Subscription]struct{}
+}
+
+// Subscription receives the events of one wallet. C is closed when the
+// subscription is closed or dropped because the client fell behind.
+type Subscription struct {
+	C <-chan messages.Event
+
+	c      chan messages.Event
+	hub    *Hub
+	userID int
+}
+
+func NewHub(natsConn *nats.Conn) *Hub {
+	return &Hub{
+		natsConn: natsConn,
+		subs:     make(map[int]map[*Subscription]struct{}),
+	}
+}
+
+// Start subscribes to the event subjects.
+func (h *Hub) Start() error {
+	for _, eventType := range EventTypes {
+		if _, err := h.natsConn.Subscribe(eventType, h.handleEvent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscribe starts receiving the events of a wallet.
+func (h *Hub) Subscribe(userID int) *Subscription {
+	c := make(chan messages.Event, subscriptionBuffer)
+	s := &Subscription{C: c, c: c, hub: h, userID: userID}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[userID] == nil {
+		h.subs[userID] = make(map[*Subscription]struct{})
+	}
+	h.subs[userID][s] = struct{}{}
+	subscribers.Inc()
+	return s
+}
+
+// Close stops the subscription.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s)
+}
+
+func (h *Hub) handleEvent(m *nats.Msg) {
+	var event messages.Event
+	if err := json.Unmarshal(m.Data, &event); err != nil {
+		log.Printf("error unmarshalling %s event: %v", m.Subject, err)
+		return
+	}
+	// Every streamed event carries the wallet it belongs to.
+	var owner struct {
+		UserID int `json:"user_id"`
+	}
+	if err := json.Unmarshal(event.Data, &owner); err != nil {
+		log.Printf("error unmarshalling %s event %d: %v", m.Subject, event.Sequence, err)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subs[owner.UserID] {
+		select {
+		case s.c <- event:
+		default:
+			// The client is not keeping up; it reconnects and resumes from
+			// the last event it received.
+			h.remove(s)
+		}
+	}
+}
+
+// remove closes a subscription. h.mu must be held.
+func (h *Hub) remove(s *Subscription) {
+	subs, ok := h.subs[s.userID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[s]; !ok {
+		return
+	}
+
+	delete(subs, s)
+	if len(subs) == 0 {
+		delete(h.subs, s.userID)
+	}
+	close(s.c)
+	subscribers.Dec()
+}
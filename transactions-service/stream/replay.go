@@ -0,0 +1,61 @@
+package stream
+
+import (
+	"context"
+	"shared/messages"
+	"transactions-service/ent"
+	"transactions-service/ent/event"
+	"transactions-service/outbox"
+)
+
+const replayBatchSize = 500
+
+// Replay calls fn with the stored events of a wallet that follow the event
+// with sequence after, in sequence order.
+func Replay(ctx context.Context, client *ent.Client, userID int, after int, fn func(messages.Event) error) error {
+	for {
+		events, err := client.Event.Query().
+			Where(
+				event.UserIDEQ(userID),
+				event.TypeIn(EventTypes...),
+				event.IDGT(after),
+			).
+			Order(ent.Asc(event.FieldID)).
+			Limit(replayBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			if err := fn(outbox.Envelope(e)); err != nil {
+				return err
+			}
+			after = e.ID
+		}
+
+		if len(events) < replayBatchSize {
+			return nil
+		}
+	}
+}
+
+// Latest returns the last balance-changed event of a wallet, so a new stream
+// can start from the current balance. It returns false for wallets whose
+// balance never changed.
+func Latest(ctx context.Context, client *ent.Client, userID int) (messages.Event, bool, error) {
+	e, err := client.Event.Query().
+		Where(
+			event.UserIDEQ(userID),
+			event.TypeEQ(messages.EventBalanceChanged),
+		).
+		Order(ent.Desc(event.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return messages.Event{}, false, nil
+	}
+	if err != nil {
+		return messages.Event{}, false, err
+	}
+	return outbox.Envelope(e), true, nil
+}
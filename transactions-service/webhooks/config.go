@@ -0,0 +1,89 @@
+package webhooks
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config controls how deliveries are sent and retried.
+type Config struct {
+	// Timeout bounds a single delivery attempt.
+	Timeout time.Duration
+	// MaxAttempts is the number of attempts after which a delivery fails.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry; it doubles with every
+	// further attempt up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// DisableAfter is the number of consecutive failed attempts, across all
+	// deliveries, after which an endpoint is disabled.
+	DisableAfter int
+}
+
+var defaultConfig = Config{
+	Timeout:        10 * time.Second,
+	MaxAttempts:    8,
+	InitialBackoff: 30 * time.Second,
+	MaxBackoff:     time.Hour,
+	DisableAfter:   20,
+}
+
+// LoadConfig reads the delivery settings from WEBHOOK_TIMEOUT,
+// WEBHOOK_MAX_ATTEMPTS, WEBHOOK_INITIAL_BACKOFF, WEBHOOK_MAX_BACKOFF and
+// WEBHOOK_DISABLE_AFTER, falling back to the defaults for unset variables.
+func LoadConfig() (Config, error) {
+	config := defaultConfig
+
+	var err error
+	if config.Timeout, err = durationFromEnv("WEBHOOK_TIMEOUT", config.Timeout); err != nil {
+		return Config{}, err
+	}
+	if config.MaxAttempts, err = positiveIntFromEnv("WEBHOOK_MAX_ATTEMPTS", config.MaxAttempts); err != nil {
+		return Config{}, err
+	}
+	if config.InitialBackoff, err = durationFromEnv("WEBHOOK_INITIAL_BACKOFF", config.InitialBackoff); err != nil {
+		return Config{}, err
+	}
+	if config.MaxBackoff, err = durationFromEnv("WEBHOOK_MAX_BACKOFF", config.MaxBackoff); err != nil {
+		return Config{}, err
+	}
+	if config.DisableAfter, err = positiveIntFromEnv("WEBHOOK_DISABLE_AFTER", config.DisableAfter); err != nil {
+		return Config{}, err
+	}
+	return config, nil
+}
+
+// backoff returns the wait before the attempt following attempt number n.
+func (c Config) backoff(n int) time.Duration {
+	d := c.InitialBackoff
+	for i := 1; i < n && d < c.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, c.MaxBackoff)
+}
+
+func positiveIntFromEnv(key string, fallback int) (int, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%s must be a positive integer", key)
+	}
+	return n, nil
+}
+
+func durationFromEnv(key string, fallback time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%s must be a positive duration", key)
+	}
+	return d, nil
+}
@@ -0,0 +1,228 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+	"transactions-service/ent"
+	"transactions-service/ent/webhookdelivery"
+	"transactions-service/ent/webhookendpoint"
+	"transactions-service/jobs"
+)
+
+// Headers sent with every delivery. HeaderID is the sequence of the event, the
+// same for every delivery of it, so receivers can deduplicate redeliveries.
+const (
+	HeaderID        = "Webhook-Id"
+	HeaderEvent     = "Webhook-Event"
+	HeaderTimestamp = "Webhook-Timestamp"
+	HeaderSignature = "Webhook-Signature"
+)
+
+const (
+	deliverBatchSize = 50
+	// claimMargin is added to the timeout of an attempt for how long a
+	// claimed delivery is left to the replica sending it.
+	claimMargin = 30 * time.Second
+	// maxResponseBody is how much of a response is read before the connection is reused.
+	maxResponseBody = 64 << 10
+)
+
+// Sign returns the signature of a delivery: the hex-encoded HMAC-SHA256 of
+// "<timestamp>.<body>" keyed with the endpoint's secret, prefixed with "sha256=".
+// Receivers recompute it to verify that a delivery is authentic, and reject
+// old timestamps to prevent replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Deliver returns a job that sends the pending deliveries that are due to
+// their endpoints. Failed attempts are retried with exponential backoff until
+// config.MaxAttempts, and endpoints that keep failing are disabled.
+func Deliver(client *ent.Client, config Config) jobs.Job {
+	d := &deliverer{
+		client:     client,
+		httpClient: &http.Client{Timeout: config.Timeout},
+		config:     config,
+	}
+	return jobs.Job{
+		Name:     "webhook-deliver",
+		Interval: time.Second,
+		Run: func(ctx context.Context) error {
+			for {
+				n, err := d.deliverBatch(ctx)
+				if err != nil || n < deliverBatchSize {
+					return err
+				}
+			}
+		},
+	}
+}
+
+type deliverer struct {
+	client     *ent.Client
+	httpClient *http.Client
+	config     Config
+}
+
+func (d *deliverer) deliverBatch(ctx context.Context) (int, error) {
+	deliveries, err := d.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(time.Now()),
+			webhookdelivery.HasEndpointWith(webhookendpoint.Enabled(true)),
+		).
+		WithEndpoint().
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(deliverBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Endpoints disabled while this batch is sent are skipped for the rest of it.
+	disabled := make(map[int]bool)
+	for _, delivery := range deliveries {
+		if disabled[delivery.EndpointID] {
+			continue
+		}
+		endpointDisabled, err := d.attempt(ctx, delivery)
+		if err != nil {
+			return 0, err
+		}
+		if endpointDisabled {
+			disabled[delivery.EndpointID] = true
+		}
+	}
+	return len(deliveries), nil
+}
+
+// attempt sends a delivery once and records the outcome. It reports whether
+// the endpoint was disabled because of the failure. A batch takes up to
+// deliverBatchSize timeouts to send, so each delivery is claimed right before
+// it is sent by moving its next attempt past the attempt's timeout; a
+// delivery claimed or sent meanwhile by another replica is skipped, and one
+// whose sender stopped is due again once the claim runs out.
+func (d *deliverer) attempt(ctx context.Context, delivery *ent.WebhookDelivery) (bool, error) {
+	claimedUntil := time.Now().Add(d.config.Timeout + claimMargin)
+	n, err := d.client.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.ID(delivery.ID),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtEQ(delivery.NextAttemptAt),
+		).
+		SetNextAttemptAt(claimedUntil).
+		Save(ctx)
+	if err != nil || n == 0 {
+		return false, err
+	}
+
+	endpoint := delivery.Edges.Endpoint
+	status, sendErr := d.send(ctx, endpoint, delivery)
+
+	attempts := delivery.Attempts + 1
+	// The outcome is only recorded while the claim holds.
+	update := delivery.Update().
+		Where(webhookdelivery.NextAttemptAtEQ(claimedUntil)).
+		SetAttempts(attempts)
+	if status != 0 {
+		update = update.SetResponseStatus(status)
+	}
+
+	if sendErr == nil {
+		err := update.
+			SetStatus(webhookdelivery.StatusSucceeded).
+			SetDeliveredAt(time.Now()).
+			SetError("").
+			Exec(ctx)
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if endpoint.ConsecutiveFailures > 0 {
+			return false, d.client.WebhookEndpoint.UpdateOneID(endpoint.ID).SetConsecutiveFailures(0).Exec(ctx)
+		}
+		return false, nil
+	}
+
+	update = update.SetError(sendErr.Error())
+	if attempts >= d.config.MaxAttempts {
+		update = update.SetStatus(webhookdelivery.StatusFailed)
+	} else {
+		update = update.SetNextAttemptAt(time.Now().Add(d.config.backoff(attempts)))
+	}
+	err = update.Exec(ctx)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return d.recordFailure(ctx, endpoint.ID)
+}
+
+// recordFailure counts a failed attempt against an endpoint and disables the
+// endpoint once it failed config.DisableAfter times in a row.
+func (d *deliverer) recordFailure(ctx context.Context, endpointID int) (bool, error) {
+	endpoint, err := d.client.WebhookEndpoint.UpdateOneID(endpointID).AddConsecutiveFailures(1).Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	if endpoint.ConsecutiveFailures < d.config.DisableAfter {
+		return false, nil
+	}
+
+	err = d.client.WebhookEndpoint.UpdateOneID(endpointID).
+		SetEnabled(false).
+		SetDisabledAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	log.Printf("disabled webhook endpoint %d after %d consecutive failures", endpointID, endpoint.ConsecutiveFailures)
+	return true, nil
+}
+
+// send posts a delivery to its endpoint and returns the response status. Any
+// status outside 2xx is an error.
+func (d *deliverer) send(ctx context.Context, endpoint *ent.WebhookEndpoint, delivery *ent.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("building request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "transactions-service-webhooks")
+	req.Header.Set(HeaderID, strconv.Itoa(delivery.EventID))
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(endpoint.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,106 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"transactions-service/ent"
+	"transactions-service/ent/event"
+	"transactions-service/ent/webhookendpoint"
+	"transactions-service/jobs"
+	"transactions-service/outbox"
+)
+
+const dispatchBatchSize = 100
+
+// Dispatch returns a job that turns committed outbox events into deliveries,
+// one for every enabled endpoint of the event's wallet that subscribed to its
+// type. Events are dispatched once, in sequence order; endpoints registered
+// later only receive later events.
+func Dispatch(client *ent.Client) jobs.Job {
+	return jobs.Job{
+		Name:     "webhook-dispatch",
+		Interval: time.Second,
+		Run: func(ctx context.Context) error {
+			for {
+				n, err := dispatchBatch(ctx, client)
+				if err != nil || n < dispatchBatchSize {
+					return err
+				}
+			}
+		},
+	}
+}
+
+func dispatchBatch(ctx context.Context, client *ent.Client) (int, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	n, err := dispatchEvents(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("committing transaction: %w", err)
+	}
+	return n, nil
+}
+
+func dispatchEvents(ctx context.Context, tx *ent.Tx) (int, error) {
+	events, err := tx.Event.Query().
+		Where(event.DispatchedAtIsNil()).
+		Order(ent.Asc(event.FieldID)).
+		Limit(dispatchBatchSize).
+		All(ctx)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+
+	walletIDs := make([]int, 0, len(events))
+	for _, e := range events {
+		walletIDs = append(walletIDs, e.UserID)
+	}
+	endpoints, err := tx.WebhookEndpoint.Query().
+		Where(webhookendpoint.Enabled(true), webhookendpoint.WalletIDIn(walletIDs...)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var deliveries []*ent.WebhookDeliveryCreate
+	ids := make([]int, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+
+		payload, err := json.Marshal(outbox.Envelope(e))
+		if err != nil {
+			return 0, err
+		}
+		for _, endpoint := range endpoints {
+			if !subscribed(endpoint, e) {
+				continue
+			}
+			deliveries = append(deliveries, tx.WebhookDelivery.Create().
+				SetEndpointID(endpoint.ID).
+				SetEventID(e.ID).
+				SetEventType(e.Type).
+				SetPayload(payload))
+		}
+	}
+
+	if len(deliveries) > 0 {
+		if err := tx.WebhookDelivery.CreateBulk(deliveries...).Exec(ctx); err != nil {
+			return 0, fmt.Errorf("creating deliveries: %w", err)
+		}
+	}
+	if err := tx.Event.Update().Where(event.IDIn(ids...)).SetDispatchedAt(time.Now()).Exec(ctx); err != nil {
+		return 0, fmt.Errorf("marking events dispatched: %w", err)
+	}
+
+	return len(events), nil
+}
@@ -0,0 +1,88 @@
+package webhooks
+
+import (
+	"context"
+	"shared/messages"
+	"slices"
+	"testing"
+	"transactions-service/ent/enttest"
+	"transactions-service/ent/webhookdelivery"
+	"transactions-service/outbox"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestDispatchScopesEventsToTheirWallet(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	s := NewService(client)
+
+	client.User.Create().SetID(1).SetEmail("ada@example.com").ExecX(ctx)
+	client.User.Create().SetID(2).SetEmail("bob@example.com").ExecX(ctx)
+	ada, err := s.Register(ctx, "acme", 1, "https://acme.example.com/hooks", nil)
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	bob, err := s.Register(ctx, "acme", 2, "https://acme.example.com/hooks", []string{messages.EventTransactionCreated})
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if _, err := s.Register(ctx, "acme", 3, "https://acme.example.com/hooks", nil); err == nil {
+		t.Error("Register for a wallet that does not exist succeeded")
+	}
+	// An endpoint registered before endpoints were scoped receives nothing.
+	legacy := client.WebhookEndpoint.Create().
+		SetClient("legacy").
+		SetURL("https://legacy.example.com/hooks").
+		SetSecret("whsec_legacy").
+		SaveX(ctx)
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, userID := range []int{1, 2, 1} {
+		err := outbox.Record(ctx, tx, messages.EventBalanceChanged, userID, messages.BalanceChanged{UserID: userID})
+		if err != nil {
+			tx.Rollback()
+			t.Fatal(err)
+		}
+	}
+	if err := outbox.Record(ctx, tx, messages.EventTransactionCreated, 2, messages.TransactionCreated{UserID: 2}); err != nil {
+		tx.Rollback()
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := dispatchBatch(ctx, client); err != nil {
+		t.Fatalf("dispatchBatch: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		endpointID int
+		wantEvents []int
+	}{
+		{"wallet 1", ada.ID, []int{1, 3}},
+		{"wallet 2, transactions only", bob.ID, []int{4}},
+		{"unscoped endpoint", legacy.ID, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deliveries := client.WebhookDelivery.Query().
+				Where(webhookdelivery.EndpointIDEQ(tt.endpointID)).
+				Order(webhookdelivery.ByEventID()).
+				AllX(ctx)
+			var got []int
+			for _, d := range deliveries {
+				got = append(got, d.EventID)
+			}
+			if !slices.Equal(got, tt.wantEvents) {
+				t.Errorf("endpoint %d received events %v, want %v", tt.endpointID, got, tt.wantEvents)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// Package webhooks pushes the events committed to the outbox to the HTTP
+// endpoints registered by integrators.
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"shared/messages"
+	"slices"
+	"time"
+	"transactions-service/ent"
+	"transactions-service/ent/user"
+	"transactions-service/ent/webhookdelivery"
+	"transactions-service/ent/webhookendpoint"
+)
+
+var (
+	ErrEndpointNotFound  = errors.New("webhook endpoint not found")
+	ErrDeliveryNotFound  = errors.New("webhook delivery not found")
+	ErrInvalidURL        = errors.New("url must be an absolute http or https URL")
+	ErrUnknownEventType  = errors.New("unknown event type")
+	ErrMissingClientName = errors.New("client is required")
+	ErrMissingWallet     = errors.New("wallet_id is required")
+	ErrUnknownWallet     = errors.New("wallet not found")
+	ErrInvalidStatus     = errors.New("status must be pending, succeeded or failed")
+)
+
+// EventTypes lists the event types endpoints can subscribe to.
+var EventTypes = []string{
+	messages.EventBalanceChanged,
+	messages.EventTransactionCreated,
+}
+
+// secretPrefix marks signing secrets so they are recognisable when leaked.
+const secretPrefix = "whsec_"
+
+// EndpointUpdate holds the fields of an endpoint to change; nil fields are kept.
+type EndpointUpdate struct {
+	URL        *string
+	EventTypes *[]string
+	Enabled    *bool
+}
+
+// Service manages webhook endpoints and their delivery log.
+type Service struct {
+	client *ent.Client
+}
+
+func NewService(client *ent.Client) *Service {
+	return &Service{client: client}
+}
+
+// Register creates an endpoint for the events of a wallet with a new signing
+// secret. An empty list of event types subscribes the endpoint to every type.
+func (s *Service) Register(ctx context.Context, clientName string, walletID int, endpointURL string, eventTypes []string) (*ent.WebhookEndpoint, error) {
+	if clientName == "" {
+		return nil, ErrMissingClientName
+	}
+	if walletID == 0 {
+		return nil, ErrMissingWallet
+	}
+	if err := validateURL(endpointURL); err != nil {
+		return nil, err
+	}
+	if err := validateEventTypes(eventTypes); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.client.User.Query().Where(user.IDEQ(walletID)).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: id %d", ErrUnknownWallet, walletID)
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.WebhookEndpoint.Create().
+		SetClient(clientName).
+		SetWalletID(walletID).
+		SetURL(endpointURL).
+		SetSecret(secret).
+		SetEventTypes(eventTypes).
+		Save(ctx)
+}
+
+// List returns the endpoints of a client, or of every client when clientName is empty.
+func (s *Service) List(ctx context.Context, clientName string) ([]*ent.WebhookEndpoint, error) {
+	query := s.client.WebhookEndpoint.Query()
+	if clientName != "" {
+		query = query.Where(webhookendpoint.ClientEQ(clientName))
+	}
+	return query.Order(ent.Asc(webhookendpoint.FieldID)).All(ctx)
+}
+
+// Get returns the endpoint with the given ID.
+func (s *Service) Get(ctx context.Context, id int) (*ent.WebhookEndpoint, error) {
+	e, err := s.client.WebhookEndpoint.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: id %d", ErrEndpointNotFound, id)
+	}
+	return e, err
+}
+
+// Update changes an endpoint. Re-enabling an endpoint clears its failure count,
+// so deliveries that are still pending are attempted again.
+func (s *Service) Update(ctx context.Context, id int, update EndpointUpdate) (*ent.WebhookEndpoint, error) {
+	e, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	mutation := e.Update()
+	if update.URL != nil {
+		if err := validateURL(*update.URL); err != nil {
+			return nil, err
+		}
+		mutation = mutation.SetURL(*update.URL)
+	}
+	if update.EventTypes != nil {
+		if err := validateEventTypes(*update.EventTypes); err != nil {
+			return nil, err
+		}
+		mutation = mutation.SetEventTypes(*update.EventTypes)
+	}
+	if update.Enabled != nil && *update.Enabled != e.Enabled {
+		if *update.Enabled {
+			mutation = mutation.SetEnabled(true).SetConsecutiveFailures(0).ClearDisabledAt()
+		} else {
+			mutation = mutation.SetEnabled(false).SetDisabledAt(time.Now())
+		}
+	}
+
+	return mutation.Save(ctx)
+}
+
+// Delete removes an endpoint together with its delivery log.
+func (s *Service) Delete(ctx context.Context, id int) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	if _, err := tx.WebhookDelivery.Delete().Where(webhookdelivery.EndpointIDEQ(id)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.WebhookEndpoint.DeleteOneID(id).Exec(ctx); err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: id %d", ErrEndpointNotFound, id)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// Deliveries returns a page of the delivery log of an endpoint, newest first,
+// together with the total number of matching deliveries. An empty status
+// matches every delivery.
+func (s *Service) Deliveries(ctx context.Context, endpointID int, status string, limit int, offset int) ([]*ent.WebhookDelivery, int, error) {
+	if _, err := s.Get(ctx, endpointID); err != nil {
+		return nil, 0, err
+	}
+
+	query := s.client.WebhookDelivery.Query().Where(webhookdelivery.EndpointIDEQ(endpointID))
+	if status != "" {
+		st := webhookdelivery.Status(status)
+		if err := webhookdelivery.StatusValidator(st); err != nil {
+			return nil, 0, ErrInvalidStatus
+		}
+		query = query.Where(webhookdelivery.StatusEQ(st))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	deliveries, err := query.Order(ent.Desc(webhookdelivery.FieldID)).Limit(limit).Offset(offset).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return deliveries, total, nil
+}
+
+// GetDelivery returns a delivery of an endpoint.
+func (s *Service) GetDelivery(ctx context.Context, endpointID int, id int) (*ent.WebhookDelivery, error) {
+	d, err := s.client.WebhookDelivery.Query().
+		Where(webhookdelivery.IDEQ(id), webhookdelivery.EndpointIDEQ(endpointID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: id %d", ErrDeliveryNotFound, id)
+	}
+	return d, err
+}
+
+// Redeliver queues the event of a delivery to be sent to its endpoint again.
+// The original delivery is kept in the log and a new one is returned.
+func (s *Service) Redeliver(ctx context.Context, endpointID int, id int) (*ent.WebhookDelivery, error) {
+	d, err := s.GetDelivery(ctx, endpointID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.WebhookDelivery.Create().
+		SetEndpointID(d.EndpointID).
+		SetEventID(d.EventID).
+		SetEventType(d.EventType).
+		SetPayload(d.Payload).
+		Save(ctx)
+}
+
+// subscribed reports whether an endpoint receives an event: one of its wallet,
+// of a type it subscribed to.
+func subscribed(e *ent.WebhookEndpoint, event *ent.Event) bool {
+	if e.WalletID == nil || *e.WalletID != event.UserID {
+		return false
+	}
+	return len(e.EventTypes) == 0 || slices.Contains(e.EventTypes, event.Type)
+}
+
+func validateURL(endpointURL string) error {
+	u, err := url.Parse(endpointURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidURL
+	}
+	return nil
+}
+
+func validateEventTypes(eventTypes []string) error {
+	for _, t := range eventTypes {
+		if !slices.Contains(EventTypes, t) {
+			return fmt.Errorf("%w: %s", ErrUnknownEventType, t)
+		}
+	}
+	return nil
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating webhook secret: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,290 @@
+// Package apikeys manages the API keys server-to-server integrations
+// authenticate with, and verifies them for both services.
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"shared/auth"
+	"shared/messages"
+	"slices"
+	"time"
+	"user-service/ent"
+	"user-service/ent/apikey"
+	"user-service/ent/user"
+	"user-service/services"
+)
+
+var (
+	ErrAPIKeyNotFound = errors.New("API key not found")
+	ErrAPIKeyInactive = errors.New("API key is revoked, expired or already rotated")
+	ErrMissingName    = errors.New("name is required")
+	ErrNoScopes       = errors.New("at least one scope is required")
+	ErrUnknownScope   = errors.New("unknown scope")
+	ErrInvalidExpiry  = errors.New("expires_at must be in the future")
+	ErrInvalidGrace   = errors.New("grace period must be between 0 and 168h")
+)
+
+// Scopes lists the scopes that can be granted to API keys.
+var Scopes = []string{auth.ScopeWalletRead, auth.ScopeTransferWrite, auth.ScopeAdmin}
+
+const (
+	// DefaultGracePeriod is how long a rotated key keeps working next to its replacement.
+	DefaultGracePeriod = 24 * time.Hour
+	MaxGracePeriod     = 7 * 24 * time.Hour
+
+	// lastUsedResolution limits how often a key's last use is written.
+	lastUsedResolution = time.Minute
+)
+
+// Service manages API keys.
+type Service struct {
+	client *ent.Client
+}
+
+func NewService(client *ent.Client) *Service {
+	return &Service{client: client}
+}
+
+// Create issues a key for a user and returns it together with the key itself,
+// which is only stored as a hash and cannot be shown again. ctx carries the
+// principal of the request, which must have stepped up to issue a key that
+// can transfer money.
+func (s *Service) Create(ctx context.Context, userID int, name string, scopes []string, expiresAt *time.Time) (*ent.APIKey, string, error) {
+	if name == "" {
+		return nil, "", ErrMissingName
+	}
+	if err := validateScopes(scopes); err != nil {
+		return nil, "", err
+	}
+	if err := requireStepUp(ctx, scopes); err != nil {
+		return nil, "", err
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", ErrInvalidExpiry
+	}
+
+	exists, err := s.client.User.Query().Where(user.ID(userID)).Exist(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if !exists {
+		return nil, "", services.ErrUserNotFound
+	}
+
+	return s.create(ctx, s.client, userID, name, scopes, expiresAt)
+}
+
+// List returns the keys of a user, newest first.
+func (s *Service) List(ctx context.Context, userID int) ([]*ent.APIKey, error) {
+	return s.client.APIKey.Query().
+		Where(apikey.UserID(userID)).
+		Order(ent.Desc(apikey.FieldID)).
+		All(ctx)
+}
+
+// Get returns a key of a user.
+func (s *Service) Get(ctx context.Context, userID, id int) (*ent.APIKey, error) {
+	k, err := s.client.APIKey.Query().
+		Where(apikey.ID(id), apikey.UserID(userID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrAPIKeyNotFound
+	}
+	return k, err
+}
+
+// Rotate replaces a key with a new one with the same name, scopes and expiry.
+// The old key keeps working for the grace period, so integrations can switch
+// over without downtime. Like Create, rotating a key that can transfer money
+// needs a step-up.
+func (s *Service) Rotate(ctx context.Context, userID, id int, grace time.Duration) (*ent.APIKey, string, error) {
+	if grace < 0 || grace > MaxGracePeriod {
+		return nil, "", ErrInvalidGrace
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("starting transaction: %w", err)
+	}
+
+	old, err := tx.APIKey.Query().
+		Where(apikey.ID(id), apikey.UserID(userID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		tx.Rollback()
+		return nil, "", ErrAPIKeyNotFound
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+	if !active(old, time.Now()) || old.ReplacedBy != nil {
+		tx.Rollback()
+		return nil, "", ErrAPIKeyInactive
+	}
+	if err := requireStepUp(ctx, old.Scopes); err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+
+	k, key, err := s.create(ctx, tx.Client(), userID, old.Name, old.Scopes, old.ExpiresAt)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+
+	graceEnd := time.Now().Add(grace)
+	if old.ExpiresAt != nil && old.ExpiresAt.Before(graceEnd) {
+		graceEnd = *old.ExpiresAt
+	}
+	// Only the first of two concurrent rotations replaces the key.
+	n, err := tx.APIKey.Update().
+		Where(apikey.ID(old.ID), apikey.ReplacedByIsNil(), apikey.RevokedAtIsNil()).
+		SetExpiresAt(graceEnd).
+		SetReplacedBy(k.ID).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+	if n == 0 {
+		tx.Rollback()
+		return nil, "", ErrAPIKeyInactive
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", fmt.Errorf("committing transaction: %w", err)
+	}
+	return k, key, nil
+}
+
+// Revoke disables a key at once. Revoking a revoked key does nothing.
+func (s *Service) Revoke(ctx context.Context, userID, id int) (*ent.APIKey, error) {
+	k, err := s.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if k.RevokedAt != nil {
+		return k, nil
+	}
+	return k.Update().SetRevokedAt(time.Now()).Save(ctx)
+}
+
+// Verify returns the identity of the active key with the given prefix and
+// hash, and records that it was used.
+func (s *Service) Verify(ctx context.Context, prefix, hash string) (*messages.APIKeyIdentity, error) {
+	k, err := s.client.APIKey.Query().Where(apikey.Prefix(prefix)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if subtle.ConstantTimeCompare([]byte(k.Hash), []byte(hash)) != 1 || !active(k, now) {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	u, err := s.client.User.Get(ctx, k.UserID)
+	if ent.IsNotFound(err) {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) >= lastUsedResolution {
+		if err := k.Update().SetLastUsedAt(now).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return &messages.APIKeyIdentity{
+		ID:        k.ID,
+		Prefix:    k.Prefix,
+		UserID:    k.UserID,
+		Email:     u.Email,
+		Scopes:    k.Scopes,
+		ExpiresAt: k.ExpiresAt,
+	}, nil
+}
+
+// ResolveAPIKey implements auth.APIKeyResolver.
+func (s *Service) ResolveAPIKey(ctx context.Context, key string) (*auth.Principal, error) {
+	prefix, ok := auth.ParseAPIKey(key)
+	if !ok {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	identity, err := s.Verify(ctx, prefix, auth.HashAPIKey(key))
+	if err != nil {
+		return nil, err
+	}
+	return auth.APIKeyPrincipal(*identity), nil
+}
+
+func (s *Service) create(ctx context.Context, client *ent.Client, userID int, name string, scopes []string, expiresAt *time.Time) (*ent.APIKey, string, error) {
+	id, err := randomHex(6)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, "", err
+	}
+	prefix := auth.APIKeyPrefix + id
+	key := prefix + "_" + secret
+
+	k, err := client.APIKey.Create().
+		SetUserID(userID).
+		SetName(name).
+		SetPrefix(prefix).
+		SetHash(auth.HashAPIKey(key)).
+		SetScopes(scopes).
+		SetNillableExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return k, key, nil
+}
+
+// active reports whether a key may be used at t.
+func active(k *ent.APIKey, t time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || t.Before(*k.ExpiresAt))
+}
+
+// requireStepUp checks that the caller stepped up when issuing a key with
+// scopes that include transfers, as the key moves money without a second
+// factor for as long as it lives.
+func requireStepUp(ctx context.Context, scopes []string) error {
+	if !slices.Contains(scopes, auth.ScopeTransferWrite) {
+		return nil
+	}
+	return auth.RequireStepUp(ctx)
+}
+
+func validateScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return ErrNoScopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(Scopes, scope) {
+			return fmt.Errorf("%w %q", ErrUnknownScope, scope)
+		}
+	}
+	return nil
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating API key: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,111 @@
+package apikeys
+
+import (
+	"context"
+	"errors"
+	"shared/auth"
+	"shared/problems"
+	"testing"
+	"time"
+	"user-service/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestOwnership(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	s := NewService(client)
+
+	client.User.Create().SetID(1).SetEmail("ada@example.com").ExecX(ctx)
+	client.User.Create().SetID(2).SetEmail("bob@example.com").ExecX(ctx)
+	owner := auth.WithPrincipal(ctx, &auth.Principal{UserID: 1, Scopes: auth.UserScopes})
+	k, _, err := s.Create(owner, 1, "ci", []string{auth.ScopeWalletRead}, nil)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	other := auth.WithPrincipal(ctx, &auth.Principal{UserID: 2, Scopes: auth.UserScopes})
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"Get", func() error { _, err := s.Get(other, 2, k.ID); return err }},
+		{"Rotate", func() error { _, _, err := s.Rotate(other, 2, k.ID, 0); return err }},
+		{"Revoke", func() error { _, err := s.Revoke(other, 2, k.ID); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, ErrAPIKeyNotFound) {
+				t.Errorf("%s of another user's key = %v, want %v", tt.name, err, ErrAPIKeyNotFound)
+			}
+		})
+	}
+
+	if k, err := s.Get(ctx, 1, k.ID); err != nil || k.RevokedAt != nil || k.ReplacedBy != nil {
+		t.Errorf("key of user 1 changed by user 2: %+v, %v", k, err)
+	}
+	if keys, err := s.List(ctx, 2); err != nil || len(keys) != 0 {
+		t.Errorf("List of user 2 = %d keys, %v, want none", len(keys), err)
+	}
+}
+
+func TestStepUp(t *testing.T) {
+	tests := []struct {
+		name      string
+		scopes    []string
+		steppedUp bool
+		wantErr   bool
+	}{
+		{name: "read key", scopes: []string{auth.ScopeWalletRead}},
+		{name: "transfer key", scopes: []string{auth.ScopeWalletRead, auth.ScopeTransferWrite}, wantErr: true},
+		{name: "transfer key after step-up", scopes: []string{auth.ScopeTransferWrite}, steppedUp: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+			defer client.Close()
+			s := NewService(client)
+
+			client.User.Create().SetID(1).SetEmail("ada@example.com").ExecX(context.Background())
+			p := &auth.Principal{UserID: 1, Scopes: auth.UserScopes}
+			if tt.steppedUp {
+				now := time.Now()
+				p.SteppedUpAt = &now
+			}
+			ctx := auth.WithPrincipal(context.Background(), p)
+
+			k, _, err := s.Create(ctx, 1, "ci", tt.scopes, nil)
+			assertStepUp(t, "Create", err, tt.wantErr)
+
+			// Rotating a key issues another key with its scopes, so it needs
+			// the same step-up as creating it.
+			if k == nil {
+				k = client.APIKey.Create().
+					SetUserID(1).
+					SetName("ci").
+					SetPrefix("wk_test").
+					SetHash("hash").
+					SetScopes(tt.scopes).
+					SaveX(ctx)
+			}
+			_, _, err = s.Rotate(ctx, 1, k.ID, DefaultGracePeriod)
+			assertStepUp(t, "Rotate", err, tt.wantErr)
+		})
+	}
+}
+
+func assertStepUp(t *testing.T, op string, err error, want bool) {
+	t.Helper()
+	if !want {
+		if err != nil {
+			t.Fatalf("%s: %v", op, err)
+		}
+		return
+	}
+	p, ok := problems.As(err)
+	if !ok || p.Code != problems.CodeStepUpRequired {
+		t.Fatalf("%s error = %v, want %s", op, err, problems.CodeStepUpRequired)
+	}
+}
@@ -0,0 +1,47 @@
+package apikeys
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"shared/auth"
+	"shared/messages"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Serve answers verify-api-key requests, so transactions-service can
+// authenticate API keys without access to them.
+func (s *Service) Serve(natsConn *nats.Conn, queueGroup string) error {
+	_, err := natsConn.QueueSubscribe(messages.SubjectVerifyAPIKey, queueGroup, func(m *nats.Msg) {
+		reply := s.handleVerify(context.Background(), m.Data)
+		data, err := json.Marshal(reply)
+		if err != nil {
+			log.Printf("error marshalling %s reply: %v", m.Subject, err)
+			return
+		}
+		if err := m.Respond(data); err != nil {
+			log.Printf("error responding to %s: %v", m.Subject, err)
+		}
+	})
+	return err
+}
+
+func (s *Service) handleVerify(ctx context.Context, data []byte) messages.APIKeyVerification {
+	var req messages.VerifyAPIKey
+	if err := json.Unmarshal(data, &req); err != nil {
+		return messages.APIKeyVerification{Reply: messages.Error(messages.ErrorCodeInvalidRequest, "error unmarshalling verify-api-key message: "+err.Error())}
+	}
+
+	identity, err := s.Verify(ctx, req.Prefix, req.Hash)
+	if errors.Is(err, auth.ErrInvalidAPIKey) {
+		return messages.APIKeyVerification{Reply: messages.Error(messages.ErrorCodeUnauthorized, err.Error())}
+	}
+	if err != nil {
+		log.Printf("error verifying API key %s: %v", req.Prefix, err)
+		return messages.APIKeyVerification{Reply: messages.Error(messages.ErrorCodeInternal, "error verifying API key")}
+	}
+
+	return messages.APIKeyVerification{Reply: messages.Success(""), Key: identity}
+}
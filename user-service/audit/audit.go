@@ -0,0 +1,83 @@
+// Package audit records the privileged actions of operators together with
+// the operator who performed them.
+package audit
+
+import (
+	"context"
+	shared "shared/audit"
+	"user-service/ent"
+	"user-service/ent/auditlog"
+)
+
+// Actions recorded by user-service.
+const (
+	ActionUserRolesSet       = "user.roles.set"
+	ActionUserSessionsRevoke = "user.sessions.revoke"
+	ActionUserSessionRevoke  = "user.session.revoke"
+	ActionUserDataExport     = "user.data.export"
+	ActionUserErase          = "user.erase"
+	ActionProjectionRebuild  = "projection.rebuild"
+)
+
+// Target types of the recorded actions.
+const (
+	TargetUser       = "user"
+	TargetProjection = "projection"
+)
+
+var ErrNoActor = shared.ErrNoActor
+
+// Filter selects audit log entries; empty fields match every entry.
+type Filter = shared.Filter
+
+// Record stores an action performed by the caller of ctx. Pass the client of
+// the transaction making the change, so the action and its record are
+// committed together.
+func Record(ctx context.Context, client *ent.Client, action, targetType, targetID string, details map[string]any) error {
+	p, err := shared.Actor(ctx)
+	if err != nil {
+		return err
+	}
+
+	return client.AuditLog.Create().
+		SetActor(p.Subject).
+		SetActorRoles(p.Roles).
+		SetAction(action).
+		SetTargetType(targetType).
+		SetTargetID(targetID).
+		SetDetails(details).
+		Exec(ctx)
+}
+
+// List returns the entries matching filter, newest first, and the number of
+// matching entries.
+func List(ctx context.Context, client *ent.Client, filter Filter, limit, offset int) ([]*ent.AuditLog, int, error) {
+	query := client.AuditLog.Query()
+	if filter.Actor != "" {
+		query = query.Where(auditlog.Actor(filter.Actor))
+	}
+	if filter.Action != "" {
+		query = query.Where(auditlog.Action(filter.Action))
+	}
+	if filter.TargetType != "" {
+		query = query.Where(auditlog.TargetType(filter.TargetType))
+	}
+	if filter.TargetID != "" {
+		query = query.Where(auditlog.TargetID(filter.TargetID))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entries, err := query.
+		Order(ent.Desc(auditlog.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
@@ -0,0 +1,109 @@
+// Package problems implements RFC 7807 problem details for user-service.
+// The problem types and the codes shared with transactions-service live in
+// shared/problems; this package re-exports them and registers the codes of
+// user-service.
+package problems
+
+import (
+	"net/http"
+	shared "shared/problems"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+)
+
+type (
+	// Code is a stable, machine-readable error code.
+	Code = shared.Code
+	// Problem is an RFC 7807 problem details object extended with a stable code.
+	Problem = shared.Problem // @name problems.Problem
+)
+
+// Codes shared with transactions-service.
+const (
+	CodeInvalidRequest     = shared.CodeInvalidRequest
+	CodeValidationFailed   = shared.CodeValidationFailed
+	CodeUnauthorized       = shared.CodeUnauthorized
+	CodeForbidden          = shared.CodeForbidden
+	CodeUserNotFound       = shared.CodeUserNotFound
+	CodeUserAlreadyExists  = shared.CodeUserAlreadyExists
+	CodeEmailTaken         = shared.CodeEmailTaken
+	CodeEmailNotVerified   = shared.CodeEmailNotVerified
+	CodeStepUpRequired     = shared.CodeStepUpRequired
+	CodeWalletNotEmpty     = shared.CodeWalletNotEmpty
+	CodeUserErased         = shared.CodeUserErased
+	CodeAliasNotFound      = shared.CodeAliasNotFound
+	CodeRateLimited        = shared.CodeRateLimited
+	CodeQuotaExceeded      = shared.CodeQuotaExceeded
+	CodeServiceTimeout     = shared.CodeServiceTimeout
+	CodeServiceUnavailable = shared.CodeServiceUnavailable
+	CodeServiceBusy        = shared.CodeServiceBusy
+	CodeInternal           = shared.CodeInternal
+)
+
+// Codes of user-service.
+const (
+	CodeEmailAlreadyVerified Code = "EMAIL_ALREADY_VERIFIED" // 409 Conflict
+	CodePhoneNotVerified     Code = "PHONE_NOT_VERIFIED"     // 403 Forbidden
+	CodePhoneAlreadyVerified Code = "PHONE_ALREADY_VERIFIED" // 409 Conflict
+	CodeInvalidVerification  Code = "INVALID_VERIFICATION"   // 400 Bad Request
+	CodeInvalidCredentials   Code = "INVALID_CREDENTIALS"    // 401 Unauthorized
+	CodeAccountLocked        Code = "ACCOUNT_LOCKED"         // 423 Locked
+	CodeWeakPassword         Code = "WEAK_PASSWORD"          // 422 Unprocessable Entity
+	CodeInvalidPasswordReset Code = "INVALID_PASSWORD_RESET" // 400 Bad Request
+	CodeMFARequired          Code = "MFA_REQUIRED"           // 401 Unauthorized
+	CodeInvalidOTP           Code = "INVALID_OTP"            // 422 Unprocessable Entity
+	CodeMFANotEnabled        Code = "MFA_NOT_ENABLED"        // 409 Conflict
+	CodeMFAAlreadyEnabled    Code = "MFA_ALREADY_ENABLED"    // 409 Conflict
+	CodeDataRequestNotFound  Code = "DATA_REQUEST_NOT_FOUND" // 404 Not Found
+	CodeExportNotReady       Code = "EXPORT_NOT_READY"       // 409 Conflict
+	CodeExportExpired        Code = "EXPORT_EXPIRED"         // 410 Gone
+	CodeAliasTaken           Code = "ALIAS_TAKEN"            // 409 Conflict
+	CodeAPIKeyNotFound       Code = "API_KEY_NOT_FOUND"      // 404 Not Found
+	CodeAPIKeyInactive       Code = "API_KEY_INACTIVE"       // 409 Conflict
+	CodeSessionNotFound      Code = "SESSION_NOT_FOUND"      // 404 Not Found
+	CodeInvalidReferralCode  Code = "INVALID_REFERRAL_CODE"  // 422 Unprocessable Entity
+)
+
+func init() {
+	shared.Register(CodeEmailAlreadyVerified, http.StatusConflict, codes.FailedPrecondition, "Email already verified")
+	shared.Register(CodePhoneNotVerified, http.StatusForbidden, codes.FailedPrecondition, "Phone not verified")
+	shared.Register(CodePhoneAlreadyVerified, http.StatusConflict, codes.FailedPrecondition, "Phone already verified")
+	shared.Register(CodeInvalidVerification, http.StatusBadRequest, codes.InvalidArgument, "Verification token is invalid or expired")
+	shared.Register(CodeInvalidCredentials, http.StatusUnauthorized, codes.Unauthenticated, "Invalid email or password")
+	shared.Register(CodeAccountLocked, http.StatusLocked, codes.FailedPrecondition, "Account temporarily locked")
+	shared.Register(CodeWeakPassword, http.StatusUnprocessableEntity, codes.InvalidArgument, "Password does not meet the password policy")
+	shared.Register(CodeInvalidPasswordReset, http.StatusBadRequest, codes.InvalidArgument, "Password reset token is invalid or expired")
+	shared.Register(CodeMFARequired, http.StatusUnauthorized, codes.Unauthenticated, "Second factor required")
+	shared.Register(CodeInvalidOTP, http.StatusUnprocessableEntity, codes.InvalidArgument, "Invalid one-time code")
+	shared.Register(CodeMFANotEnabled, http.StatusConflict, codes.FailedPrecondition, "Two-factor authentication is not enabled")
+	shared.Register(CodeMFAAlreadyEnabled, http.StatusConflict, codes.FailedPrecondition, "Two-factor authentication is already enabled")
+	shared.Register(CodeDataRequestNotFound, http.StatusNotFound, codes.NotFound, "Data request not found")
+	shared.Register(CodeExportNotReady, http.StatusConflict, codes.FailedPrecondition, "Data export is not ready")
+	shared.Register(CodeExportExpired, http.StatusGone, codes.FailedPrecondition, "Data export expired")
+	shared.Register(CodeAliasTaken, http.StatusConflict, codes.AlreadyExists, "Alias taken")
+	shared.Register(CodeAPIKeyNotFound, http.StatusNotFound, codes.NotFound, "API key not found")
+	shared.Register(CodeAPIKeyInactive, http.StatusConflict, codes.FailedPrecondition, "API key is revoked, expired or rotated")
+	shared.Register(CodeSessionNotFound, http.StatusNotFound, codes.NotFound, "Session not found")
+	shared.Register(CodeInvalidReferralCode, http.StatusUnprocessableEntity, codes.InvalidArgument, "Referral code is invalid")
+}
+
+// New builds the problem for code with the given detail.
+func New(code Code, detail string) *Problem {
+	return shared.New(code, detail)
+}
+
+// As returns the problem wrapped in err, if any.
+func As(err error) (*Problem, bool) {
+	return shared.As(err)
+}
+
+// Write aborts the request with the problem for code.
+func Write(c *gin.Context, code Code, detail string) {
+	shared.Write(c, code, detail)
+}
+
+// WriteProblem aborts the request with p, using the request path as its instance.
+func WriteProblem(c *gin.Context, p *Problem) {
+	shared.WriteProblem(c, p)
+}
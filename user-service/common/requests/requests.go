@@ -1,5 +1,110 @@
 package requests
 
+import (
+	"time"
+	"user-service/profiles"
+)
+
 type CreateUserRequest struct {
 	Email string `json:"email"`
+	// Password lets the user log in with grant_type=password; users without
+	// one set it through a password reset.
+	Password string `json:"password,omitempty"`
+	// ReferralCode is the code of the user who referred the new one.
+	ReferralCode string `json:"referral_code,omitempty" example:"K7QW2MXP"`
+}
+
+// UpdateUserRequest changes the fields it carries and keeps the others. An
+// empty profile field, or an empty address, removes it.
+type UpdateUserRequest struct {
+	Email     *string `json:"email,omitempty" example:"jane.doe@example.com"`
+	Name      *string `json:"name,omitempty" example:"Jane Doe"`
+	LegalName *string `json:"legal_name,omitempty" example:"Jane Mary Doe"`
+	// DateOfBirth is an ISO 8601 date.
+	DateOfBirth *string `json:"date_of_birth,omitempty" example:"1990-04-01"`
+	// Phone is an international number with its country calling code; it is
+	// stored in E.164 format.
+	Phone    *string           `json:"phone,omitempty" example:"+14155550123"`
+	Address  *profiles.Address `json:"address,omitempty"`
+	Locale   *string           `json:"locale,omitempty" example:"en-US"`
+	Timezone *string           `json:"timezone,omitempty" example:"America/Chicago"`
+	// CurrentPassword confirms a change of email; without it the request
+	// needs a step-up token.
+	CurrentPassword *string `json:"current_password,omitempty"`
+}
+
+// SetAliasRequest carries the value of a payment alias. Email and phone
+// aliases default to the user's email and profile phone.
+type SetAliasRequest struct {
+	Value string `json:"value,omitempty" example:"jane_doe"`
+}
+
+// VerifyEmailRequest carries the token of an email verification link.
+type VerifyEmailRequest struct {
+	Token string `json:"token" form:"token" binding:"required"`
+}
+
+// VerifyPhoneRequest carries the code texted to the phone of a profile.
+type VerifyPhoneRequest struct {
+	Code string `json:"code" binding:"required" example:"123456"`
+}
+
+// TokenRequest is an OAuth 2.0 token request, sent as JSON or as a form.
+type TokenRequest struct {
+	GrantType    string `json:"grant_type" form:"grant_type" binding:"required" example:"refresh_token"`
+	RefreshToken string `json:"refresh_token" form:"refresh_token"`
+	// Username is the email of the user for grant_type=password.
+	Username string `json:"username" form:"username" example:"jane@example.com"`
+	Password string `json:"password" form:"password"`
+	// OTP is a one-time or recovery code, required for grant_type=password
+	// once the user enabled two-factor authentication.
+	OTP          string `json:"otp" form:"otp" example:"123456"`
+	ClientID     string `json:"client_id" form:"client_id"`
+	ClientSecret string `json:"client_secret" form:"client_secret"`
+	// DeviceName names the session in the user's list of sessions for
+	// grant_type=password and refresh_token; it defaults to a description
+	// of the user agent.
+	DeviceName string `json:"device_name" form:"device_name" example:"Jane's phone"`
+}
+
+type ChangePasswordRequest struct {
+	// CurrentPassword is required once the user has a password.
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password" binding:"required"`
+}
+
+type PasswordResetRequest struct {
+	Email string `json:"email" binding:"required" example:"jane@example.com"`
+}
+
+type ConfirmPasswordResetRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// OTPRequest confirms an operation with a code from the authenticator app or,
+// except for enrollment, a recovery code.
+type OTPRequest struct {
+	Code string `json:"code" binding:"required" example:"123456"`
+}
+
+type RevokeTokenRequest struct {
+	Token string `json:"token" form:"token" binding:"required"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name" binding:"required" example:"payouts backend"`
+	Scopes []string `json:"scopes" binding:"required" example:"wallet:read,transfer:write"`
+	// ExpiresAt is when the key stops working; keys without it do not expire.
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type RotateAPIKeyRequest struct {
+	// GracePeriod is how long the old key keeps working, 24h when empty.
+	GracePeriod string `json:"grace_period" example:"24h"`
+}
+
+type SetRolesRequest struct {
+	// Roles replace the user's roles; an empty list removes them all.
+	Roles []string `json:"roles" binding:"required" example:"support,finance"`
 }
@@ -1,11 +1,283 @@
 package responses
 
+import (
+	"time"
+	"user-service/profiles"
+	"user-service/projections"
+
+	"github.com/google/uuid"
+)
+
+// StatusSuccess is the status of every successful response. Failures are
+// reported as problem details instead.
+const StatusSuccess = "success"
+
 type BaseResponse struct {
-	Status  string `json:"status"`
+	Status  string `json:"status" example:"success"`
 	Message string `json:"message"`
 }
 
+type UserResponse struct {
+	ID    int    `json:"id"`
+	Email string `json:"email" example:"jane@example.com"`
+	Name  string `json:"name,omitempty" example:"Jane Doe"`
+	// The profile fields are normalized: phone in E.164 format, locale as a
+	// canonical BCP 47 tag and the date of birth as an ISO 8601 date.
+	LegalName   string `json:"legal_name,omitempty" example:"Jane Mary Doe"`
+	DateOfBirth string `json:"date_of_birth,omitempty" example:"1990-04-01"`
+	Phone       string `json:"phone,omitempty" example:"+14155550123"`
+	// PhoneVerifiedAt is when the phone was verified with a texted code; only
+	// a verified phone can be used as an alias.
+	PhoneVerifiedAt *time.Time        `json:"phone_verified_at,omitempty"`
+	Address         *profiles.Address `json:"address,omitempty"`
+	Locale          string            `json:"locale,omitempty" example:"en-US"`
+	Timezone        string            `json:"timezone,omitempty" example:"America/Chicago"`
+	// VerifiedAt is when the email was verified; unverified users can receive
+	// money but not send it.
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	// ErasedAt is set once the user was erased and their data pseudonymized.
+	ErasedAt *time.Time `json:"erased_at,omitempty"`
+	// Tokens are returned when the user is created, so it can call the API at once.
+	Tokens *TokenResponse `json:"tokens,omitempty"`
+}
+
+type UserListResponse struct {
+	Status string         `json:"status" example:"success"`
+	Users  []UserResponse `json:"users"`
+	Total  int            `json:"total"`
+}
+
+type TokenResponse struct {
+	Status       string `json:"status" example:"success"`
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type" example:"Bearer"`
+	ExpiresIn    int    `json:"expires_in" example:"900"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope" example:"wallet:read transfer:write"`
+}
+
+type DataRequestResponse struct {
+	Status    string    `json:"status" example:"success"`
+	ID        uuid.UUID `json:"id"`
+	UserID    int       `json:"user_id"`
+	Kind      string    `json:"kind" example:"export"`
+	State     string    `json:"state" example:"completed"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	// CompletedAt is when the request completed or finally failed.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// ArchiveURL is where a completed export is downloaded until ExpiresAt.
+	ArchiveURL string     `json:"archive_url,omitempty" example:"/api/v2/users/1/data-requests/6f1c2a0e-2b1d-4c55-9a8e-0c7d7e0b8f1a/archive"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+}
+
+type TOTPEnrollmentResponse struct {
+	Status string `json:"status" example:"success"`
+	// Secret is the base32 TOTP secret, for authenticator apps that cannot scan
+	// the QR code of OTPAuthURI.
+	Secret     string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	OTPAuthURI string `json:"otpauth_uri" example:"otpauth://totp/Digital%20Wallet:jane@example.com?algorithm=SHA1&digits=6&issuer=Digital%20Wallet&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+}
+
+type RecoveryCodesResponse struct {
+	Status string `json:"status" example:"success"`
+	// RecoveryCodes are shown once; each replaces a one-time code a single time.
+	RecoveryCodes []string `json:"recovery_codes" example:"k7m2p-x9qrt,a3bcd-efg45"`
+}
+
+type StepUpResponse struct {
+	Status      string `json:"status" example:"success"`
+	StepUpToken string `json:"step_up_token"`
+	ExpiresIn   int    `json:"expires_in" example:"300"`
+}
+
 type GetBalanceResponse struct {
-	Status  string  `json:"status"`
-	Balance float64 `json:"balance"`
+	Status   string    `json:"status" example:"success"`
+	Balance  float64   `json:"balance"`
+	Currency string    `json:"currency" example:"USD"`
+	Source   string    `json:"source" example:"projection"`
+	Sequence int       `json:"sequence,omitempty"`
+	AsOf     time.Time `json:"as_of"`
+}
+
+type ProjectionStatusResponse struct {
+	Status     string             `json:"status" example:"success"`
+	Projection projections.Status `json:"projection"`
+}
+
+type RebuildProjectionResponse struct {
+	Status  string `json:"status" example:"success"`
+	Wallets int    `json:"wallets"`
+}
+
+type APIKeyResponse struct {
+	ID     int      `json:"id"`
+	Name   string   `json:"name" example:"payouts backend"`
+	Prefix string   `json:"prefix" example:"wk_3f9a1c2b7d4e"`
+	Scopes []string `json:"scopes" example:"wallet:read,transfer:write"`
+	// Key is only returned when the key is created or rotated.
+	Key        string     `json:"key,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	ReplacedBy *int       `json:"replaced_by,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type APIKeyListResponse struct {
+	Status  string           `json:"status" example:"success"`
+	APIKeys []APIKeyResponse `json:"api_keys"`
+}
+
+type SessionResponse struct {
+	ID         string    `json:"id" example:"5f0c3a8e-9d7b-4c1e-a2f4-6b8d0e1c3a57"`
+	Device     string    `json:"device" example:"Firefox on Windows"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip" example:"203.0.113.7"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	// Current is set on the session of the caller's access token.
+	Current bool `json:"current"`
+}
+
+type SessionListResponse struct {
+	Status   string            `json:"status" example:"success"`
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+type ReferralCodeResponse struct {
+	Status string `json:"status" example:"success"`
+	Code   string `json:"code" example:"K7QW2MXP"`
+	// RemainingBonuses is how many more referrals can earn bonuses; it is
+	// left out when there is no cap.
+	RemainingBonuses *int `json:"remaining_bonuses,omitempty" example:"18"`
+}
+
+type ReferralResponse struct {
+	ID int `json:"id"`
+	// Status is pending, qualified, paid, failed, capped or expired.
+	Status string `json:"status" example:"paid"`
+	// Bonus is the bonus of the referrer, set once the referral qualifies.
+	Bonus       float64    `json:"bonus" example:"10"`
+	CreatedAt   time.Time  `json:"created_at"`
+	QualifiedAt *time.Time `json:"qualified_at,omitempty"`
+	PaidAt      *time.Time `json:"paid_at,omitempty"`
+}
+
+type ReferralListResponse struct {
+	Status    string             `json:"status" example:"success"`
+	Referrals []ReferralResponse `json:"referrals"`
+}
+
+type AdminReferralResponse struct {
+	ID         int `json:"id"`
+	ReferrerID int `json:"referrer_id"`
+	ReferredID int `json:"referred_id"`
+	// Status is pending, qualified, paid, failed, capped or expired.
+	Status        string     `json:"status" example:"failed"`
+	ReferrerBonus float64    `json:"referrer_bonus" example:"10"`
+	ReferredBonus float64    `json:"referred_bonus" example:"10"`
+	CreatedAt     time.Time  `json:"created_at"`
+	QualifiedAt   *time.Time `json:"qualified_at,omitempty"`
+	PaidAt        *time.Time `json:"paid_at,omitempty"`
+	// PayoutAttempts counts the failed attempts to pay the bonuses, and
+	// PayoutError is why the last one failed.
+	PayoutAttempts int    `json:"payout_attempts"`
+	PayoutError    string `json:"payout_error,omitempty" example:"promotions wallet has insufficient funds"`
+	// NextPayoutAt is when a failed payout is next retried.
+	NextPayoutAt *time.Time `json:"next_payout_at,omitempty"`
+}
+
+type AdminReferralListResponse struct {
+	Status    string                  `json:"status" example:"success"`
+	Referrals []AdminReferralResponse `json:"referrals"`
+	Total     int                     `json:"total"`
+}
+
+type AdminUserResponse struct {
+	ID         int        `json:"id"`
+	Email      string     `json:"email" example:"jane@example.com"`
+	Roles      []string   `json:"roles" example:"support"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	ErasedAt   *time.Time `json:"erased_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	// Balance is the projected balance of the user's wallet, if it is projected.
+	Balance *ProjectedBalanceResponse `json:"balance,omitempty"`
+}
+
+type ProjectedBalanceResponse struct {
+	Amount   float64   `json:"amount"`
+	Currency string    `json:"currency" example:"USD"`
+	Sequence int       `json:"sequence"`
+	AsOf     time.Time `json:"as_of"`
+}
+
+type AdminUserListResponse struct {
+	Status string              `json:"status" example:"success"`
+	Users  []AdminUserResponse `json:"users"`
+	Total  int                 `json:"total"`
+}
+
+type RevokeSessionsResponse struct {
+	Status  string `json:"status" example:"success"`
+	Revoked int    `json:"revoked"`
+}
+
+type ProfileChangeResponse struct {
+	Field string `json:"field" example:"phone"`
+	// OldValue and NewValue are empty when the field was unset or removed.
+	// Addresses are JSON objects encoded as strings.
+	OldValue  string    `json:"old_value,omitempty" example:"+14155550123"`
+	NewValue  string    `json:"new_value,omitempty" example:"+14155550199"`
+	ChangedBy string    `json:"changed_by" example:"1"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+type ProfileHistoryResponse struct {
+	Status  string                  `json:"status" example:"success"`
+	Changes []ProfileChangeResponse `json:"changes"`
+	Total   int                     `json:"total"`
+}
+
+type AliasResponse struct {
+	Type string `json:"type" example:"handle"`
+	// Alias is the alias as payers enter it, e.g. @jane_doe for a handle.
+	Alias     string    `json:"alias" example:"@jane_doe"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type AliasListResponse struct {
+	Status  string          `json:"status" example:"success"`
+	Aliases []AliasResponse `json:"aliases"`
+}
+
+// RecipientResponse previews the recipient of a transfer to an alias.
+type RecipientResponse struct {
+	Status    string `json:"status" example:"success"`
+	AliasType string `json:"alias_type" example:"handle"`
+	Alias     string `json:"alias" example:"@jane_doe"`
+	// MaskedName shows the first letter of each word of the recipient's
+	// name; it is empty when they have not set one.
+	MaskedName string `json:"masked_name,omitempty" example:"J*** D**"`
+}
+
+type AuditLogEntryResponse struct {
+	ID         int            `json:"id"`
+	Actor      string         `json:"actor" example:"1"`
+	ActorRoles []string       `json:"actor_roles"`
+	Action     string         `json:"action" example:"user.roles.set"`
+	TargetType string         `json:"target_type" example:"user"`
+	TargetID   string         `json:"target_id" example:"42"`
+	Details    map[string]any `json:"details,omitempty"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+type AuditLogResponse struct {
+	Status  string                  `json:"status" example:"success"`
+	Entries []AuditLogEntryResponse `json:"entries"`
+	Total   int                     `json:"total"`
 }
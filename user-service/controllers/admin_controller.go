@@ -0,0 +1,449 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"shared/auth"
+	"strconv"
+	"user-service/audit"
+	"user-service/common/problems"
+	"user-service/common/requests"
+	"user-service/common/responses"
+	"user-service/ent"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultAdminPageSize = 50
+	maxAdminPageSize     = 500
+)
+
+// AdminController serves the back-office API used by operators. Access is
+// granted per route by the permissions of the caller's roles.
+type AdminController struct {
+	client *ent.Client
+	admin  *services.AdminService
+}
+
+func NewAdminController(client *ent.Client, admin *services.AdminService) *AdminController {
+	return &AdminController{client: client, admin: admin}
+}
+
+// SearchUsers
+// @Summary Search users
+// @Description Search users by email and role, with the projected balance of their wallet
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param email query string false "Part of the email, ignoring case"
+// @Param role query string false "Only users holding this role (support, finance, compliance)"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AdminUserListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/users [get]
+func (adminController *AdminController) SearchUsers(c *gin.Context) {
+	search := services.UserSearch{Email: c.Query("email"), Role: c.Query("role")}
+	if search.Role != "" && !auth.IsRole(search.Role) {
+		problems.Write(c, problems.CodeInvalidRequest, "unknown role "+strconv.Quote(search.Role))
+		return
+	}
+
+	limit, offset, err := pagination(c, defaultAdminPageSize, maxAdminPageSize)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	users, total, err := adminController.admin.SearchUsers(context.Background(), search, limit, offset)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.AdminUserResponse, 0, len(users))
+	for _, u := range users {
+		items = append(items, adminUserResponse(u))
+	}
+
+	c.JSON(http.StatusOK, responses.AdminUserListResponse{
+		Status: responses.StatusSuccess,
+		Users:  items,
+		Total:  total,
+	})
+}
+
+// GetUser
+// @Summary Inspect a user
+// @Description Get a user with its roles and the projected balance of its wallet
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AdminUserResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/users/{id} [get]
+func (adminController *AdminController) GetUser(c *gin.Context) {
+	id, ok := adminUserID(c)
+	if !ok {
+		return
+	}
+
+	u, err := adminController.admin.GetUser(context.Background(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, adminUserResponse(u))
+}
+
+// SetRoles
+// @Summary Set the roles of a user
+// @Description Replace the back-office roles of a user. They take effect when the user's access token is
+// @Description next issued or refreshed. Only admins may manage roles.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param request body requests.SetRolesRequest true "Roles"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AdminUserResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/users/{id}/roles [put]
+func (adminController *AdminController) SetRoles(c *gin.Context) {
+	id, ok := adminUserID(c)
+	if !ok {
+		return
+	}
+
+	var req requests.SetRolesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	// The request context carries the operator recorded in the audit log.
+	ctx := c.Request.Context()
+	if _, err := adminController.admin.SetRoles(ctx, id, req.Roles); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	u, err := adminController.admin.GetUser(ctx, id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, adminUserResponse(u))
+}
+
+// ListSessions
+// @Summary List the sessions of a user
+// @Description List the active sessions of a user with the device, user agent and IP they were last seen from
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.SessionListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/users/{id}/sessions [get]
+func (adminController *AdminController) ListSessions(c *gin.Context) {
+	id, ok := adminUserID(c)
+	if !ok {
+		return
+	}
+
+	sessions, err := adminController.admin.ListSessions(c.Request.Context(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sessionListResponse(c, sessions))
+}
+
+// RevokeSession
+// @Summary Revoke a session of a user
+// @Description Sign a user out of one session; its access tokens stop working at once
+// @Tags admin
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param sessionId path string true "Session ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 204
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/users/{id}/sessions/{sessionId} [delete]
+func (adminController *AdminController) RevokeSession(c *gin.Context) {
+	id, ok := adminUserID(c)
+	if !ok {
+		return
+	}
+
+	// The request context carries the operator recorded in the audit log.
+	if err := adminController.admin.RevokeSession(c.Request.Context(), id, c.Param("sessionId")); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeSessions
+// @Summary Revoke the sessions of a user
+// @Description Revoke every session of a user, signing it out everywhere at once
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.RevokeSessionsResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/users/{id}/sessions/revoke [post]
+func (adminController *AdminController) RevokeSessions(c *gin.Context) {
+	id, ok := adminUserID(c)
+	if !ok {
+		return
+	}
+
+	n, err := adminController.admin.RevokeSessions(c.Request.Context(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.RevokeSessionsResponse{
+		Status:  responses.StatusSuccess,
+		Revoked: n,
+	})
+}
+
+// ListAuditLog
+// @Summary List the audit log
+// @Description List the privileged actions performed on user-service, newest first
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param actor query string false "Filter by operator (token subject)"
+// @Param action query string false "Filter by action, e.g. user.roles.set"
+// @Param target_type query string false "Filter by target type (user, projection)"
+// @Param target_id query string false "Filter by target ID"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AuditLogResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/audit-log [get]
+func (adminController *AdminController) ListAuditLog(c *gin.Context) {
+	limit, offset, err := pagination(c, defaultAdminPageSize, maxAdminPageSize)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	filter := audit.Filter{
+		Actor:      c.Query("actor"),
+		Action:     c.Query("action"),
+		TargetType: c.Query("target_type"),
+		TargetID:   c.Query("target_id"),
+	}
+	logs, total, err := audit.List(context.Background(), adminController.client, filter, limit, offset)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	entries := make([]responses.AuditLogEntryResponse, 0, len(logs))
+	for _, l := range logs {
+		entries = append(entries, responses.AuditLogEntryResponse{
+			ID:         l.ID,
+			Actor:      l.Actor,
+			ActorRoles: l.ActorRoles,
+			Action:     l.Action,
+			TargetType: l.TargetType,
+			TargetID:   l.TargetID,
+			Details:    l.Details,
+			CreatedAt:  l.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, responses.AuditLogResponse{
+		Status:  responses.StatusSuccess,
+		Entries: entries,
+		Total:   total,
+	})
+}
+
+// ListReferrals
+// @Summary List referrals
+// @Description List the referrals, the latest first, with the state of their payout. Referrals whose bonuses
+// @Description could not be paid after every retry have the status failed.
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Param status query string false "Only referrals with this status (pending, qualified, paid, failed, capped, expired)"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AdminReferralListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/admin/referrals [get]
+func (adminController *AdminController) ListReferrals(c *gin.Context) {
+	limit, offset, err := pagination(c, defaultAdminPageSize, maxAdminPageSize)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	referrals, total, err := adminController.admin.ListReferrals(c.Request.Context(), c.Query("status"), limit, offset)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.AdminReferralResponse, 0, len(referrals))
+	for _, r := range referrals {
+		items = append(items, responses.AdminReferralResponse{
+			ID:             r.ID,
+			ReferrerID:     r.ReferrerID,
+			ReferredID:     r.ReferredID,
+			Status:         string(r.Status),
+			ReferrerBonus:  r.ReferrerBonus,
+			ReferredBonus:  r.ReferredBonus,
+			CreatedAt:      r.CreatedAt,
+			QualifiedAt:    r.QualifiedAt,
+			PaidAt:         r.PaidAt,
+			PayoutAttempts: r.PayoutAttempts,
+			PayoutError:    r.PayoutError,
+			NextPayoutAt:   r.NextPayoutAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, responses.AdminReferralListResponse{
+		Status:    responses.StatusSuccess,
+		Referrals: items,
+		Total:     total,
+	})
+}
+
+// recordAudit records a privileged action that has already been performed.
+// A failure to record it is logged rather than reported, as the action
+// cannot be undone.
+func recordAudit(c *gin.Context, client *ent.Client, action, targetType, targetID string, details map[string]any) {
+	if err := audit.Record(c.Request.Context(), client, action, targetType, targetID, details); err != nil {
+		log.Printf("error recording %s of %s %s: %v", action, targetType, targetID, err)
+	}
+}
+
+// adminUserID reads the user ID of an admin route. Unlike userID it does not
+// require the caller to be that user; the route's permission grants access.
+func adminUserID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, "invalid user id")
+		return 0, false
+	}
+	return id, true
+}
+
+// pagination reads the limit and offset query parameters.
+func pagination(c *gin.Context, defaultLimit int, maxLimit int) (int, int, error) {
+	limit := defaultLimit
+	if v := c.Query("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l <= 0 {
+			return 0, 0, errors.New("limit must be a positive integer")
+		}
+		limit = min(l, maxLimit)
+	}
+
+	offset := 0
+	if v := c.Query("offset"); v != "" {
+		o, err := strconv.Atoi(v)
+		if err != nil || o < 0 {
+			return 0, 0, errors.New("offset must be a non-negative integer")
+		}
+		offset = o
+	}
+
+	return limit, offset, nil
+}
+
+func adminUserResponse(u services.AdminUser) responses.AdminUserResponse {
+	resp := responses.AdminUserResponse{
+		ID:         u.ID,
+		Email:      u.Email,
+		Roles:      u.Roles,
+		VerifiedAt: u.VerifiedAt,
+		ErasedAt:   u.ErasedAt,
+		CreatedAt:  u.CreatedAt,
+	}
+	if resp.Roles == nil {
+		resp.Roles = []string{}
+	}
+	if u.Balance != nil {
+		resp.Balance = &responses.ProjectedBalanceResponse{
+			Amount:   u.Balance.Balance,
+			Currency: u.Balance.Currency,
+			Sequence: u.Balance.Sequence,
+			AsOf:     u.Balance.AsOf,
+		}
+	}
+	return resp
+}
@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"user-service/common/problems"
+	"user-service/common/requests"
+	"user-service/common/responses"
+	"user-service/ent"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AliasController struct {
+	users *services.UsersService
+}
+
+func NewAliasController(users *services.UsersService) *AliasController {
+	return &AliasController{users: users}
+}
+
+// ListAliases
+// @Summary List the payment aliases of a user
+// @Description List the handle, email and phone number payers can send money to the user with.
+// @Tags aliases
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AliasListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/aliases [get]
+func (aliasController *AliasController) ListAliases(c *gin.Context) {
+	id, ok := userID(c)
+	if !ok {
+		return
+	}
+
+	aliases, err := aliasController.users.ListAliases(context.Background(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.AliasResponse, 0, len(aliases))
+	for _, a := range aliases {
+		items = append(items, aliasResponse(a))
+	}
+	c.JSON(http.StatusOK, responses.AliasListResponse{
+		Status:  responses.StatusSuccess,
+		Aliases: items,
+	})
+}
+
+// SetAlias
+// @Summary Set a payment alias
+// @Description Set the handle, email or phone alias of a user, replacing the previous one of that type.
+// @Description Handles are 3 to 30 lower-case letters, digits or underscores. The email alias must be the
+// @Description user's verified email and the phone alias the phone number of their profile; both default
+// @Description to them. An alias belongs to a single user.
+// @Tags aliases
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param type path string true "Alias type" Enums(handle, email, phone)
+// @Param request body requests.SetAliasRequest false "Alias"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.AliasResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 410 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/aliases/{type} [put]
+func (aliasController *AliasController) SetAlias(c *gin.Context) {
+	id, ok := userID(c)
+	if !ok {
+		return
+	}
+
+	var request requests.SetAliasRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			problems.Write(c, problems.CodeInvalidRequest, err.Error())
+			return
+		}
+	}
+
+	a, err := aliasController.users.SetAlias(context.Background(), id, c.Param("type"), request.Value)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, aliasResponse(a))
+}
+
+// RemoveAlias
+// @Summary Remove a payment alias
+// @Tags aliases
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param type path string true "Alias type" Enums(handle, email, phone)
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.BaseResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/aliases/{type} [delete]
+func (aliasController *AliasController) RemoveAlias(c *gin.Context) {
+	id, ok := userID(c)
+	if !ok {
+		return
+	}
+
+	if err := aliasController.users.RemoveAlias(context.Background(), id, c.Param("type")); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.BaseResponse{
+		Status:  responses.StatusSuccess,
+		Message: "Alias removed",
+	})
+}
+
+// PreviewRecipient
+// @Summary Preview the recipient of an alias
+// @Description Show whom a transfer to a handle, email or phone number would reach, with their name masked,
+// @Description so the payer can check it before confirming. A leading @ marks a handle and a leading + a
+// @Description phone number.
+// @Tags aliases
+// @Produce json
+// @Produce application/problem+json
+// @Param alias query string true "Handle, email or phone number"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.RecipientResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/recipients [get]
+func (aliasController *AliasController) PreviewRecipient(c *gin.Context) {
+	alias := c.Query("alias")
+	if alias == "" {
+		problems.Write(c, problems.CodeInvalidRequest, "alias is required")
+		return
+	}
+
+	recipient, err := aliasController.users.ResolveAlias(context.Background(), alias)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.RecipientResponse{
+		Status:     responses.StatusSuccess,
+		AliasType:  recipient.AliasType,
+		Alias:      recipient.Alias,
+		MaskedName: recipient.MaskedName,
+	})
+}
+
+func aliasResponse(a *ent.Alias) responses.AliasResponse {
+	return responses.AliasResponse{
+		Type:      string(a.Type),
+		Alias:     services.DisplayAlias(a),
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
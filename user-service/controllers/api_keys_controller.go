@@ -0,0 +1,284 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"shared/auth"
+	"strconv"
+	"time"
+	"user-service/apikeys"
+	"user-service/common/problems"
+	"user-service/common/requests"
+	"user-service/common/responses"
+	"user-service/ent"
+
+	"github.com/gin-gonic/gin"
+)
+
+type APIKeysController struct {
+	apiKeys *apikeys.Service
+}
+
+func NewAPIKeysController(apiKeys *apikeys.Service) *APIKeysController {
+	return &APIKeysController{apiKeys: apiKeys}
+}
+
+// CreateAPIKey
+// @Summary Create an API key
+// @Description Create an API key for a server-to-server integration acting for the user. Callers can only
+// @Description grant scopes they hold themselves, so the admin scope is granted by admins only. Keys with the
+// @Description transfer:write scope need a step-up token in the X-Step-Up-Token header. The key is
+// @Description returned once and is sent in the X-API-Key header.
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param request body requests.CreateAPIKeyRequest true "Key"
+// @Security BearerAuth
+// @Success 201 {object} responses.APIKeyResponse
+// @Header 201 {string} Location "URL of the created key"
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/api-keys [post]
+func (apiKeysController *APIKeysController) CreateAPIKey(c *gin.Context) {
+	id, ok := apiKeyOwner(c)
+	if !ok {
+		return
+	}
+
+	var request requests.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+	for _, scope := range request.Scopes {
+		if err := auth.RequireScope(c.Request.Context(), scope); err != nil {
+			writeProblem(c, err)
+			return
+		}
+	}
+
+	k, key, err := apiKeysController.apiKeys.Create(c.Request.Context(), id, request.Name, request.Scopes, request.ExpiresAt)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	resp := apiKeyResponse(k)
+	resp.Key = key
+	c.Header("Location", apiKeyLocation(k))
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListAPIKeys
+// @Summary List API keys
+// @Description List the API keys of a user, including revoked and expired ones, newest first
+// @Tags api-keys
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Success 200 {object} responses.APIKeyListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/api-keys [get]
+func (apiKeysController *APIKeysController) ListAPIKeys(c *gin.Context) {
+	id, ok := apiKeyOwner(c)
+	if !ok {
+		return
+	}
+
+	keys, err := apiKeysController.apiKeys.List(context.Background(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.APIKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, apiKeyResponse(k))
+	}
+
+	c.JSON(http.StatusOK, responses.APIKeyListResponse{
+		Status:  responses.StatusSuccess,
+		APIKeys: items,
+	})
+}
+
+// GetAPIKey
+// @Summary Get an API key
+// @Description Get an API key with the time it was last used
+// @Tags api-keys
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param keyId path int true "API key ID"
+// @Security BearerAuth
+// @Success 200 {object} responses.APIKeyResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/api-keys/{keyId} [get]
+func (apiKeysController *APIKeysController) GetAPIKey(c *gin.Context) {
+	id, keyID, ok := apiKeyIDs(c)
+	if !ok {
+		return
+	}
+
+	k, err := apiKeysController.apiKeys.Get(context.Background(), id, keyID)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, apiKeyResponse(k))
+}
+
+// RotateAPIKey
+// @Summary Rotate an API key
+// @Description Replace an API key with a new one with the same name, scopes and expiry. The old key keeps
+// @Description working for the grace period (24h by default, at most 168h) so the integration can switch over.
+// @Description Keys with the transfer:write scope need a step-up token in the X-Step-Up-Token header.
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param keyId path int true "API key ID"
+// @Param request body requests.RotateAPIKeyRequest false "Grace period"
+// @Security BearerAuth
+// @Success 201 {object} responses.APIKeyResponse
+// @Header 201 {string} Location "URL of the new key"
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/api-keys/{keyId}/rotate [post]
+func (apiKeysController *APIKeysController) RotateAPIKey(c *gin.Context) {
+	id, keyID, ok := apiKeyIDs(c)
+	if !ok {
+		return
+	}
+
+	var request requests.RotateAPIKeyRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			problems.Write(c, problems.CodeInvalidRequest, err.Error())
+			return
+		}
+	}
+	grace := apikeys.DefaultGracePeriod
+	if request.GracePeriod != "" {
+		d, err := time.ParseDuration(request.GracePeriod)
+		if err != nil {
+			problems.Write(c, problems.CodeInvalidRequest, "grace_period must be a duration such as 24h")
+			return
+		}
+		grace = d
+	}
+
+	k, key, err := apiKeysController.apiKeys.Rotate(c.Request.Context(), id, keyID, grace)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	resp := apiKeyResponse(k)
+	resp.Key = key
+	c.Header("Location", apiKeyLocation(k))
+	c.JSON(http.StatusCreated, resp)
+}
+
+// RevokeAPIKey
+// @Summary Revoke an API key
+// @Description Stop accepting an API key. transactions-service may accept it for up to 30 more seconds.
+// @Tags api-keys
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param keyId path int true "API key ID"
+// @Security BearerAuth
+// @Success 200 {object} responses.APIKeyResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/api-keys/{keyId} [delete]
+func (apiKeysController *APIKeysController) RevokeAPIKey(c *gin.Context) {
+	id, keyID, ok := apiKeyIDs(c)
+	if !ok {
+		return
+	}
+
+	k, err := apiKeysController.apiKeys.Revoke(context.Background(), id, keyID)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, apiKeyResponse(k))
+}
+
+// apiKeyOwner reads the user whose keys are managed. Keys are managed with a
+// user's own access token: a leaked key must not be able to mint new ones, so
+// only admin keys may manage keys.
+func apiKeyOwner(c *gin.Context) (int, bool) {
+	id, ok := userID(c)
+	if !ok {
+		return 0, false
+	}
+	if p, _ := auth.FromContext(c.Request.Context()); p.APIKeyID != 0 && !p.IsAdmin() {
+		problems.Write(c, problems.CodeForbidden, "API keys cannot manage API keys")
+		return 0, false
+	}
+	return id, true
+}
+
+func apiKeyIDs(c *gin.Context) (int, int, bool) {
+	id, ok := apiKeyOwner(c)
+	if !ok {
+		return 0, 0, false
+	}
+	keyID, err := strconv.Atoi(c.Param("keyId"))
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, "invalid API key id")
+		return 0, 0, false
+	}
+	return id, keyID, true
+}
+
+func apiKeyLocation(k *ent.APIKey) string {
+	return "/api/v2/users/" + strconv.Itoa(k.UserID) + "/api-keys/" + strconv.Itoa(k.ID)
+}
+
+func apiKeyResponse(k *ent.APIKey) responses.APIKeyResponse {
+	return responses.APIKeyResponse{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		ReplacedBy: k.ReplacedBy,
+		CreatedAt:  k.CreatedAt,
+	}
+}
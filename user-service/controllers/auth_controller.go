@@ -0,0 +1,256 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"user-service/common/problems"
+	"user-service/common/requests"
+	"user-service/common/responses"
+	"user-service/credentials"
+	"user-service/tokens"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Grant types accepted by the token endpoint.
+const (
+	grantPassword          = "password"
+	grantRefreshToken      = "refresh_token"
+	grantClientCredentials = "client_credentials"
+)
+
+type AuthController struct {
+	issuer      *tokens.Issuer
+	keys        *tokens.KeyStore
+	credentials *credentials.Service
+}
+
+func NewAuthController(issuer *tokens.Issuer, keys *tokens.KeyStore, credentials *credentials.Service) *AuthController {
+	return &AuthController{issuer: issuer, keys: keys, credentials: credentials}
+}
+
+// Token
+// @Summary Issue tokens
+// @Description Log in with an email and password and start a session (grant_type=password), exchange a
+// @Description refresh token for a new access and refresh token (grant_type=refresh_token), or authenticate
+// @Description a service client for an admin access token (grant_type=client_credentials). Every refresh
+// @Description token can be used once; reusing one revokes every token of its session. Users with two-factor
+// @Description authentication also send otp, a one-time or recovery code; without it the answer is
+// @Description MFA_REQUIRED. Failed logins lock the account for a time that grows with every further failure.
+// @Description Logins and refreshes record the device, user agent and IP of the session.
+// @Tags auth
+// @Accept json
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Produce application/problem+json
+// @Param request body requests.TokenRequest true "Grant"
+// @Success 200 {object} responses.TokenResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 423 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/auth/token [post]
+func (authController *AuthController) Token(c *gin.Context) {
+	var request requests.TokenRequest
+	if err := c.ShouldBind(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	var pair *tokens.Pair
+	var err error
+	switch request.GrantType {
+	case grantPassword:
+		if request.Username == "" || request.Password == "" {
+			problems.Write(c, problems.CodeInvalidRequest, "username and password are required")
+			return
+		}
+		pair, err = authController.login(context.Background(), request.Username, request.Password, request.OTP, device(c, request.DeviceName))
+	case grantRefreshToken:
+		if request.RefreshToken == "" {
+			problems.Write(c, problems.CodeInvalidRequest, "refresh_token is required")
+			return
+		}
+		pair, err = authController.issuer.Refresh(context.Background(), request.RefreshToken, device(c, request.DeviceName))
+	case grantClientCredentials:
+		pair, err = authController.issuer.IssueForClient(context.Background(), request.ClientID, request.ClientSecret)
+	default:
+		problems.Write(c, problems.CodeInvalidRequest, "unsupported grant_type "+request.GrantType)
+		return
+	}
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, tokenResponse(pair))
+}
+
+// ChangePassword
+// @Summary Change the password of a user
+// @Description Set a new password. The current password is required once the user has one, and wrong
+// @Description ones count as failed logins. Every session of the user is revoked, so the user logs in again.
+// @Tags auth
+// @Accept json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param request body requests.ChangePasswordRequest true "Passwords"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 204
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 423 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/password [put]
+func (authController *AuthController) ChangePassword(c *gin.Context) {
+	id, ok := userID(c)
+	if !ok {
+		return
+	}
+
+	var request requests.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	if err := authController.credentials.ChangePassword(context.Background(), id, request.CurrentPassword, request.NewPassword); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RequestPasswordReset
+// @Summary Request a password reset
+// @Description Mail a link to reset the password to the user with the given email. The answer is the
+// @Description same whether or not the email belongs to a user. Only the latest link of a user works.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param request body requests.PasswordResetRequest true "Email"
+// @Success 202 {object} responses.BaseResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/auth/password-reset [post]
+func (authController *AuthController) RequestPasswordReset(c *gin.Context) {
+	var request requests.PasswordResetRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	if err := authController.credentials.RequestReset(context.Background(), request.Email); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, responses.BaseResponse{
+		Status:  responses.StatusSuccess,
+		Message: "If the email belongs to a user, a password reset link was sent to it",
+	})
+}
+
+// ConfirmPasswordReset
+// @Summary Reset a password
+// @Description Set a new password with the token of a password reset link. The token can be used once;
+// @Description the lockout of the account is lifted and every session of the user is revoked.
+// @Tags auth
+// @Accept json
+// @Produce application/problem+json
+// @Param request body requests.ConfirmPasswordResetRequest true "Token and new password"
+// @Success 204
+// @Failure 400 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/auth/password-reset/confirm [post]
+func (authController *AuthController) ConfirmPasswordReset(c *gin.Context) {
+	var request requests.ConfirmPasswordResetRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	if err := authController.credentials.ResetPassword(context.Background(), request.Token, request.NewPassword); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Revoke
+// @Summary Revoke a refresh token
+// @Description Revoke a refresh token together with every token issued from the same session. The access
+// @Description tokens of the session stop working at once, in every service.
+// @Description Unknown or invalid tokens are accepted, as there is nothing left to revoke.
+// @Tags auth
+// @Accept json
+// @Accept x-www-form-urlencoded
+// @Produce application/problem+json
+// @Param request body requests.RevokeTokenRequest true "Refresh token"
+// @Success 204
+// @Failure 400 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/auth/revoke [post]
+func (authController *AuthController) Revoke(c *gin.Context) {
+	var request requests.RevokeTokenRequest
+	if err := c.ShouldBind(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	if err := authController.issuer.Revoke(context.Background(), request.Token); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// JWKS publishes the public keys tokens are signed with at auth.JWKSPath, for
+// transactions-service and other verifiers.
+func (authController *AuthController) JWKS(c *gin.Context) {
+	set, err := authController.keys.JWKS(context.Background())
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, set)
+}
+
+// login checks the password and second factor of a user and starts a
+// session on device.
+func (authController *AuthController) login(ctx context.Context, email, password, otp string, device tokens.Device) (*tokens.Pair, error) {
+	u, err := authController.credentials.Login(ctx, email, password, otp)
+	if err != nil {
+		return nil, err
+	}
+	return authController.issuer.IssueForUser(ctx, u, device)
+}
+
+func tokenResponse(pair *tokens.Pair) responses.TokenResponse {
+	return responses.TokenResponse{
+		Status:       responses.StatusSuccess,
+		AccessToken:  pair.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(pair.ExpiresIn.Seconds()),
+		RefreshToken: pair.RefreshToken,
+		Scope:        pair.Scope,
+	}
+}
@@ -0,0 +1,232 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"shared/auth"
+	"user-service/common/problems"
+	"user-service/common/requests"
+	"user-service/common/responses"
+	"user-service/credentials"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MFAController struct {
+	credentials *credentials.Service
+}
+
+func NewMFAController(credentials *credentials.Service) *MFAController {
+	return &MFAController{credentials: credentials}
+}
+
+// EnrollTOTP
+// @Summary Enroll an authenticator app
+// @Description Generate a TOTP secret for the user, replacing one that was not confirmed yet. Show the
+// @Description otpauth URI as a QR code, then confirm the secret with a code from the app; until then logins
+// @Description do not ask for codes.
+// @Tags mfa
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Success 201 {object} responses.TOTPEnrollmentResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/mfa/totp [post]
+func (mfaController *MFAController) EnrollTOTP(c *gin.Context) {
+	id, ok := interactiveUserID(c)
+	if !ok {
+		return
+	}
+
+	enrollment, err := mfaController.credentials.EnrollTOTP(context.Background(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, responses.TOTPEnrollmentResponse{
+		Status:     responses.StatusSuccess,
+		Secret:     enrollment.Secret,
+		OTPAuthURI: enrollment.URI,
+	})
+}
+
+// ConfirmTOTP
+// @Summary Enable two-factor authentication
+// @Description Confirm the enrolled TOTP secret with a code from the authenticator app. From then on, logins
+// @Description need a code. The answer holds the recovery codes of the user, which are shown this once.
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param request body requests.OTPRequest true "Code from the authenticator app"
+// @Security BearerAuth
+// @Success 200 {object} responses.RecoveryCodesResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/mfa/totp/confirm [post]
+func (mfaController *MFAController) ConfirmTOTP(c *gin.Context) {
+	id, request, ok := mfaRequest(c)
+	if !ok {
+		return
+	}
+
+	codes, err := mfaController.credentials.ConfirmTOTP(context.Background(), id, request.Code)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, responses.RecoveryCodesResponse{Status: responses.StatusSuccess, RecoveryCodes: codes})
+}
+
+// DisableTOTP
+// @Summary Disable two-factor authentication
+// @Description Turn two-factor authentication off, confirmed with a one-time or recovery code. The recovery
+// @Description codes are deleted. Wrong codes count as failed logins.
+// @Tags mfa
+// @Accept json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param request body requests.OTPRequest true "One-time or recovery code"
+// @Security BearerAuth
+// @Success 204
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 423 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/mfa/totp [delete]
+func (mfaController *MFAController) DisableTOTP(c *gin.Context) {
+	id, request, ok := mfaRequest(c)
+	if !ok {
+		return
+	}
+
+	if err := mfaController.credentials.DisableTOTP(context.Background(), id, request.Code); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RegenerateRecoveryCodes
+// @Summary Replace the recovery codes
+// @Description Replace the recovery codes of the user, confirmed with a one-time or recovery code. The old
+// @Description codes stop working and the new ones are shown this once.
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param request body requests.OTPRequest true "One-time or recovery code"
+// @Security BearerAuth
+// @Success 200 {object} responses.RecoveryCodesResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 423 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/mfa/recovery-codes [post]
+func (mfaController *MFAController) RegenerateRecoveryCodes(c *gin.Context) {
+	id, request, ok := mfaRequest(c)
+	if !ok {
+		return
+	}
+
+	codes, err := mfaController.credentials.RegenerateRecoveryCodes(context.Background(), id, request.Code)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, responses.RecoveryCodesResponse{Status: responses.StatusSuccess, RecoveryCodes: codes})
+}
+
+// StepUp
+// @Summary Step up for a sensitive operation
+// @Description Confirm a one-time or recovery code and get a short-lived step-up token. Sensitive requests,
+// @Description such as transfers above the step-up threshold of transactions-service, send it in the
+// @Description X-Step-Up-Token header next to the access token. Only users with two-factor authentication
+// @Description can step up.
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param request body requests.OTPRequest true "One-time or recovery code"
+// @Security BearerAuth
+// @Success 200 {object} responses.StepUpResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 423 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/auth/step-up [post]
+func (mfaController *MFAController) StepUp(c *gin.Context) {
+	p, ok := auth.FromContext(c.Request.Context())
+	if !ok || p.UserID == 0 || p.APIKeyID != 0 {
+		problems.Write(c, problems.CodeForbidden, "only users logged in with an access token can step up")
+		return
+	}
+
+	var request requests.OTPRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	token, err := mfaController.credentials.StepUp(context.Background(), p.UserID, request.Code)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, responses.StepUpResponse{
+		Status:      responses.StatusSuccess,
+		StepUpToken: token,
+		ExpiresIn:   int(mfaController.credentials.StepUpTTL().Seconds()),
+	})
+}
+
+func mfaRequest(c *gin.Context) (int, requests.OTPRequest, bool) {
+	var request requests.OTPRequest
+	id, ok := interactiveUserID(c)
+	if !ok {
+		return 0, request, false
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return 0, request, false
+	}
+	return id, request, true
+}
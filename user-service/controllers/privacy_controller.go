@@ -0,0 +1,205 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"user-service/common/problems"
+	"user-service/common/responses"
+	"user-service/ent"
+	"user-service/ent/datarequest"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PrivacyController struct {
+	users *services.UsersService
+}
+
+func NewPrivacyController(users *services.UsersService) *PrivacyController {
+	return &PrivacyController{users: users}
+}
+
+// RequestExport
+// @Summary Export the data of a user
+// @Description Start collecting the data of the user from both services into a zip archive holding JSON
+// @Description files and CSV files of the tables. Poll the returned data request until it completes, then
+// @Description download the archive from its archive_url. A pending export is returned instead of starting
+// @Description another one. Operators acting for a user are recorded in the audit log.
+// @Tags privacy
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Success 202 {object} responses.DataRequestResponse
+// @Header 202 {string} Location "URL of the data request"
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 410 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/data-exports [post]
+func (privacyController *PrivacyController) RequestExport(c *gin.Context) {
+	id, ok := interactiveUserID(c)
+	if !ok {
+		return
+	}
+
+	r, err := privacyController.users.RequestExport(c.Request.Context(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Location", dataRequestPath(r))
+	c.JSON(http.StatusAccepted, dataRequestResponse(r))
+}
+
+// RequestErasure
+// @Summary Erase a user
+// @Description Start erasing the user: the email is replaced by a pseudonym and the name, password, second
+// @Description factor, sessions, API keys and export archives are removed, in user-service and on the
+// @Description wallet in transactions-service. The transactions of the wallet are kept, as the ledger must be
+// @Description retained, and the wallet is frozen. Wallets that still hold money cannot be erased; the data
+// @Description request then fails with WALLET_NOT_EMPTY. Erasure cannot be undone.
+// @Tags privacy
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Success 202 {object} responses.DataRequestResponse
+// @Header 202 {string} Location "URL of the data request"
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 410 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/erasure [post]
+func (privacyController *PrivacyController) RequestErasure(c *gin.Context) {
+	id, ok := interactiveUserID(c)
+	if !ok {
+		return
+	}
+
+	r, err := privacyController.users.RequestErasure(c.Request.Context(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Location", dataRequestPath(r))
+	c.JSON(http.StatusAccepted, dataRequestResponse(r))
+}
+
+// GetDataRequest
+// @Summary Get a data request
+// @Description Get the state of an export or erasure of the user: pending, running, completed or failed.
+// @Description Requests are retried while transactions-service cannot be reached.
+// @Tags privacy
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param requestId path string true "Data request ID"
+// @Security BearerAuth
+// @Success 200 {object} responses.DataRequestResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/data-requests/{requestId} [get]
+func (privacyController *PrivacyController) GetDataRequest(c *gin.Context) {
+	id, requestID, ok := dataRequestID(c)
+	if !ok {
+		return
+	}
+
+	r, err := privacyController.users.GetDataRequest(context.Background(), id, requestID)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dataRequestResponse(r))
+}
+
+// DownloadExport
+// @Summary Download a data export
+// @Description Download the zip archive of a completed export: profile.json and wallet.json with every
+// @Description field, and transactions.csv, api_keys.csv and sessions.csv. Archives are deleted when they
+// @Description expire and when the user is erased.
+// @Tags privacy
+// @Produce application/zip
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param requestId path string true "Data request ID"
+// @Security BearerAuth
+// @Success 200 {file} file
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 410 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/data-requests/{requestId}/archive [get]
+func (privacyController *PrivacyController) DownloadExport(c *gin.Context) {
+	id, requestID, ok := dataRequestID(c)
+	if !ok {
+		return
+	}
+
+	r, err := privacyController.users.ExportArchive(context.Background(), id, requestID)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("Content-Disposition", `attachment; filename="`+services.ArchiveFileName(r)+`"`)
+	c.Data(http.StatusOK, "application/zip", *r.Archive)
+}
+
+// dataRequestID reads the user and data request IDs of the path.
+func dataRequestID(c *gin.Context) (int, uuid.UUID, bool) {
+	id, ok := interactiveUserID(c)
+	if !ok {
+		return 0, uuid.Nil, false
+	}
+	requestID, err := uuid.Parse(c.Param("requestId"))
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, "invalid data request id")
+		return 0, uuid.Nil, false
+	}
+	return id, requestID, true
+}
+
+func dataRequestPath(r *ent.DataRequest) string {
+	return "/api/v2/users/" + strconv.Itoa(r.UserID) + "/data-requests/" + r.ID.String()
+}
+
+func dataRequestResponse(r *ent.DataRequest) responses.DataRequestResponse {
+	resp := responses.DataRequestResponse{
+		Status:      responses.StatusSuccess,
+		ID:          r.ID,
+		UserID:      r.UserID,
+		Kind:        string(r.Kind),
+		State:       string(r.Status),
+		Error:       r.Error,
+		CreatedAt:   r.CreatedAt,
+		CompletedAt: r.CompletedAt,
+	}
+	if r.Kind == datarequest.KindExport && services.HasArchive(r) {
+		resp.ArchiveURL = dataRequestPath(r) + "/archive"
+		resp.ExpiresAt = r.ExpiresAt
+	}
+	return resp
+}
@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"errors"
+	"user-service/apikeys"
+	"user-service/common/problems"
+	"user-service/credentials"
+	"user-service/services"
+	"user-service/tokens"
+
+	"github.com/gin-gonic/gin"
+)
+
+// problemFor converts an error into a problem, handling the errors of API key,
+// session and credential management before falling back to the business
+// logic's mapping.
+func problemFor(err error) *problems.Problem {
+	switch {
+	case errors.Is(err, apikeys.ErrAPIKeyNotFound):
+		return problems.New(problems.CodeAPIKeyNotFound, err.Error())
+	case errors.Is(err, apikeys.ErrAPIKeyInactive):
+		return problems.New(problems.CodeAPIKeyInactive, err.Error())
+	case errors.Is(err, apikeys.ErrMissingName),
+		errors.Is(err, apikeys.ErrNoScopes),
+		errors.Is(err, apikeys.ErrUnknownScope),
+		errors.Is(err, apikeys.ErrInvalidExpiry),
+		errors.Is(err, apikeys.ErrInvalidGrace):
+		return problems.New(problems.CodeValidationFailed, err.Error())
+	case errors.Is(err, tokens.ErrSessionNotFound):
+		return problems.New(problems.CodeSessionNotFound, err.Error())
+	case errors.Is(err, credentials.ErrInvalidCredentials):
+		return problems.New(problems.CodeInvalidCredentials, err.Error())
+	case errors.Is(err, credentials.ErrWrongPassword):
+		return problems.New(problems.CodeForbidden, err.Error())
+	case errors.Is(err, credentials.ErrAccountLocked):
+		return problems.New(problems.CodeAccountLocked, err.Error())
+	case errors.Is(err, credentials.ErrWeakPassword):
+		return problems.New(problems.CodeWeakPassword, err.Error())
+	case errors.Is(err, credentials.ErrInvalidReset):
+		return problems.New(problems.CodeInvalidPasswordReset, err.Error())
+	case errors.Is(err, credentials.ErrSecondFactorRequired):
+		return problems.New(problems.CodeMFARequired, err.Error())
+	case errors.Is(err, credentials.ErrInvalidOTP):
+		return problems.New(problems.CodeInvalidOTP, err.Error())
+	case errors.Is(err, credentials.ErrMFANotEnabled):
+		return problems.New(problems.CodeMFANotEnabled, err.Error())
+	case errors.Is(err, credentials.ErrMFAAlreadyEnabled):
+		return problems.New(problems.CodeMFAAlreadyEnabled, err.Error())
+	default:
+		return services.ProblemFor(err)
+	}
+}
+
+func writeProblem(c *gin.Context, err error) {
+	problems.WriteProblem(c, problemFor(err))
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"user-service/audit"
+	"user-service/common/problems"
+	"user-service/common/responses"
+	"user-service/ent"
+	"user-service/projections"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProjectionsController struct {
+	client   *ent.Client
+	balances *projections.BalanceProjector
+}
+
+func NewProjectionsController(client *ent.Client, balances *projections.BalanceProjector) *ProjectionsController {
+	return &ProjectionsController{client: client, balances: balances}
+}
+
+// GetBalanceProjectionStatus
+// @Summary Get balance projection status
+// @Description Report how many wallets are projected, the last event applied and how long it took to arrive
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.ProjectionStatusResponse
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v1/admin/projections/balances [get]
+func (projectionsController *ProjectionsController) GetBalanceProjectionStatus(c *gin.Context) {
+	status, err := projectionsController.balances.Status(context.Background())
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ProjectionStatusResponse{
+		Status:     responses.StatusSuccess,
+		Projection: status,
+	})
+}
+
+// RebuildBalanceProjection
+// @Summary Rebuild balance projection
+// @Description Replace the balance projection with a snapshot from transactions-service in one transaction.
+// @Description Wallets that an event newer than the snapshot already reached are kept.
+// @Tags admin
+// @Produce json
+// @Produce application/problem+json
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.RebuildProjectionResponse
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 503 {object} problems.Problem
+// @Router /v1/admin/projections/balances/rebuild [post]
+func (projectionsController *ProjectionsController) RebuildBalanceProjection(c *gin.Context) {
+	n, err := projectionsController.balances.Rebuild(context.Background())
+	if err != nil {
+		problems.Write(c, problems.CodeServiceUnavailable, "Rebuild failed: "+err.Error())
+		return
+	}
+	recordAudit(c, projectionsController.client, audit.ActionProjectionRebuild, audit.TargetProjection, "balances", map[string]any{
+		"wallets": n,
+	})
+
+	c.JSON(http.StatusOK, responses.RebuildProjectionResponse{
+		Status:  responses.StatusSuccess,
+		Wallets: n,
+	})
+}
@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"user-service/common/responses"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReferralsController struct {
+	users *services.UsersService
+}
+
+func NewReferralsController(users *services.UsersService) *ReferralsController {
+	return &ReferralsController{users: users}
+}
+
+// GetReferralCode
+// @Summary Get the referral code of a user
+// @Description Get the code a user shares to refer new users, who pass it as referral_code when they
+// @Description sign up. The code is issued the first time it is asked for.
+// @Tags referrals
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.ReferralCodeResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 410 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/referral-code [get]
+func (referralsController *ReferralsController) GetReferralCode(c *gin.Context) {
+	id, ok := userID(c)
+	if !ok {
+		return
+	}
+
+	code, err := referralsController.users.ReferralCode(context.Background(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.ReferralCodeResponse{
+		Status:           responses.StatusSuccess,
+		Code:             code.Code,
+		RemainingBonuses: referralsController.users.RemainingReferralBonuses(code),
+	})
+}
+
+// ListReferrals
+// @Summary List the referrals of a user
+// @Description List the users a user referred, the latest first, with the status of each referral and the
+// @Description bonus it earned the referrer. The referred users are not named.
+// @Tags referrals
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.ReferralListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/referrals [get]
+func (referralsController *ReferralsController) ListReferrals(c *gin.Context) {
+	id, ok := userID(c)
+	if !ok {
+		return
+	}
+
+	referrals, err := referralsController.users.ListReferrals(context.Background(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	items := make([]responses.ReferralResponse, 0, len(referrals))
+	for _, r := range referrals {
+		items = append(items, responses.ReferralResponse{
+			ID:          r.ID,
+			Status:      string(r.Status),
+			Bonus:       r.ReferrerBonus,
+			CreatedAt:   r.CreatedAt,
+			QualifiedAt: r.QualifiedAt,
+			PaidAt:      r.PaidAt,
+		})
+	}
+	c.JSON(http.StatusOK, responses.ReferralListResponse{
+		Status:    responses.StatusSuccess,
+		Referrals: items,
+	})
+}
@@ -0,0 +1,164 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"shared/auth"
+	"strconv"
+	"user-service/common/problems"
+	"user-service/common/responses"
+	"user-service/ent"
+	"user-service/tokens"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SessionsController struct {
+	issuer *tokens.Issuer
+}
+
+func NewSessionsController(issuer *tokens.Issuer) *SessionsController {
+	return &SessionsController{issuer: issuer}
+}
+
+// ListSessions
+// @Summary List the sessions of a user
+// @Description List the active sessions of a user, the most recently seen first, with the device, user
+// @Description agent and IP they were last seen from. The session of the caller's token is marked current.
+// @Tags sessions
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Success 200 {object} responses.SessionListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/sessions [get]
+func (sessionsController *SessionsController) ListSessions(c *gin.Context) {
+	id, ok := interactiveUserID(c)
+	if !ok {
+		return
+	}
+
+	sessions, err := sessionsController.issuer.ListSessions(context.Background(), id)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sessionListResponse(c, sessions))
+}
+
+// RevokeSession
+// @Summary Revoke a session
+// @Description Sign a user out of one session. Its refresh token can no longer be used and its access
+// @Description tokens stop working at once, in every service.
+// @Tags sessions
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param sessionId path string true "Session ID"
+// @Security BearerAuth
+// @Success 204
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/sessions/{sessionId} [delete]
+func (sessionsController *SessionsController) RevokeSession(c *gin.Context) {
+	id, ok := interactiveUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := sessionsController.issuer.RevokeSession(context.Background(), id, c.Param("sessionId")); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeSessions
+// @Summary Revoke every session of a user
+// @Description Sign a user out everywhere, or with keep_current=true everywhere but in the session of the
+// @Description caller's token. The access tokens of the revoked sessions stop working at once.
+// @Tags sessions
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param keep_current query bool false "Keep the session of the caller's token"
+// @Security BearerAuth
+// @Success 200 {object} responses.RevokeSessionsResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/sessions [delete]
+func (sessionsController *SessionsController) RevokeSessions(c *gin.Context) {
+	id, ok := interactiveUserID(c)
+	if !ok {
+		return
+	}
+
+	keepCurrent, err := strconv.ParseBool(c.DefaultQuery("keep_current", "false"))
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, "keep_current must be a boolean")
+		return
+	}
+	var keep string
+	if keepCurrent {
+		keep = currentSession(c)
+	}
+
+	n, err := sessionsController.issuer.RevokeSessions(context.Background(), id, keep)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.RevokeSessionsResponse{
+		Status:  responses.StatusSuccess,
+		Revoked: n,
+	})
+}
+
+// device describes the client of a request that logs in or refreshes its
+// tokens, under the name it gave.
+func device(c *gin.Context, name string) tokens.Device {
+	return tokens.Device{Name: name, UserAgent: c.Request.UserAgent(), IP: c.ClientIP()}
+}
+
+// currentSession returns the session of the caller's access token, if any.
+func currentSession(c *gin.Context) string {
+	if p, ok := auth.FromContext(c.Request.Context()); ok {
+		return p.SessionID
+	}
+	return ""
+}
+
+func sessionListResponse(c *gin.Context, sessions []*ent.Session) responses.SessionListResponse {
+	current := currentSession(c)
+	items := make([]responses.SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		items = append(items, responses.SessionResponse{
+			ID:         s.ID,
+			Device:     s.Device,
+			UserAgent:  s.UserAgent,
+			IP:         s.IP,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			ExpiresAt:  s.ExpiresAt,
+			Current:    current != "" && s.ID == current,
+		})
+	}
+	return responses.SessionListResponse{
+		Status:   responses.StatusSuccess,
+		Sessions: items,
+	}
+}
@@ -2,201 +2,640 @@ package controllers
 
 import (
 	"context"
-	"encoding/json"
 	"github.com/gin-gonic/gin"
-	"github.com/nats-io/nats.go"
 	"net/http"
 	"net/url"
+	"shared/auth"
 	"strconv"
 	"time"
+	"user-service/common/problems"
 	"user-service/common/requests"
+	"user-service/common/responses"
+	"user-service/credentials"
 	"user-service/ent"
-	"user-service/ent/user"
+	"user-service/profiles"
+	"user-service/services"
+	"user-service/tokens"
+)
+
+const (
+	defaultUserPageSize = 50
+	maxUserPageSize     = 500
 )
 
 type UserController struct {
-	client   *ent.Client
-	natsConn *nats.Conn
+	users       *services.UsersService
+	issuer      *tokens.Issuer
+	credentials *credentials.Service
 }
 
-func NewUserController(client *ent.Client, natsConn *nats.Conn) *UserController {
-	return &UserController{client: client, natsConn: natsConn}
+func NewUserController(users *services.UsersService, issuer *tokens.Issuer, credentials *credentials.Service) *UserController {
+	return &UserController{users: users, issuer: issuer, credentials: credentials}
 }
 
 // CreateUser
 // @Summary Create a new user
-// @Description Create a new user with the provided email
+// @Description Create a new user with the provided email, and optionally a password and the referral
+// @Description code of the user who referred them
 // @Tags users
 // @Accept json
 // @Produce json
+// @Produce application/problem+json
 // @Param request body requests.CreateUserRequest true "User email"
 // @Success 200 {object} responses.BaseResponse
-// @Failure 400 {object} responses.BaseResponse
-// @Failure 500 {object} responses.BaseResponse
-// @Router /createUser [post]
+// @Failure 400 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Failure 503 {object} problems.Problem
+// @Router /v1/createUser [post]
 func (userController *UserController) CreateUser(c *gin.Context) {
 	var request requests.CreateUserRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"message": err.Error(),
-		})
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
 		return
 	}
 
-	result := make(chan gin.H)
-
-	go userController.processCreateUserRequest(request, result)
-
-	response := <-result
-	status, ok := response["status"].(int)
-	if !ok {
-		status = http.StatusOK
+	if _, err := userController.createUser(context.Background(), request); err != nil {
+		writeProblem(c, err)
+		return
 	}
-	c.JSON(status, response)
+
+	c.JSON(http.StatusOK, responses.BaseResponse{
+		Status:  responses.StatusSuccess,
+		Message: "User created successfully",
+	})
 }
 
 // GetBalance
 // @Summary Get user balance
-// @Description Get the balance of a user by email
+// @Description Get the balance of a user by email. By default transactions-service is asked directly, as
+// @Description v1 clients expect; consistency=eventual reads the local projection of its events instead.
 // @Tags users
 // @Produce json
+// @Produce application/problem+json
 // @Param email path string true "User email"
+// @Param consistency query string false "strong (default) or eventual"
+// @Security BearerAuth
+// @Security APIKey
 // @Success 200 {object} responses.GetBalanceResponse
-// @Failure 400 {object} responses.BaseResponse
-// @Failure 404 {object} responses.BaseResponse
-// @Failure 500 {object} responses.BaseResponse
-// @Router /balance/{email} [get]
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Failure 503 {object} problems.Problem
+// @Router /v1/balance/{email} [get]
 func (userController *UserController) GetBalance(c *gin.Context) {
 	email := c.Param("email")
 
 	decodedEmail, err := url.PathUnescape(email)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid email format"})
+		problems.Write(c, problems.CodeInvalidRequest, "Invalid email format")
 		return
 	}
 
-	result := make(chan gin.H)
+	if err := auth.AuthorizeEmail(c.Request.Context(), decodedEmail); err != nil {
+		writeProblem(c, err)
+		return
+	}
 
-	go userController.processBalanceRequest(decodedEmail, result)
+	consistency := c.DefaultQuery("consistency", services.ConsistencyStrong)
 
-	response := <-result
-	status, ok := response["status"].(int)
-	if !ok {
-		status = http.StatusOK
+	balance, err := userController.users.GetBalance(context.Background(), decodedEmail, consistency)
+	if err != nil {
+		writeProblem(c, err)
+		return
 	}
-	c.JSON(status, response)
+
+	c.JSON(http.StatusOK, balanceResponse(balance))
 }
 
-func (userController *UserController) processBalanceRequest(email string, result chan gin.H) {
-	defer close(result)
+// PostUser
+// @Summary Create a user
+// @Description Create a user and its wallet. The response carries the first access and refresh
+// @Description token of the user. With a password, the user can later log in on /v2/auth/token. With the
+// @Description referral code of another user, both earn a bonus once the referral qualifies.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param request body requests.CreateUserRequest true "User email"
+// @Success 201 {object} responses.UserResponse
+// @Header 201 {string} Location "URL of the created user"
+// @Failure 400 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Failure 503 {object} problems.Problem
+// @Router /v2/users [post]
+func (userController *UserController) PostUser(c *gin.Context) {
+	var request requests.CreateUserRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
 
-	tx, err := userController.client.Tx(context.Background())
+	u, err := userController.createUser(context.Background(), request)
 	if err != nil {
-		result <- gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": "Transaction error: " + err.Error(),
-		}
+		writeProblem(c, err)
 		return
 	}
 
-	_, err = tx.User.Query().Where(user.EmailEQ(email)).Only(context.Background())
+	pair, err := userController.issuer.IssueForUser(context.Background(), u, device(c, ""))
 	if err != nil {
-		result <- gin.H{
-			"status":  http.StatusNotFound,
-			"message": "User not found",
-		}
+		writeProblem(c, err)
+		return
+	}
+
+	resp := userResponse(u)
+	issued := tokenResponse(pair)
+	resp.Tokens = &issued
+	c.Header("Location", "/api/v2/users/"+strconv.Itoa(u.ID))
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetUser
+// @Summary Get a user
+// @Tags users
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.UserResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id} [get]
+func (userController *UserController) GetUser(c *gin.Context) {
+	id, ok := userID(c)
+	if !ok {
 		return
 	}
 
-	msg, err := userController.natsConn.Request("get-balance", []byte(email), 1000*time.Second)
+	u, err := userController.users.GetUser(context.Background(), id)
 	if err != nil {
-		result <- gin.H{
-			"status": http.StatusInternalServerError,
-			"error":  "NATS request error: " + err.Error(),
-		}
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, userResponse(u))
+}
+
+// ListUsers
+// @Summary List users
+// @Description List users ordered by ID, optionally searched and filtered. Requires the users:read permission.
+// @Tags users
+// @Produce json
+// @Produce application/problem+json
+// @Param q query string false "Part of the email or name, ignoring case"
+// @Param email query string false "Part of the email, ignoring case"
+// @Param created_after query string false "Only users created at or after this time (RFC 3339)"
+// @Param created_before query string false "Only users created before this time (RFC 3339)"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.UserListResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users [get]
+func (userController *UserController) ListUsers(c *gin.Context) {
+	filter := services.UserFilter{Query: c.Query("q"), Email: c.Query("email")}
+
+	var ok bool
+	if filter.CreatedAfter, ok = timeQuery(c, "created_after"); !ok {
+		return
+	}
+	if filter.CreatedBefore, ok = timeQuery(c, "created_before"); !ok {
 		return
 	}
 
-	balance := string(msg.Data)
-	bal, err := strconv.ParseFloat(balance, 64)
+	limit, offset, err := pagination(c, defaultUserPageSize, maxUserPageSize)
 	if err != nil {
-		result <- gin.H{
-			"status": http.StatusInternalServerError,
-			"error":  "NATS request error: " + balance,
-		}
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
 	}
 
-	result <- gin.H{
-		"status":  http.StatusOK,
-		"balance": bal,
+	users, total, err := userController.users.ListUsers(context.Background(), filter, limit, offset)
+	if err != nil {
+		writeProblem(c, err)
+		return
 	}
+
+	items := make([]responses.UserResponse, 0, len(users))
+	for _, u := range users {
+		items = append(items, userResponse(u))
+	}
+
+	c.JSON(http.StatusOK, responses.UserListResponse{
+		Status: responses.StatusSuccess,
+		Users:  items,
+		Total:  total,
+	})
 }
 
-func (userController *UserController) processCreateUserRequest(request requests.CreateUserRequest, result chan gin.H) {
-	defer close(result)
+// UpdateUser
+// @Summary Update a user
+// @Description Change the email, name or profile of a user; fields left out are kept, and empty profile
+// @Description fields are removed. Profile fields are validated and normalized, and every change is recorded
+// @Description in the profile history. A new email must not be used by another user and is also set on the
+// @Description user's wallet in transactions-service. Access tokens carry the email they were issued with
+// @Description until they are refreshed. Changing the email is not allowed with an API key and needs the
+// @Description current_password or a step-up token; the previous email is told of the change.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param request body requests.UpdateUserRequest true "Fields to change"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.UserResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 422 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Failure 503 {object} problems.Problem
+// @Router /v2/users/{id} [patch]
+func (userController *UserController) UpdateUser(c *gin.Context) {
+	var request requests.UpdateUserRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
 
-	tx, err := userController.client.Tx(context.Background())
-	if err != nil {
-		result <- gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": "Transaction error: " + err.Error(),
-		}
+	// An email change hands over the account, so API keys cannot make it.
+	resolve := userID
+	if request.Email != nil {
+		resolve = interactiveUserID
+	}
+	id, ok := resolve(c)
+	if !ok {
 		return
 	}
-	_, err = tx.User.Query().Where(user.EmailEQ(request.Email)).Only(context.Background())
-	if err == nil {
-		result <- gin.H{
-			"status":  http.StatusBadRequest,
-			"message": "User already exists",
+	if request.CurrentPassword != nil {
+		if err := userController.credentials.ConfirmPassword(c.Request.Context(), id, *request.CurrentPassword); err != nil {
+			writeProblem(c, err)
+			return
 		}
+	}
+
+	u, err := userController.users.UpdateUser(c.Request.Context(), id, services.UserUpdate{
+		Email:             request.Email,
+		Name:              request.Name,
+		LegalName:         request.LegalName,
+		DateOfBirth:       request.DateOfBirth,
+		Phone:             request.Phone,
+		Address:           request.Address,
+		Locale:            request.Locale,
+		Timezone:          request.Timezone,
+		PasswordConfirmed: request.CurrentPassword != nil,
+	})
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, userResponse(u))
+}
+
+// ProfileHistory
+// @Summary List the profile changes of a user
+// @Description List every change of a user's email, name and profile, newest first, with the old and new
+// @Description value and the subject of the token that made it.
+// @Tags users
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.ProfileHistoryResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/profile-history [get]
+func (userController *UserController) ProfileHistory(c *gin.Context) {
+	id, ok := userID(c)
+	if !ok {
 		return
 	}
 
-	u, err := tx.User.Create().
-		SetEmail(request.Email).
-		SetCreatedAt(time.Now()).
-		Save(context.Background())
+	limit, offset, err := pagination(c, defaultUserPageSize, maxUserPageSize)
 	if err != nil {
-		tx.Rollback()
-		result <- gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": "Database error: " + err.Error(),
-		}
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
 		return
 	}
 
-	userData, err := json.Marshal(u)
+	changes, total, err := userController.users.ListProfileChanges(context.Background(), id, limit, offset)
 	if err != nil {
-		tx.Rollback()
-		result <- gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": "JSON marshal error: " + err.Error(),
-		}
+		writeProblem(c, err)
 		return
 	}
 
-	msg, err := userController.natsConn.Request("user-created", userData, 10*time.Second)
+	items := make([]responses.ProfileChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		items = append(items, responses.ProfileChangeResponse{
+			Field:     change.Field,
+			OldValue:  change.OldValue,
+			NewValue:  change.NewValue,
+			ChangedBy: change.ChangedBy,
+			ChangedAt: change.ChangedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, responses.ProfileHistoryResponse{
+		Status:  responses.StatusSuccess,
+		Changes: items,
+		Total:   total,
+	})
+}
+
+// ResendVerification
+// @Summary Resend the email verification link
+// @Description Send the user another link to verify their email. Links are sent at most once every
+// @Description EMAIL_VERIFICATION_RESEND_INTERVAL.
+// @Tags users
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 202 {object} responses.BaseResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/email-verification [post]
+func (userController *UserController) ResendVerification(c *gin.Context) {
+	id, ok := userID(c)
+	if !ok {
+		return
+	}
+
+	if err := userController.users.ResendVerification(context.Background(), id); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, responses.BaseResponse{
+		Status:  responses.StatusSuccess,
+		Message: "Verification email sent",
+	})
+}
+
+// VerifyEmail
+// @Summary Verify an email
+// @Description Verify the email of a user with the token of the link sent to it. The token is read
+// @Description from the token query parameter, or from the body of a POST. A token stops working
+// @Description once the user changes their email.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param token query string false "Verification token"
+// @Param request body requests.VerifyEmailRequest false "Verification token"
+// @Success 200 {object} responses.UserResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Failure 503 {object} problems.Problem
+// @Router /v2/email-verification [get]
+// @Router /v2/email-verification [post]
+func (userController *UserController) VerifyEmail(c *gin.Context) {
+	var request requests.VerifyEmailRequest
+	if err := c.ShouldBind(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	u, err := userController.users.VerifyEmail(context.Background(), request.Token)
 	if err != nil {
-		tx.Rollback()
-		result <- gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": "NATS request error: " + err.Error(),
-		}
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, userResponse(u))
+}
+
+// SendPhoneVerification
+// @Summary Send a phone verification code
+// @Description Text a code to the phone of the user's profile that verifies it. Codes are sent at
+// @Description most once every PHONE_VERIFICATION_RESEND_INTERVAL and expire after
+// @Description PHONE_VERIFICATION_TTL. Changing the phone drops its verification.
+// @Tags users
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 202 {object} responses.BaseResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 409 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/phone-verification [post]
+func (userController *UserController) SendPhoneVerification(c *gin.Context) {
+	id, ok := userID(c)
+	if !ok {
+		return
+	}
+
+	if err := userController.users.SendPhoneVerification(context.Background(), id); err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, responses.BaseResponse{
+		Status:  responses.StatusSuccess,
+		Message: "Verification code sent",
+	})
+}
+
+// VerifyPhone
+// @Summary Verify a phone
+// @Description Verify the phone of the user's profile with the code texted to it. A code can be
+// @Description entered five times, after which another one has to be sent. Not allowed with an
+// @Description API key.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param request body requests.VerifyPhoneRequest true "Verification code"
+// @Security BearerAuth
+// @Success 200 {object} responses.UserResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Router /v2/users/{id}/phone-verification/confirm [post]
+func (userController *UserController) VerifyPhone(c *gin.Context) {
+	id, ok := interactiveUserID(c)
+	if !ok {
+		return
+	}
+
+	var request requests.VerifyPhoneRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, err.Error())
 		return
 	}
 
-	var response gin.H
-	err = json.Unmarshal(msg.Data, &response)
+	u, err := userController.users.VerifyPhone(context.Background(), id, request.Code)
 	if err != nil {
-		tx.Rollback()
-		result <- gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": "JSON unmarshal error: " + err.Error(),
-		}
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, userResponse(u))
+}
+
+// GetUserBalance
+// @Summary Get the balance of a user
+// @Description Get the wallet balance of a user, read like GET /v1/balance/{email}
+// @Tags users
+// @Produce json
+// @Produce application/problem+json
+// @Param id path int true "User ID"
+// @Param consistency query string false "eventual (default) or strong"
+// @Security BearerAuth
+// @Security APIKey
+// @Success 200 {object} responses.GetBalanceResponse
+// @Failure 400 {object} problems.Problem
+// @Failure 401 {object} problems.Problem
+// @Failure 403 {object} problems.Problem
+// @Failure 404 {object} problems.Problem
+// @Failure 429 {object} problems.Problem
+// @Failure 500 {object} problems.Problem
+// @Failure 503 {object} problems.Problem
+// @Router /v2/users/{id}/balance [get]
+func (userController *UserController) GetUserBalance(c *gin.Context) {
+	id, ok := userID(c)
+	if !ok {
 		return
 	}
 
-	tx.Commit()
-	result <- response
+	consistency := c.DefaultQuery("consistency", services.ConsistencyEventual)
+
+	balance, err := userController.users.GetUserBalance(context.Background(), id, consistency)
+	if err != nil {
+		writeProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, balanceResponse(balance))
+}
+
+// createUser creates the user of a request, with its password if it has one.
+// The password is checked and hashed first, so that a weak one creates no
+// user, and is stored in the transaction that creates the user.
+func (userController *UserController) createUser(ctx context.Context, request requests.CreateUserRequest) (*ent.User, error) {
+	var hash string
+	if request.Password != "" {
+		var err error
+		if hash, err = userController.credentials.HashPassword(ctx, request.Password, request.Email); err != nil {
+			return nil, err
+		}
+	}
+	return userController.users.CreateUser(ctx, request.Email, hash, request.ReferralCode)
+}
+
+// userID reads the user ID path parameter and checks that the caller may act
+// on that user.
+func userID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, "invalid user id")
+		return 0, false
+	}
+	if err := auth.AuthorizeUser(c.Request.Context(), id); err != nil {
+		writeProblem(c, err)
+		return 0, false
+	}
+	return id, true
+}
+
+// interactiveUserID is userID for the routes API keys may not reach, those
+// that could lock the user out of or remove their account.
+func interactiveUserID(c *gin.Context) (int, bool) {
+	if p, ok := auth.FromContext(c.Request.Context()); ok && p.APIKeyID != 0 {
+		problems.Write(c, problems.CodeForbidden, "this operation cannot be performed with an API key")
+		return 0, false
+	}
+	return userID(c)
+}
+
+// timeQuery reads an optional RFC 3339 time query parameter.
+func timeQuery(c *gin.Context, name string) (*time.Time, bool) {
+	v := c.Query(name)
+	if v == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		problems.Write(c, problems.CodeInvalidRequest, name+" must be an RFC 3339 time")
+		return nil, false
+	}
+	return &t, true
+}
+
+func userResponse(u *ent.User) responses.UserResponse {
+	resp := responses.UserResponse{
+		ID:              u.ID,
+		Email:           u.Email,
+		Name:            u.Name,
+		LegalName:       u.LegalName,
+		Phone:           u.Phone,
+		PhoneVerifiedAt: u.PhoneVerifiedAt,
+		Address:         u.Address,
+		Locale:          u.Locale,
+		Timezone:        u.Timezone,
+		VerifiedAt:      u.VerifiedAt,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+		ErasedAt:        u.ErasedAt,
+	}
+	if u.DateOfBirth != nil {
+		resp.DateOfBirth = u.DateOfBirth.Format(profiles.DateLayout)
+	}
+	return resp
+}
+
+func balanceResponse(balance services.Balance) responses.GetBalanceResponse {
+	return responses.GetBalanceResponse{
+		Status:   responses.StatusSuccess,
+		Balance:  balance.Amount,
+		Currency: balance.Currency,
+		Source:   balance.Source,
+		Sequence: balance.Sequence,
+		AsOf:     balance.AsOf,
+	}
 }
@@ -0,0 +1,73 @@
+package credentials
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// hashParams are the Argon2id parameters of a password hash.
+type hashParams struct {
+	memory  uint32 // KiB
+	time    uint32
+	threads uint8
+	keyLen  uint32
+}
+
+// defaultParams follow the OWASP recommendation for Argon2id. Hashes made with
+// other parameters still verify and are rehashed on the next login.
+var defaultParams = hashParams{memory: 19 * 1024, time: 2, threads: 1, keyLen: 32}
+
+const saltLen = 16
+
+var errMalformedHash = errors.New("malformed password hash")
+
+// hashPassword hashes password into a PHC string such as
+// $argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>.
+func hashPassword(password string) (string, error) {
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generating salt: %w", err)
+	}
+	p := defaultParams
+	key := argon2.IDKey([]byte(password), salt, p.time, p.memory, p.threads, p.keyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.memory, p.time, p.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// checkPassword reports whether password matches hash, and whether hash
+// should be replaced because it was made with other parameters.
+func checkPassword(hash, password string) (ok bool, rehash bool, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return false, false, errMalformedHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, false, errMalformedHash
+	}
+	var p hashParams
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return false, false, errMalformedHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, false, errMalformedHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return false, false, errMalformedHash
+	}
+	p.keyLen = uint32(len(key))
+
+	computed := argon2.IDKey([]byte(password), salt, p.time, p.memory, p.threads, p.keyLen)
+	ok = subtle.ConstantTimeCompare(computed, key) == 1
+	return ok, p != defaultParams || len(salt) != saltLen, nil
+}
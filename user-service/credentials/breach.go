@@ -0,0 +1,58 @@
+package credentials
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultPwnedPasswordsURL is the range API of Have I Been Pwned.
+const DefaultPwnedPasswordsURL = "https://api.pwnedpasswords.com/range/"
+
+// PwnedPasswords checks passwords against the Have I Been Pwned range API.
+// Only the first five characters of the password's SHA-1 hash are sent.
+type PwnedPasswords struct {
+	url    string
+	client *http.Client
+}
+
+func NewPwnedPasswords(url string, timeout time.Duration) *PwnedPasswords {
+	return &PwnedPasswords{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+func (p *PwnedPasswords) Breached(ctx context.Context, password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := digest[:5], digest[5:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+prefix, nil)
+	if err != nil {
+		return false, err
+	}
+	// Padding hides the number of matching suffixes from observers.
+	req.Header.Set("Add-Padding", "true")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("pwned passwords answered %s", resp.Status)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		candidate, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if ok && candidate == suffix {
+			// Padding entries have a count of 0.
+			return count != "0", nil
+		}
+	}
+	return false, scanner.Err()
+}
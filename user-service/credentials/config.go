@@ -0,0 +1,132 @@
+package credentials
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Breach checks selectable with PASSWORD_BREACH_CHECK.
+const (
+	BreachCheckPwned = "pwned"
+	BreachCheckOff   = "off"
+)
+
+// minMinLength is the shortest minimum password length that can be configured.
+const minMinLength = 8
+
+// Config controls the password policy, the lockout, the reset links and the
+// TOTP provisioning.
+type Config struct {
+	Policy Policy
+	// LockoutThreshold is the number of failed logins in a row that lock the account.
+	LockoutThreshold int
+	// LockoutBase is the first lockout; every further failure doubles it, up to LockoutMax.
+	LockoutBase time.Duration
+	LockoutMax  time.Duration
+	// ResetURL is the page of the reset link; the token is added as its token
+	// query parameter.
+	ResetURL string
+	// TOTPIssuer names the service in authenticator apps.
+	TOTPIssuer string
+}
+
+var defaultConfig = Config{
+	Policy:           Policy{MinLength: 10},
+	LockoutThreshold: 5,
+	LockoutBase:      time.Minute,
+	LockoutMax:       time.Hour,
+	ResetURL:         "http://localhost:3000/reset-password",
+	TOTPIssuer:       "Digital Wallet",
+}
+
+// LoadConfig reads PASSWORD_MIN_LENGTH, PASSWORD_BREACH_CHECK (pwned or off),
+// PWNED_PASSWORDS_URL, LOGIN_LOCKOUT_THRESHOLD, LOGIN_LOCKOUT_BASE,
+// LOGIN_LOCKOUT_MAX, PASSWORD_RESET_URL and TOTP_ISSUER.
+func LoadConfig() (Config, error) {
+	config := defaultConfig
+
+	var err error
+	if config.Policy.MinLength, err = intFromEnv("PASSWORD_MIN_LENGTH", config.Policy.MinLength); err != nil {
+		return Config{}, err
+	}
+	if config.Policy.MinLength < minMinLength || config.Policy.MinLength > maxPasswordLength {
+		return Config{}, fmt.Errorf("PASSWORD_MIN_LENGTH must be between %d and %d", minMinLength, maxPasswordLength)
+	}
+
+	switch check := os.Getenv("PASSWORD_BREACH_CHECK"); check {
+	case "", BreachCheckPwned:
+		pwnedURL := os.Getenv("PWNED_PASSWORDS_URL")
+		if pwnedURL == "" {
+			pwnedURL = DefaultPwnedPasswordsURL
+		}
+		config.Policy.Breaches = NewPwnedPasswords(pwnedURL, 2*time.Second)
+	case BreachCheckOff:
+	default:
+		return Config{}, fmt.Errorf("PASSWORD_BREACH_CHECK must be %s or %s, got %q", BreachCheckPwned, BreachCheckOff, check)
+	}
+
+	if config.LockoutThreshold, err = intFromEnv("LOGIN_LOCKOUT_THRESHOLD", config.LockoutThreshold); err != nil {
+		return Config{}, err
+	}
+	if config.LockoutBase, err = durationFromEnv("LOGIN_LOCKOUT_BASE", config.LockoutBase); err != nil {
+		return Config{}, err
+	}
+	if config.LockoutMax, err = durationFromEnv("LOGIN_LOCKOUT_MAX", config.LockoutMax); err != nil {
+		return Config{}, err
+	}
+	if config.LockoutMax < config.LockoutBase {
+		return Config{}, fmt.Errorf("LOGIN_LOCKOUT_MAX must not be shorter than LOGIN_LOCKOUT_BASE")
+	}
+
+	if v := os.Getenv("PASSWORD_RESET_URL"); v != "" {
+		if _, err := url.Parse(v); err != nil {
+			return Config{}, fmt.Errorf("PASSWORD_RESET_URL must be a URL: %w", err)
+		}
+		config.ResetURL = v
+	}
+	if v := os.Getenv("TOTP_ISSUER"); v != "" {
+		if strings.Contains(v, ":") {
+			return Config{}, fmt.Errorf("TOTP_ISSUER must not contain a colon")
+		}
+		config.TOTPIssuer = v
+	}
+	return config, nil
+}
+
+// lockout is how long the account is locked after the given number of
+// failures past the first locking one.
+func (c Config) lockout(over int) time.Duration {
+	d := c.LockoutBase
+	for i := 0; i < over && d < c.LockoutMax; i++ {
+		d *= 2
+	}
+	return min(d, c.LockoutMax)
+}
+
+func intFromEnv(key string, fallback int) (int, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%s must be a positive integer", key)
+	}
+	return n, nil
+}
+
+func durationFromEnv(key string, fallback time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%s must be a positive duration", key)
+	}
+	return d, nil
+}
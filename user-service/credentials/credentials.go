@@ -0,0 +1,399 @@
+// Package credentials manages the passwords users log in with: hashing with
+// Argon2id, the password policy, password changes and resets, and the
+// lockout after failed logins. It also manages the second factor: TOTP,
+// recovery codes and the step-up of sensitive operations.
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+	"user-service/ent"
+	"user-service/ent/credential"
+	"user-service/ent/user"
+	"user-service/mailer"
+	"user-service/services"
+	"user-service/tokens"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrAccountLocked      = errors.New("account is locked after too many failed logins")
+	ErrWrongPassword      = errors.New("current password is wrong")
+	ErrInvalidReset       = errors.New("password reset token is invalid, expired or already used")
+)
+
+// dummyHash is checked against when there is no password to check, so that
+// failing logins take as long for unknown emails as for wrong passwords.
+var dummyHash, _ = hashPassword("dummy password")
+
+// Service manages the credentials of users.
+type Service struct {
+	client *ent.Client
+	issuer *tokens.Issuer
+	mailer mailer.Mailer
+	config Config
+}
+
+func NewService(client *ent.Client, issuer *tokens.Issuer, mailer mailer.Mailer, config Config) *Service {
+	return &Service{client: client, issuer: issuer, mailer: mailer, config: config}
+}
+
+// Login returns the user with the given email and password. Users with
+// two-factor authentication also give a one-time or recovery code as otp;
+// without one, Login returns ErrSecondFactorRequired. Failed logins lock the
+// account for a time that doubles with every failure past the lockout
+// threshold; a successful login clears them.
+func (s *Service) Login(ctx context.Context, email, password, otp string) (*ent.User, error) {
+	u, err := s.client.User.Query().Where(user.EmailEQ(email)).Only(ctx)
+	if ent.IsNotFound(err) {
+		checkPassword(dummyHash, password)
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.client.Credential.Query().Where(credential.UserID(u.ID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		checkPassword(dummyHash, password)
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rehash, err := s.verifyPassword(ctx, c, password)
+	if err != nil {
+		return nil, err
+	}
+	if totpEnabled(c) {
+		// The failures are only cleared once both factors passed, so that a
+		// known password does not reset the guessing of codes.
+		if otp == "" {
+			return nil, ErrSecondFactorRequired
+		}
+		if err := s.verifySecondFactor(ctx, c, otp); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.succeed(ctx, c, password, rehash); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// CheckPolicy returns an error wrapping ErrWeakPassword when password may not
+// be set for the user with the given email.
+func (s *Service) CheckPolicy(ctx context.Context, password, email string) error {
+	return s.config.Policy.Check(ctx, password, email)
+}
+
+// HashPassword checks password against the policy for a user signing up with
+// email and returns its hash, to be stored with the new user.
+func (s *Service) HashPassword(ctx context.Context, password, email string) (string, error) {
+	if err := s.CheckPolicy(ctx, password, email); err != nil {
+		return "", err
+	}
+	return hashPassword(password)
+}
+
+// SetPassword sets the password of a user without asking for the current one,
+// for users who have none yet.
+func (s *Service) SetPassword(ctx context.Context, userID int, password string) error {
+	u, err := s.user(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.CheckPolicy(ctx, password, u.Email); err != nil {
+		return err
+	}
+	return s.store(ctx, userID, password, nil)
+}
+
+// ChangePassword replaces the password of a user. The current password is
+// required once the user has one, and wrong ones count as failed logins.
+// Every session of the user is revoked.
+func (s *Service) ChangePassword(ctx context.Context, userID int, current, password string) error {
+	u, err := s.user(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	c, err := s.client.Credential.Query().Where(credential.UserID(userID)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if c != nil && c.PasswordHash != "" {
+		err := s.authenticate(ctx, c, current)
+		if errors.Is(err, ErrInvalidCredentials) {
+			return ErrWrongPassword
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.CheckPolicy(ctx, password, u.Email); err != nil {
+		return err
+	}
+	return s.store(ctx, userID, password, nil)
+}
+
+// ConfirmPassword checks the current password of a user, who confirms a
+// sensitive change with it. Wrong passwords count as failed logins.
+func (s *Service) ConfirmPassword(ctx context.Context, userID int, password string) error {
+	c, err := s.client.Credential.Query().Where(credential.UserID(userID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		checkPassword(dummyHash, password)
+		return ErrWrongPassword
+	}
+	if err != nil {
+		return err
+	}
+	err = s.authenticate(ctx, c, password)
+	if errors.Is(err, ErrInvalidCredentials) {
+		return ErrWrongPassword
+	}
+	return err
+}
+
+// RequestReset mails a password reset link to the user with the given email.
+// The link is sent in the background and unknown emails are ignored, so the
+// answer tells nothing about which emails have accounts. Only the latest link
+// of a user works.
+func (s *Service) RequestReset(ctx context.Context, email string) error {
+	u, err := s.client.User.Query().Where(user.EmailEQ(email)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := s.sendReset(context.Background(), u); err != nil {
+			log.Printf("error sending password reset email to user %d: %v", u.ID, err)
+		}
+	}()
+	return nil
+}
+
+// ResetPassword sets the password of the user a reset token was issued to,
+// lifting any lockout. A token can be used once, and only while the user
+// still has the email it was sent to. Every session of the user is revoked.
+func (s *Service) ResetPassword(ctx context.Context, token, password string) error {
+	claims, err := s.issuer.VerifyPasswordReset(ctx, token)
+	if err != nil {
+		return ErrInvalidReset
+	}
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return ErrInvalidReset
+	}
+	u, err := s.client.User.Get(ctx, userID)
+	if ent.IsNotFound(err) {
+		return ErrInvalidReset
+	}
+	if err != nil {
+		return err
+	}
+	if u.Email != claims.Email {
+		return ErrInvalidReset
+	}
+
+	if err := s.CheckPolicy(ctx, password, u.Email); err != nil {
+		return err
+	}
+	return s.store(ctx, userID, password, &claims.ID)
+}
+
+// authenticate checks password against the credential c, applying the lockout.
+func (s *Service) authenticate(ctx context.Context, c *ent.Credential, password string) error {
+	rehash, err := s.verifyPassword(ctx, c, password)
+	if err != nil {
+		return err
+	}
+	return s.succeed(ctx, c, password, rehash)
+}
+
+// verifyPassword checks password against the credential c, applying the
+// lockout, and reports whether its hash should be upgraded.
+func (s *Service) verifyPassword(ctx context.Context, c *ent.Credential, password string) (bool, error) {
+	now := time.Now()
+	if c.LockedUntil != nil && now.Before(*c.LockedUntil) {
+		return false, lockedUntil(*c.LockedUntil)
+	}
+	if c.PasswordHash == "" {
+		checkPassword(dummyHash, password)
+		return false, ErrInvalidCredentials
+	}
+
+	ok, rehash, err := checkPassword(c.PasswordHash, password)
+	if err != nil {
+		return false, fmt.Errorf("checking password of user %d: %w", c.UserID, err)
+	}
+	if !ok {
+		return false, s.recordFailure(ctx, c, now)
+	}
+	return rehash, nil
+}
+
+// succeed clears the failed logins of the credential c and upgrades the hash
+// of password if rehash is set.
+func (s *Service) succeed(ctx context.Context, c *ent.Credential, password string, rehash bool) error {
+	if c.FailedAttempts == 0 && c.LockedUntil == nil && !rehash {
+		return nil
+	}
+	update := s.client.Credential.UpdateOne(c).
+		SetFailedAttempts(0).
+		ClearLockedUntil()
+	if rehash {
+		hash, err := hashPassword(password)
+		if err != nil {
+			return err
+		}
+		update = update.SetPasswordHash(hash)
+	}
+	return update.Exec(ctx)
+}
+
+// recordFailure counts a failed login and locks the account once the failures
+// reach the lockout threshold.
+func (s *Service) recordFailure(ctx context.Context, c *ent.Credential, now time.Time) error {
+	c, err := s.client.Credential.UpdateOne(c).AddFailedAttempts(1).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	over := c.FailedAttempts - s.config.LockoutThreshold
+	if over < 0 {
+		return ErrInvalidCredentials
+	}
+	until := now.Add(s.config.lockout(over))
+	if err := s.client.Credential.UpdateOne(c).SetLockedUntil(until).Exec(ctx); err != nil {
+		return err
+	}
+	return lockedUntil(until)
+}
+
+// store hashes password and sets it as the password of a user, revoking
+// every session of the user. With resetTokenID set, the password is only
+// stored if that reset token is the latest one and unused.
+func (s *Service) store(ctx context.Context, userID int, password string, resetTokenID *string) error {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	if resetTokenID == nil {
+		if err := s.ensure(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	now := time.Now()
+	update := tx.Credential.Update().Where(credential.UserID(userID))
+	if resetTokenID != nil {
+		update = update.Where(credential.ResetTokenID(*resetTokenID))
+	}
+	n, err := update.
+		SetPasswordHash(hash).
+		SetPasswordChangedAt(now).
+		SetFailedAttempts(0).
+		ClearLockedUntil().
+		ClearResetTokenID().
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if n == 0 {
+		tx.Rollback()
+		return ErrInvalidReset
+	}
+
+	revoked, err := tokens.RevokeUserSessions(ctx, tx, userID, "", now)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	s.issuer.DenySessions(revoked...)
+	return nil
+}
+
+// sendReset records a new reset token for u, replacing the previous one, and
+// mails its link.
+func (s *Service) sendReset(ctx context.Context, u *ent.User) error {
+	token, id, err := s.issuer.IssuePasswordReset(ctx, u)
+	if err != nil {
+		return err
+	}
+	if err := s.ensure(ctx, u.ID); err != nil {
+		return err
+	}
+	err = s.client.Credential.Update().
+		Where(credential.UserID(u.ID)).
+		SetResetTokenID(id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	link, err := url.Parse(s.config.ResetURL)
+	if err != nil {
+		return err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      u.Email,
+		Subject: "Reset your password",
+		Body: "Someone asked to reset the password of " + u.Email + ". To choose a new password, open the link below.\n\n" +
+			link.String() + "\n\n" +
+			"The link expires in " + s.issuer.PasswordResetTTL().String() + " and can be used once. " +
+			"If you did not ask for it, you can ignore this email.\n",
+	})
+}
+
+// ensure creates the credential of a user if it does not exist yet.
+func (s *Service) ensure(ctx context.Context, userID int) error {
+	err := s.client.Credential.Create().SetUserID(userID).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return nil
+	}
+	return err
+}
+
+// user returns the user with the given ID, who must not have been erased.
+func (s *Service) user(ctx context.Context, id int) (*ent.User, error) {
+	u, err := s.client.User.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, services.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if u.ErasedAt != nil {
+		return nil, services.ErrUserErased
+	}
+	return u, nil
+}
+
+func lockedUntil(until time.Time) error {
+	return fmt.Errorf("%w, retry after %s", ErrAccountLocked, until.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,314 @@
+package credentials
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"user-service/ent"
+	"user-service/ent/credential"
+	"user-service/ent/recoverycode"
+)
+
+var (
+	ErrSecondFactorRequired = errors.New("a one-time code from the authenticator app or a recovery code is required")
+	ErrInvalidOTP           = errors.New("one-time code is invalid or already used")
+	ErrMFANotEnabled        = errors.New("two-factor authentication is not enabled")
+	ErrMFAAlreadyEnabled    = errors.New("two-factor authentication is already enabled")
+)
+
+// Recovery codes are recoveryCodeCount codes of two groups of five characters
+// from recoveryCodeAlphabet, which leaves out characters that are easily
+// confused.
+const (
+	recoveryCodeCount    = 10
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+// Enrollment is a TOTP secret waiting to be confirmed.
+type Enrollment struct {
+	Secret string
+	// URI is the otpauth URI of the secret, to be shown as a QR code.
+	URI string
+}
+
+// EnrollTOTP generates a new TOTP secret for a user, replacing any secret
+// that was not confirmed yet. The secret only protects logins once
+// ConfirmTOTP accepted a code for it.
+func (s *Service) EnrollTOTP(ctx context.Context, userID int) (*Enrollment, error) {
+	u, err := s.user(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensure(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	secret, err := newTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.client.Credential.Update().
+		Where(credential.UserID(userID), credential.TotpConfirmedAtIsNil()).
+		SetTotpSecret(secret).
+		SetTotpLastStep(0).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	return &Enrollment{Secret: secret, URI: totpURI(s.config.TOTPIssuer, u.Email, secret)}, nil
+}
+
+// ConfirmTOTP enables two-factor authentication for a user once code proves
+// that their authenticator app holds the enrolled secret. It returns the
+// recovery codes of the user, which are shown this once.
+func (s *Service) ConfirmTOTP(ctx context.Context, userID int, code string) ([]string, error) {
+	c, err := s.credential(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if c.TotpConfirmedAt != nil {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	if c.TotpSecret == nil {
+		return nil, fmt.Errorf("%w, enroll a TOTP secret first", ErrMFANotEnabled)
+	}
+	step, ok := checkTOTP(*c.TotpSecret, normalizeCode(code), time.Now(), c.TotpLastStep)
+	if !ok {
+		return nil, ErrInvalidOTP
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	n, err := tx.Credential.Update().
+		Where(credential.ID(c.ID), credential.TotpSecret(*c.TotpSecret), credential.TotpConfirmedAtIsNil()).
+		SetTotpConfirmedAt(time.Now()).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if n == 0 {
+		tx.Rollback()
+		return nil, ErrInvalidOTP
+	}
+	codes, err := replaceRecoveryCodes(ctx, tx, userID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return codes, nil
+}
+
+// DisableTOTP turns two-factor authentication off for a user, who confirms it
+// with a one-time or recovery code. The recovery codes are deleted.
+func (s *Service) DisableTOTP(ctx context.Context, userID int, code string) error {
+	c, err := s.enabledCredential(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.verifySecondFactor(ctx, c, code); err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	err = tx.Credential.UpdateOne(c).
+		ClearTotpSecret().
+		ClearTotpConfirmedAt().
+		SetTotpLastStep(0).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.RecoveryCode.Delete().Where(recoverycode.UserID(userID)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of a user, who confirms
+// it with a one-time or recovery code.
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, userID int, code string) ([]string, error) {
+	c, err := s.enabledCredential(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifySecondFactor(ctx, c, code); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	codes, err := replaceRecoveryCodes(ctx, tx, userID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return codes, nil
+}
+
+// StepUp returns a step-up token for a user who confirmed a one-time or
+// recovery code. Users without two-factor authentication cannot step up, as
+// their password alone must not authorize sensitive operations.
+func (s *Service) StepUp(ctx context.Context, userID int, code string) (string, error) {
+	u, err := s.user(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	c, err := s.enabledCredential(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if err := s.verifySecondFactor(ctx, c, code); err != nil {
+		return "", err
+	}
+	return s.issuer.IssueStepUp(ctx, u)
+}
+
+// StepUpTTL is how long a step-up lasts.
+func (s *Service) StepUpTTL() time.Duration {
+	return s.issuer.StepUpTTL()
+}
+
+// verifySecondFactor checks a one-time or recovery code against the enabled
+// credential c and uses it up. Wrong codes count as failed logins, so that
+// codes cannot be guessed.
+func (s *Service) verifySecondFactor(ctx context.Context, c *ent.Credential, code string) error {
+	now := time.Now()
+	if c.LockedUntil != nil && now.Before(*c.LockedUntil) {
+		return lockedUntil(*c.LockedUntil)
+	}
+
+	code = normalizeCode(code)
+	if step, ok := checkTOTP(*c.TotpSecret, code, now, c.TotpLastStep); ok {
+		// Only the first of concurrent requests with the same code gets it.
+		n, err := s.client.Credential.Update().
+			Where(credential.ID(c.ID), credential.TotpLastStepLT(step)).
+			SetTotpLastStep(step).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 1 {
+			return nil
+		}
+	} else {
+		n, err := s.client.RecoveryCode.Update().
+			Where(recoverycode.UserID(c.UserID), recoverycode.Hash(hashRecoveryCode(code)), recoverycode.UsedAtIsNil()).
+			SetUsedAt(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 1 {
+			return nil
+		}
+	}
+
+	err := s.recordFailure(ctx, c, now)
+	if errors.Is(err, ErrInvalidCredentials) {
+		return ErrInvalidOTP
+	}
+	return err
+}
+
+// credential returns the credential of a user, which exists once a password
+// or a TOTP secret was set.
+func (s *Service) credential(ctx context.Context, userID int) (*ent.Credential, error) {
+	if _, err := s.user(ctx, userID); err != nil {
+		return nil, err
+	}
+	c, err := s.client.Credential.Query().Where(credential.UserID(userID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrMFANotEnabled
+	}
+	return c, err
+}
+
+// enabledCredential returns the credential of a user with two-factor
+// authentication enabled.
+func (s *Service) enabledCredential(ctx context.Context, userID int) (*ent.Credential, error) {
+	c, err := s.credential(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !totpEnabled(c) {
+		return nil, ErrMFANotEnabled
+	}
+	return c, nil
+}
+
+func totpEnabled(c *ent.Credential) bool {
+	return c.TotpSecret != nil && c.TotpConfirmedAt != nil
+}
+
+// replaceRecoveryCodes deletes the recovery codes of a user and returns new ones.
+func replaceRecoveryCodes(ctx context.Context, tx *ent.Tx, userID int) ([]string, error) {
+	if _, err := tx.RecoveryCode.Delete().Where(recoverycode.UserID(userID)).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, recoveryCodeCount)
+	creates := make([]*ent.RecoveryCodeCreate, recoveryCodeCount)
+	for i := range codes {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code
+		creates[i] = tx.RecoveryCode.Create().SetUserID(userID).SetHash(hashRecoveryCode(normalizeCode(code)))
+	}
+	if err := tx.RecoveryCode.CreateBulk(creates...).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func newRecoveryCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		// 256 is not a multiple of the alphabet size; the slight bias costs a
+		// fraction of a bit of the code's 49 bits.
+		b[i] = recoveryCodeAlphabet[int(b[i])%len(recoveryCodeAlphabet)]
+	}
+	return string(b[:5]) + "-" + string(b[5:]), nil
+}
+
+// normalizeCode strips what users type around codes: spaces, dashes and case.
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(code)))
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
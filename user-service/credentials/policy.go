@@ -0,0 +1,78 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrWeakPassword is returned for passwords the policy refuses.
+var ErrWeakPassword = errors.New("password is too weak")
+
+// maxPasswordLength bounds the work of hashing a password.
+const maxPasswordLength = 128
+
+// commonPasswords are refused even when the breach check is off.
+var commonPasswords = map[string]bool{
+	"1234567890": true, "12345678910": true, "0123456789": true, "0987654321": true,
+	"1111111111": true, "1q2w3e4r5t": true, "1qaz2wsx3edc": true, "abcdefghij": true,
+	"password12": true, "password123": true, "password1234": true, "passw0rd123": true,
+	"qwertyuiop": true, "qwerty1234": true, "qwerty12345": true, "qwerty123456": true,
+	"asdfghjkl1": true, "zxcvbnm123": true, "iloveyou12": true, "iloveyou123": true,
+	"letmein123": true, "welcome123": true, "welcome1234": true, "administrator": true,
+	"admin12345": true, "changeme123": true, "football123": true, "baseball123": true,
+	"sunshine123": true, "princess123": true, "monkey12345": true, "dragon12345": true,
+	"trustno1234": true, "superman123": true, "starwars123": true, "whatever123": true,
+	"computer123": true, "internet123": true, "michael123": true, "jennifer123": true,
+	"1234qwer": true, "123456789a": true, "a123456789": true, "12345qwert": true,
+	"1234567890a": true, "aa12345678": true, "abc1234567": true, "abcd123456": true,
+	"q1w2e3r4t5": true, "q1w2e3r4t5y6": true, "zaq12wsxcde3": true, "passwordpassword": true,
+	"digitalwallet": true, "digital-wallet": true, "wallet12345": true,
+}
+
+// BreachChecker reports whether a password is known from data breaches.
+type BreachChecker interface {
+	Breached(ctx context.Context, password string) (bool, error)
+}
+
+// Policy decides which passwords may be set.
+type Policy struct {
+	MinLength int
+	// Breaches is consulted when set. Passwords are accepted when it fails,
+	// so an outage of the breach database does not block users.
+	Breaches BreachChecker
+}
+
+// Check returns an error wrapping ErrWeakPassword when password may not be
+// set for the user with the given email.
+func (p Policy) Check(ctx context.Context, password, email string) error {
+	length := utf8.RuneCountInString(password)
+	if length == 0 || length < p.MinLength {
+		return fmt.Errorf("%w: it must have at least %d characters", ErrWeakPassword, p.MinLength)
+	}
+	if length > maxPasswordLength {
+		return fmt.Errorf("%w: it must have at most %d characters", ErrWeakPassword, maxPasswordLength)
+	}
+
+	lower := strings.ToLower(password)
+	if commonPasswords[lower] || strings.Count(lower, lower[:1]) == len(lower) {
+		return fmt.Errorf("%w: it is too common", ErrWeakPassword)
+	}
+	local, _, _ := strings.Cut(strings.ToLower(email), "@")
+	if len(local) >= 3 && strings.Contains(lower, local) {
+		return fmt.Errorf("%w: it must not contain the email", ErrWeakPassword)
+	}
+
+	if p.Breaches != nil {
+		breached, err := p.Breaches.Breached(ctx, password)
+		if err != nil {
+			log.Printf("error checking password against breaches: %v", err)
+		} else if breached {
+			return fmt.Errorf("%w: it appeared in a data breach", ErrWeakPassword)
+		}
+	}
+	return nil
+}
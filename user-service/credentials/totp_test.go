@@ -0,0 +1,64 @@
+package credentials
+
+import (
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 key of the test vectors of RFC 6238, base32-encoded.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCheckTOTP(t *testing.T) {
+	// At 1111111111 the RFC 6238 code is 14050471, 050471 in six digits.
+	now := time.Unix(1111111111, 0)
+	step := now.Unix() / totpPeriod
+	key, _ := totpEncoding.DecodeString(rfcSecret)
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		last     int64
+		wantStep int64
+		wantOK   bool
+	}{
+		{name: "current step", secret: rfcSecret, code: "050471", wantStep: step, wantOK: true},
+		{name: "previous step", secret: rfcSecret, code: totpCode(key, step-1), wantStep: step - 1, wantOK: true},
+		{name: "next step", secret: rfcSecret, code: totpCode(key, step+1), wantStep: step + 1, wantOK: true},
+		{name: "two steps early", secret: rfcSecret, code: totpCode(key, step-2)},
+		{name: "two steps late", secret: rfcSecret, code: totpCode(key, step+2)},
+		{name: "replayed", secret: rfcSecret, code: "050471", last: step},
+		{name: "later step after replay", secret: rfcSecret, code: totpCode(key, step+1), last: step, wantStep: step + 1, wantOK: true},
+		{name: "wrong code", secret: rfcSecret, code: "000000"},
+		{name: "too short", secret: rfcSecret, code: "05047"},
+		{name: "too long", secret: rfcSecret, code: "0504711"},
+		{name: "malformed secret", secret: "not base32!", code: "050471"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, gotOK := checkTOTP(tt.secret, tt.code, now, tt.last)
+			if gotOK != tt.wantOK || gotStep != tt.wantStep {
+				t.Errorf("checkTOTP(%q) = %d, %v; want %d, %v", tt.code, gotStep, gotOK, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTOTPCodeRFC6238(t *testing.T) {
+	key, _ := totpEncoding.DecodeString(rfcSecret)
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		if got := totpCode(key, tt.unix/totpPeriod); got != tt.want {
+			t.Errorf("totpCode at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
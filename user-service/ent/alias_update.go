@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/alias"
+	"user-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AliasUpdate is the builder for updating Alias entities.
+type AliasUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AliasMutation
+}
+
+// Where appends a list predicates to the AliasUpdate builder.
+func (au *AliasUpdate) Where(ps ...predicate.Alias) *AliasUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetValue sets the "value" field.
+func (au *AliasUpdate) SetValue(s string) *AliasUpdate {
+	au.mutation.SetValue(s)
+	return au
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (au *AliasUpdate) SetNillableValue(s *string) *AliasUpdate {
+	if s != nil {
+		au.SetValue(*s)
+	}
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AliasUpdate) SetUpdatedAt(t time.Time) *AliasUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// Mutation returns the AliasMutation object of the builder.
+func (au *AliasUpdate) Mutation() *AliasMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AliasUpdate) Save(ctx context.Context) (int, error) {
+	au.defaults()
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AliasUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AliasUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AliasUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AliasUpdate) defaults() {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		v := alias.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (au *AliasUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(alias.Table, alias.Columns, sqlgraph.NewFieldSpec(alias.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.Value(); ok {
+		_spec.SetField(alias.FieldValue, field.TypeString, value)
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.SetField(alias.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AliasUpdateOne is the builder for updating a single Alias entity.
+type AliasUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AliasMutation
+}
+
+// SetValue sets the "value" field.
+func (auo *AliasUpdateOne) SetValue(s string) *AliasUpdateOne {
+	auo.mutation.SetValue(s)
+	return auo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (auo *AliasUpdateOne) SetNillableValue(s *string) *AliasUpdateOne {
+	if s != nil {
+		auo.SetValue(*s)
+	}
+	return auo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AliasUpdateOne) SetUpdatedAt(t time.Time) *AliasUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// Mutation returns the AliasMutation object of the builder.
+func (auo *AliasUpdateOne) Mutation() *AliasMutation {
+	return auo.mutation
+}
+
+// Where appends a list predicates to the AliasUpdate builder.
+func (auo *AliasUpdateOne) Where(ps ...predicate.Alias) *AliasUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AliasUpdateOne) Select(field string, fields ...string) *AliasUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Alias entity.
+func (auo *AliasUpdateOne) Save(ctx context.Context) (*Alias, error) {
+	auo.defaults()
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AliasUpdateOne) SaveX(ctx context.Context) *Alias {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AliasUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AliasUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AliasUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		v := alias.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (auo *AliasUpdateOne) sqlSave(ctx context.Context) (_node *Alias, err error) {
+	_spec := sqlgraph.NewUpdateSpec(alias.Table, alias.Columns, sqlgraph.NewFieldSpec(alias.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Alias.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, alias.FieldID)
+		for _, f := range fields {
+			if !alias.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != alias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.Value(); ok {
+		_spec.SetField(alias.FieldValue, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.SetField(alias.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Alias{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"user-service/ent/balanceprojection"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BalanceProjection is the model entity for the BalanceProjection schema.
+type BalanceProjection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Balance holds the value of the "balance" field.
+	Balance float64 `json:"balance,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Sequence holds the value of the "sequence" field.
+	Sequence int `json:"sequence,omitempty"`
+	// AsOf holds the value of the "as_of" field.
+	AsOf time.Time `json:"as_of,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BalanceProjection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case balanceprojection.FieldBalance:
+			values[i] = new(sql.NullFloat64)
+		case balanceprojection.FieldID, balanceprojection.FieldSequence:
+			values[i] = new(sql.NullInt64)
+		case balanceprojection.FieldEmail, balanceprojection.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case balanceprojection.FieldAsOf, balanceprojection.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BalanceProjection fields.
+func (bp *BalanceProjection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case balanceprojection.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bp.ID = int(value.Int64)
+		case balanceprojection.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				bp.Email = value.String
+			}
+		case balanceprojection.FieldBalance:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				bp.Balance = value.Float64
+			}
+		case balanceprojection.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				bp.Currency = value.String
+			}
+		case balanceprojection.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				bp.Sequence = int(value.Int64)
+			}
+		case balanceprojection.FieldAsOf:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field as_of", values[i])
+			} else if value.Valid {
+				bp.AsOf = value.Time
+			}
+		case balanceprojection.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				bp.UpdatedAt = value.Time
+			}
+		default:
+			bp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BalanceProjection.
+// This includes values selected through modifiers, order, etc.
+func (bp *BalanceProjection) Value(name string) (ent.Value, error) {
+	return bp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BalanceProjection.
+// Note that you need to call BalanceProjection.Unwrap() before calling this method if this BalanceProjection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bp *BalanceProjection) Update() *BalanceProjectionUpdateOne {
+	return NewBalanceProjectionClient(bp.config).UpdateOne(bp)
+}
+
+// Unwrap unwraps the BalanceProjection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bp *BalanceProjection) Unwrap() *BalanceProjection {
+	_tx, ok := bp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BalanceProjection is not a transactional entity")
+	}
+	bp.config.driver = _tx.drv
+	return bp
+}
+
+// String implements the fmt.Stringer.
+func (bp *BalanceProjection) String() string {
+	var builder strings.Builder
+	builder.WriteString("BalanceProjection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bp.ID))
+	builder.WriteString("email=")
+	builder.WriteString(bp.Email)
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(bp.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Sequence))
+	builder.WriteString(", ")
+	builder.WriteString("as_of=")
+	builder.WriteString(bp.AsOf.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(bp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BalanceProjections is a parsable slice of BalanceProjection.
+type BalanceProjections []*BalanceProjection
@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/balanceprojection"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BalanceProjectionCreate is the builder for creating a BalanceProjection entity.
+type BalanceProjectionCreate struct {
+	config
+	mutation *BalanceProjectionMutation
+	hooks    []Hook
+}
+
+// SetEmail sets the "email" field.
+func (bpc *BalanceProjectionCreate) SetEmail(s string) *BalanceProjectionCreate {
+	bpc.mutation.SetEmail(s)
+	return bpc
+}
+
+// SetBalance sets the "balance" field.
+func (bpc *BalanceProjectionCreate) SetBalance(f float64) *BalanceProjectionCreate {
+	bpc.mutation.SetBalance(f)
+	return bpc
+}
+
+// SetCurrency sets the "currency" field.
+func (bpc *BalanceProjectionCreate) SetCurrency(s string) *BalanceProjectionCreate {
+	bpc.mutation.SetCurrency(s)
+	return bpc
+}
+
+// SetSequence sets the "sequence" field.
+func (bpc *BalanceProjectionCreate) SetSequence(i int) *BalanceProjectionCreate {
+	bpc.mutation.SetSequence(i)
+	return bpc
+}
+
+// SetAsOf sets the "as_of" field.
+func (bpc *BalanceProjectionCreate) SetAsOf(t time.Time) *BalanceProjectionCreate {
+	bpc.mutation.SetAsOf(t)
+	return bpc
+}
+
+// SetNillableAsOf sets the "as_of" field if the given value is not nil.
+func (bpc *BalanceProjectionCreate) SetNillableAsOf(t *time.Time) *BalanceProjectionCreate {
+	if t != nil {
+		bpc.SetAsOf(*t)
+	}
+	return bpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpc *BalanceProjectionCreate) SetUpdatedAt(t time.Time) *BalanceProjectionCreate {
+	bpc.mutation.SetUpdatedAt(t)
+	return bpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bpc *BalanceProjectionCreate) SetNillableUpdatedAt(t *time.Time) *BalanceProjectionCreate {
+	if t != nil {
+		bpc.SetUpdatedAt(*t)
+	}
+	return bpc
+}
+
+// SetID sets the "id" field.
+func (bpc *BalanceProjectionCreate) SetID(i int) *BalanceProjectionCreate {
+	bpc.mutation.SetID(i)
+	return bpc
+}
+
+// Mutation returns the BalanceProjectionMutation object of the builder.
+func (bpc *BalanceProjectionCreate) Mutation() *BalanceProjectionMutation {
+	return bpc.mutation
+}
+
+// Save creates the BalanceProjection in the database.
+func (bpc *BalanceProjectionCreate) Save(ctx context.Context) (*BalanceProjection, error) {
+	bpc.defaults()
+	return withHooks(ctx, bpc.sqlSave, bpc.mutation, bpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bpc *BalanceProjectionCreate) SaveX(ctx context.Context) *BalanceProjection {
+	v, err := bpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bpc *BalanceProjectionCreate) Exec(ctx context.Context) error {
+	_, err := bpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpc *BalanceProjectionCreate) ExecX(ctx context.Context) {
+	if err := bpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpc *BalanceProjectionCreate) defaults() {
+	if _, ok := bpc.mutation.UpdatedAt(); !ok {
+		v := balanceprojection.DefaultUpdatedAt()
+		bpc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bpc *BalanceProjectionCreate) check() error {
+	if _, ok := bpc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "BalanceProjection.email"`)}
+	}
+	if _, ok := bpc.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "BalanceProjection.balance"`)}
+	}
+	if _, ok := bpc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "BalanceProjection.currency"`)}
+	}
+	if _, ok := bpc.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "BalanceProjection.sequence"`)}
+	}
+	if _, ok := bpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BalanceProjection.updated_at"`)}
+	}
+	return nil
+}
+
+func (bpc *BalanceProjectionCreate) sqlSave(ctx context.Context) (*BalanceProjection, error) {
+	if err := bpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	bpc.mutation.id = &_node.ID
+	bpc.mutation.done = true
+	return _node, nil
+}
+
+func (bpc *BalanceProjectionCreate) createSpec() (*BalanceProjection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BalanceProjection{config: bpc.config}
+		_spec = sqlgraph.NewCreateSpec(balanceprojection.Table, sqlgraph.NewFieldSpec(balanceprojection.FieldID, field.TypeInt))
+	)
+	if id, ok := bpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bpc.mutation.Email(); ok {
+		_spec.SetField(balanceprojection.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := bpc.mutation.Balance(); ok {
+		_spec.SetField(balanceprojection.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
+	if value, ok := bpc.mutation.Currency(); ok {
+		_spec.SetField(balanceprojection.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := bpc.mutation.Sequence(); ok {
+		_spec.SetField(balanceprojection.FieldSequence, field.TypeInt, value)
+		_node.Sequence = value
+	}
+	if value, ok := bpc.mutation.AsOf(); ok {
+		_spec.SetField(balanceprojection.FieldAsOf, field.TypeTime, value)
+		_node.AsOf = value
+	}
+	if value, ok := bpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(balanceprojection.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// BalanceProjectionCreateBulk is the builder for creating many BalanceProjection entities in bulk.
+type BalanceProjectionCreateBulk struct {
+	config
+	err      error
+	builders []*BalanceProjectionCreate
+}
+
+// Save creates the BalanceProjection entities in the database.
+func (bpcb *BalanceProjectionCreateBulk) Save(ctx context.Context) ([]*BalanceProjection, error) {
+	if bpcb.err != nil {
+		return nil, bpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bpcb.builders))
+	nodes := make([]*BalanceProjection, len(bpcb.builders))
+	mutators := make([]Mutator, len(bpcb.builders))
+	for i := range bpcb.builders {
+		func(i int, root context.Context) {
+			builder := bpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BalanceProjectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpcb *BalanceProjectionCreateBulk) SaveX(ctx context.Context) []*BalanceProjection {
+	v, err := bpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bpcb *BalanceProjectionCreateBulk) Exec(ctx context.Context) error {
+	_, err := bpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpcb *BalanceProjectionCreateBulk) ExecX(ctx context.Context) {
+	if err := bpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
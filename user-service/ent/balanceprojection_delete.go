@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"user-service/ent/balanceprojection"
+	"user-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BalanceProjectionDelete is the builder for deleting a BalanceProjection entity.
+type BalanceProjectionDelete struct {
+	config
+	hooks    []Hook
+	mutation *BalanceProjectionMutation
+}
+
+// Where appends a list predicates to the BalanceProjectionDelete builder.
+func (bpd *BalanceProjectionDelete) Where(ps ...predicate.BalanceProjection) *BalanceProjectionDelete {
+	bpd.mutation.Where(ps...)
+	return bpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bpd *BalanceProjectionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bpd.sqlExec, bpd.mutation, bpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpd *BalanceProjectionDelete) ExecX(ctx context.Context) int {
+	n, err := bpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bpd *BalanceProjectionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(balanceprojection.Table, sqlgraph.NewFieldSpec(balanceprojection.FieldID, field.TypeInt))
+	if ps := bpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bpd.mutation.done = true
+	return affected, err
+}
+
+// BalanceProjectionDeleteOne is the builder for deleting a single BalanceProjection entity.
+type BalanceProjectionDeleteOne struct {
+	bpd *BalanceProjectionDelete
+}
+
+// Where appends a list predicates to the BalanceProjectionDelete builder.
+func (bpdo *BalanceProjectionDeleteOne) Where(ps ...predicate.BalanceProjection) *BalanceProjectionDeleteOne {
+	bpdo.bpd.mutation.Where(ps...)
+	return bpdo
+}
+
+// Exec executes the deletion query.
+func (bpdo *BalanceProjectionDeleteOne) Exec(ctx context.Context) error {
+	n, err := bpdo.bpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{balanceprojection.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpdo *BalanceProjectionDeleteOne) ExecX(ctx context.Context) {
+	if err := bpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
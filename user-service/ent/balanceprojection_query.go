@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"user-service/ent/balanceprojection"
+	"user-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BalanceProjectionQuery is the builder for querying BalanceProjection entities.
+type BalanceProjectionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []balanceprojection.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BalanceProjection
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BalanceProjectionQuery builder.
+func (bpq *BalanceProjectionQuery) Where(ps ...predicate.BalanceProjection) *BalanceProjectionQuery {
+	bpq.predicates = append(bpq.predicates, ps...)
+	return bpq
+}
+
+// Limit the number of records to be returned by this query.
+func (bpq *BalanceProjectionQuery) Limit(limit int) *BalanceProjectionQuery {
+	bpq.ctx.Limit = &limit
+	return bpq
+}
+
+// Offset to start from.
+func (bpq *BalanceProjectionQuery) Offset(offset int) *BalanceProjectionQuery {
+	bpq.ctx.Offset = &offset
+	return bpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bpq *BalanceProjectionQuery) Unique(unique bool) *BalanceProjectionQuery {
+	bpq.ctx.Unique = &unique
+	return bpq
+}
+
+// Order specifies how the records should be ordered.
+func (bpq *BalanceProjectionQuery) Order(o ...balanceprojection.OrderOption) *BalanceProjectionQuery {
+	bpq.order = append(bpq.order, o...)
+	return bpq
+}
+
+// First returns the first BalanceProjection entity from the query.
+// Returns a *NotFoundError when no BalanceProjection was found.
+func (bpq *BalanceProjectionQuery) First(ctx context.Context) (*BalanceProjection, error) {
+	nodes, err := bpq.Limit(1).All(setContextOp(ctx, bpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{balanceprojection.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bpq *BalanceProjectionQuery) FirstX(ctx context.Context) *BalanceProjection {
+	node, err := bpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BalanceProjection ID from the query.
+// Returns a *NotFoundError when no BalanceProjection ID was found.
+func (bpq *BalanceProjectionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bpq.Limit(1).IDs(setContextOp(ctx, bpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{balanceprojection.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bpq *BalanceProjectionQuery) FirstIDX(ctx context.Context) int {
+	id, err := bpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BalanceProjection entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BalanceProjection entity is found.
+// Returns a *NotFoundError when no BalanceProjection entities are found.
+func (bpq *BalanceProjectionQuery) Only(ctx context.Context) (*BalanceProjection, error) {
+	nodes, err := bpq.Limit(2).All(setContextOp(ctx, bpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{balanceprojection.Label}
+	default:
+		return nil, &NotSingularError{balanceprojection.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bpq *BalanceProjectionQuery) OnlyX(ctx context.Context) *BalanceProjection {
+	node, err := bpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BalanceProjection ID in the query.
+// Returns a *NotSingularError when more than one BalanceProjection ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bpq *BalanceProjectionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bpq.Limit(2).IDs(setContextOp(ctx, bpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{balanceprojection.Label}
+	default:
+		err = &NotSingularError{balanceprojection.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bpq *BalanceProjectionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := bpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BalanceProjections.
+func (bpq *BalanceProjectionQuery) All(ctx context.Context) ([]*BalanceProjection, error) {
+	ctx = setContextOp(ctx, bpq.ctx, "All")
+	if err := bpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BalanceProjection, *BalanceProjectionQuery]()
+	return withInterceptors[[]*BalanceProjection](ctx, bpq, qr, bpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bpq *BalanceProjectionQuery) AllX(ctx context.Context) []*BalanceProjection {
+	nodes, err := bpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BalanceProjection IDs.
+func (bpq *BalanceProjectionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if bpq.ctx.Unique == nil && bpq.path != nil {
+		bpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, bpq.ctx, "IDs")
+	if err = bpq.Select(balanceprojection.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bpq *BalanceProjectionQuery) IDsX(ctx context.Context) []int {
+	ids, err := bpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bpq *BalanceProjectionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, bpq.ctx, "Count")
+	if err := bpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, bpq, querierCount[*BalanceProjectionQuery](), bpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bpq *BalanceProjectionQuery) CountX(ctx context.Context) int {
+	count, err := bpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bpq *BalanceProjectionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, bpq.ctx, "Exist")
+	switch _, err := bpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bpq *BalanceProjectionQuery) ExistX(ctx context.Context) bool {
+	exist, err := bpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BalanceProjectionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bpq *BalanceProjectionQuery) Clone() *BalanceProjectionQuery {
+	if bpq == nil {
+		return nil
+	}
+	return &BalanceProjectionQuery{
+		config:     bpq.config,
+		ctx:        bpq.ctx.Clone(),
+		order:      append([]balanceprojection.OrderOption{}, bpq.order...),
+		inters:     append([]Interceptor{}, bpq.inters...),
+		predicates: append([]predicate.BalanceProjection{}, bpq.predicates...),
+		// clone intermediate query.
+		sql:  bpq.sql.Clone(),
+		path: bpq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BalanceProjection.Query().
+//		GroupBy(balanceprojection.FieldEmail).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (bpq *BalanceProjectionQuery) GroupBy(field string, fields ...string) *BalanceProjectionGroupBy {
+	bpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BalanceProjectionGroupBy{build: bpq}
+	grbuild.flds = &bpq.ctx.Fields
+	grbuild.label = balanceprojection.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//	}
+//
+//	client.BalanceProjection.Query().
+//		Select(balanceprojection.FieldEmail).
+//		Scan(ctx, &v)
+func (bpq *BalanceProjectionQuery) Select(fields ...string) *BalanceProjectionSelect {
+	bpq.ctx.Fields = append(bpq.ctx.Fields, fields...)
+	sbuild := &BalanceProjectionSelect{BalanceProjectionQuery: bpq}
+	sbuild.label = balanceprojection.Label
+	sbuild.flds, sbuild.scan = &bpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BalanceProjectionSelect configured with the given aggregations.
+func (bpq *BalanceProjectionQuery) Aggregate(fns ...AggregateFunc) *BalanceProjectionSelect {
+	return bpq.Select().Aggregate(fns...)
+}
+
+func (bpq *BalanceProjectionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range bpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, bpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range bpq.ctx.Fields {
+		if !balanceprojection.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bpq.path != nil {
+		prev, err := bpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bpq.sql = prev
+	}
+	return nil
+}
+
+func (bpq *BalanceProjectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BalanceProjection, error) {
+	var (
+		nodes = []*BalanceProjection{}
+		_spec = bpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BalanceProjection).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BalanceProjection{config: bpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (bpq *BalanceProjectionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bpq.querySpec()
+	_spec.Node.Columns = bpq.ctx.Fields
+	if len(bpq.ctx.Fields) > 0 {
+		_spec.Unique = bpq.ctx.Unique != nil && *bpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, bpq.driver, _spec)
+}
+
+func (bpq *BalanceProjectionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(balanceprojection.Table, balanceprojection.Columns, sqlgraph.NewFieldSpec(balanceprojection.FieldID, field.TypeInt))
+	_spec.From = bpq.sql
+	if unique := bpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if bpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := bpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balanceprojection.FieldID)
+		for i := range fields {
+			if fields[i] != balanceprojection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bpq *BalanceProjectionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bpq.driver.Dialect())
+	t1 := builder.Table(balanceprojection.Table)
+	columns := bpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = balanceprojection.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bpq.sql != nil {
+		selector = bpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bpq.ctx.Unique != nil && *bpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range bpq.predicates {
+		p(selector)
+	}
+	for _, p := range bpq.order {
+		p(selector)
+	}
+	if offset := bpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BalanceProjectionGroupBy is the group-by builder for BalanceProjection entities.
+type BalanceProjectionGroupBy struct {
+	selector
+	build *BalanceProjectionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bpgb *BalanceProjectionGroupBy) Aggregate(fns ...AggregateFunc) *BalanceProjectionGroupBy {
+	bpgb.fns = append(bpgb.fns, fns...)
+	return bpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bpgb *BalanceProjectionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bpgb.build.ctx, "GroupBy")
+	if err := bpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BalanceProjectionQuery, *BalanceProjectionGroupBy](ctx, bpgb.build, bpgb, bpgb.build.inters, v)
+}
+
+func (bpgb *BalanceProjectionGroupBy) sqlScan(ctx context.Context, root *BalanceProjectionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(bpgb.fns))
+	for _, fn := range bpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*bpgb.flds)+len(bpgb.fns))
+		for _, f := range *bpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*bpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BalanceProjectionSelect is the builder for selecting fields of BalanceProjection entities.
+type BalanceProjectionSelect struct {
+	*BalanceProjectionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bps *BalanceProjectionSelect) Aggregate(fns ...AggregateFunc) *BalanceProjectionSelect {
+	bps.fns = append(bps.fns, fns...)
+	return bps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bps *BalanceProjectionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bps.ctx, "Select")
+	if err := bps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BalanceProjectionQuery, *BalanceProjectionSelect](ctx, bps.BalanceProjectionQuery, bps, bps.inters, v)
+}
+
+func (bps *BalanceProjectionSelect) sqlScan(ctx context.Context, root *BalanceProjectionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bps.fns))
+	for _, fn := range bps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
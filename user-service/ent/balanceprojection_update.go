@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/balanceprojection"
+	"user-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BalanceProjectionUpdate is the builder for updating BalanceProjection entities.
+type BalanceProjectionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BalanceProjectionMutation
+}
+
+// Where appends a list predicates to the BalanceProjectionUpdate builder.
+func (bpu *BalanceProjectionUpdate) Where(ps ...predicate.BalanceProjection) *BalanceProjectionUpdate {
+	bpu.mutation.Where(ps...)
+	return bpu
+}
+
+// SetEmail sets the "email" field.
+func (bpu *BalanceProjectionUpdate) SetEmail(s string) *BalanceProjectionUpdate {
+	bpu.mutation.SetEmail(s)
+	return bpu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (bpu *BalanceProjectionUpdate) SetNillableEmail(s *string) *BalanceProjectionUpdate {
+	if s != nil {
+		bpu.SetEmail(*s)
+	}
+	return bpu
+}
+
+// SetBalance sets the "balance" field.
+func (bpu *BalanceProjectionUpdate) SetBalance(f float64) *BalanceProjectionUpdate {
+	bpu.mutation.ResetBalance()
+	bpu.mutation.SetBalance(f)
+	return bpu
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (bpu *BalanceProjectionUpdate) SetNillableBalance(f *float64) *BalanceProjectionUpdate {
+	if f != nil {
+		bpu.SetBalance(*f)
+	}
+	return bpu
+}
+
+// AddBalance adds f to the "balance" field.
+func (bpu *BalanceProjectionUpdate) AddBalance(f float64) *BalanceProjectionUpdate {
+	bpu.mutation.AddBalance(f)
+	return bpu
+}
+
+// SetCurrency sets the "currency" field.
+func (bpu *BalanceProjectionUpdate) SetCurrency(s string) *BalanceProjectionUpdate {
+	bpu.mutation.SetCurrency(s)
+	return bpu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (bpu *BalanceProjectionUpdate) SetNillableCurrency(s *string) *BalanceProjectionUpdate {
+	if s != nil {
+		bpu.SetCurrency(*s)
+	}
+	return bpu
+}
+
+// SetSequence sets the "sequence" field.
+func (bpu *BalanceProjectionUpdate) SetSequence(i int) *BalanceProjectionUpdate {
+	bpu.mutation.ResetSequence()
+	bpu.mutation.SetSequence(i)
+	return bpu
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (bpu *BalanceProjectionUpdate) SetNillableSequence(i *int) *BalanceProjectionUpdate {
+	if i != nil {
+		bpu.SetSequence(*i)
+	}
+	return bpu
+}
+
+// AddSequence adds i to the "sequence" field.
+func (bpu *BalanceProjectionUpdate) AddSequence(i int) *BalanceProjectionUpdate {
+	bpu.mutation.AddSequence(i)
+	return bpu
+}
+
+// SetAsOf sets the "as_of" field.
+func (bpu *BalanceProjectionUpdate) SetAsOf(t time.Time) *BalanceProjectionUpdate {
+	bpu.mutation.SetAsOf(t)
+	return bpu
+}
+
+// SetNillableAsOf sets the "as_of" field if the given value is not nil.
+func (bpu *BalanceProjectionUpdate) SetNillableAsOf(t *time.Time) *BalanceProjectionUpdate {
+	if t != nil {
+		bpu.SetAsOf(*t)
+	}
+	return bpu
+}
+
+// ClearAsOf clears the value of the "as_of" field.
+func (bpu *BalanceProjectionUpdate) ClearAsOf() *BalanceProjectionUpdate {
+	bpu.mutation.ClearAsOf()
+	return bpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpu *BalanceProjectionUpdate) SetUpdatedAt(t time.Time) *BalanceProjectionUpdate {
+	bpu.mutation.SetUpdatedAt(t)
+	return bpu
+}
+
+// Mutation returns the BalanceProjectionMutation object of the builder.
+func (bpu *BalanceProjectionUpdate) Mutation() *BalanceProjectionMutation {
+	return bpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bpu *BalanceProjectionUpdate) Save(ctx context.Context) (int, error) {
+	bpu.defaults()
+	return withHooks(ctx, bpu.sqlSave, bpu.mutation, bpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpu *BalanceProjectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := bpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bpu *BalanceProjectionUpdate) Exec(ctx context.Context) error {
+	_, err := bpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpu *BalanceProjectionUpdate) ExecX(ctx context.Context) {
+	if err := bpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpu *BalanceProjectionUpdate) defaults() {
+	if _, ok := bpu.mutation.UpdatedAt(); !ok {
+		v := balanceprojection.UpdateDefaultUpdatedAt()
+		bpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (bpu *BalanceProjectionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(balanceprojection.Table, balanceprojection.Columns, sqlgraph.NewFieldSpec(balanceprojection.FieldID, field.TypeInt))
+	if ps := bpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bpu.mutation.Email(); ok {
+		_spec.SetField(balanceprojection.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := bpu.mutation.Balance(); ok {
+		_spec.SetField(balanceprojection.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := bpu.mutation.AddedBalance(); ok {
+		_spec.AddField(balanceprojection.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := bpu.mutation.Currency(); ok {
+		_spec.SetField(balanceprojection.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := bpu.mutation.Sequence(); ok {
+		_spec.SetField(balanceprojection.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := bpu.mutation.AddedSequence(); ok {
+		_spec.AddField(balanceprojection.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := bpu.mutation.AsOf(); ok {
+		_spec.SetField(balanceprojection.FieldAsOf, field.TypeTime, value)
+	}
+	if bpu.mutation.AsOfCleared() {
+		_spec.ClearField(balanceprojection.FieldAsOf, field.TypeTime)
+	}
+	if value, ok := bpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(balanceprojection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balanceprojection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bpu.mutation.done = true
+	return n, nil
+}
+
+// BalanceProjectionUpdateOne is the builder for updating a single BalanceProjection entity.
+type BalanceProjectionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BalanceProjectionMutation
+}
+
+// SetEmail sets the "email" field.
+func (bpuo *BalanceProjectionUpdateOne) SetEmail(s string) *BalanceProjectionUpdateOne {
+	bpuo.mutation.SetEmail(s)
+	return bpuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (bpuo *BalanceProjectionUpdateOne) SetNillableEmail(s *string) *BalanceProjectionUpdateOne {
+	if s != nil {
+		bpuo.SetEmail(*s)
+	}
+	return bpuo
+}
+
+// SetBalance sets the "balance" field.
+func (bpuo *BalanceProjectionUpdateOne) SetBalance(f float64) *BalanceProjectionUpdateOne {
+	bpuo.mutation.ResetBalance()
+	bpuo.mutation.SetBalance(f)
+	return bpuo
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (bpuo *BalanceProjectionUpdateOne) SetNillableBalance(f *float64) *BalanceProjectionUpdateOne {
+	if f != nil {
+		bpuo.SetBalance(*f)
+	}
+	return bpuo
+}
+
+// AddBalance adds f to the "balance" field.
+func (bpuo *BalanceProjectionUpdateOne) AddBalance(f float64) *BalanceProjectionUpdateOne {
+	bpuo.mutation.AddBalance(f)
+	return bpuo
+}
+
+// SetCurrency sets the "currency" field.
+func (bpuo *BalanceProjectionUpdateOne) SetCurrency(s string) *BalanceProjectionUpdateOne {
+	bpuo.mutation.SetCurrency(s)
+	return bpuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (bpuo *BalanceProjectionUpdateOne) SetNillableCurrency(s *string) *BalanceProjectionUpdateOne {
+	if s != nil {
+		bpuo.SetCurrency(*s)
+	}
+	return bpuo
+}
+
+// SetSequence sets the "sequence" field.
+func (bpuo *BalanceProjectionUpdateOne) SetSequence(i int) *BalanceProjectionUpdateOne {
+	bpuo.mutation.ResetSequence()
+	bpuo.mutation.SetSequence(i)
+	return bpuo
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (bpuo *BalanceProjectionUpdateOne) SetNillableSequence(i *int) *BalanceProjectionUpdateOne {
+	if i != nil {
+		bpuo.SetSequence(*i)
+	}
+	return bpuo
+}
+
+// AddSequence adds i to the "sequence" field.
+func (bpuo *BalanceProjectionUpdateOne) AddSequence(i int) *BalanceProjectionUpdateOne {
+	bpuo.mutation.AddSequence(i)
+	return bpuo
+}
+
+// SetAsOf sets the "as_of" field.
+func (bpuo *BalanceProjectionUpdateOne) SetAsOf(t time.Time) *BalanceProjectionUpdateOne {
+	bpuo.mutation.SetAsOf(t)
+	return bpuo
+}
+
+// SetNillableAsOf sets the "as_of" field if the given value is not nil.
+func (bpuo *BalanceProjectionUpdateOne) SetNillableAsOf(t *time.Time) *BalanceProjectionUpdateOne {
+	if t != nil {
+		bpuo.SetAsOf(*t)
+	}
+	return bpuo
+}
+
+// ClearAsOf clears the value of the "as_of" field.
+func (bpuo *BalanceProjectionUpdateOne) ClearAsOf() *BalanceProjectionUpdateOne {
+	bpuo.mutation.ClearAsOf()
+	return bpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpuo *BalanceProjectionUpdateOne) SetUpdatedAt(t time.Time) *BalanceProjectionUpdateOne {
+	bpuo.mutation.SetUpdatedAt(t)
+	return bpuo
+}
+
+// Mutation returns the BalanceProjectionMutation object of the builder.
+func (bpuo *BalanceProjectionUpdateOne) Mutation() *BalanceProjectionMutation {
+	return bpuo.mutation
+}
+
+// Where appends a list predicates to the BalanceProjectionUpdate builder.
+func (bpuo *BalanceProjectionUpdateOne) Where(ps ...predicate.BalanceProjection) *BalanceProjectionUpdateOne {
+	bpuo.mutation.Where(ps...)
+	return bpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bpuo *BalanceProjectionUpdateOne) Select(field string, fields ...string) *BalanceProjectionUpdateOne {
+	bpuo.fields = append([]string{field}, fields...)
+	return bpuo
+}
+
+// Save executes the query and returns the updated BalanceProjection entity.
+func (bpuo *BalanceProjectionUpdateOne) Save(ctx context.Context) (*BalanceProjection, error) {
+	bpuo.defaults()
+	return withHooks(ctx, bpuo.sqlSave, bpuo.mutation, bpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpuo *BalanceProjectionUpdateOne) SaveX(ctx context.Context) *BalanceProjection {
+	node, err := bpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bpuo *BalanceProjectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := bpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpuo *BalanceProjectionUpdateOne) ExecX(ctx context.Context) {
+	if err := bpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpuo *BalanceProjectionUpdateOne) defaults() {
+	if _, ok := bpuo.mutation.UpdatedAt(); !ok {
+		v := balanceprojection.UpdateDefaultUpdatedAt()
+		bpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (bpuo *BalanceProjectionUpdateOne) sqlSave(ctx context.Context) (_node *BalanceProjection, err error) {
+	_spec := sqlgraph.NewUpdateSpec(balanceprojection.Table, balanceprojection.Columns, sqlgraph.NewFieldSpec(balanceprojection.FieldID, field.TypeInt))
+	id, ok := bpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BalanceProjection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balanceprojection.FieldID)
+		for _, f := range fields {
+			if !balanceprojection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != balanceprojection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bpuo.mutation.Email(); ok {
+		_spec.SetField(balanceprojection.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := bpuo.mutation.Balance(); ok {
+		_spec.SetField(balanceprojection.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := bpuo.mutation.AddedBalance(); ok {
+		_spec.AddField(balanceprojection.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := bpuo.mutation.Currency(); ok {
+		_spec.SetField(balanceprojection.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := bpuo.mutation.Sequence(); ok {
+		_spec.SetField(balanceprojection.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := bpuo.mutation.AddedSequence(); ok {
+		_spec.AddField(balanceprojection.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := bpuo.mutation.AsOf(); ok {
+		_spec.SetField(balanceprojection.FieldAsOf, field.TypeTime, value)
+	}
+	if bpuo.mutation.AsOfCleared() {
+		_spec.ClearField(balanceprojection.FieldAsOf, field.TypeTime)
+	}
+	if value, ok := bpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(balanceprojection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &BalanceProjection{config: bpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balanceprojection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bpuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/credential"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CredentialCreate is the builder for creating a Credential entity.
+type CredentialCreate struct {
+	config
+	mutation *CredentialMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (cc *CredentialCreate) SetUserID(i int) *CredentialCreate {
+	cc.mutation.SetUserID(i)
+	return cc
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (cc *CredentialCreate) SetPasswordHash(s string) *CredentialCreate {
+	cc.mutation.SetPasswordHash(s)
+	return cc
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillablePasswordHash(s *string) *CredentialCreate {
+	if s != nil {
+		cc.SetPasswordHash(*s)
+	}
+	return cc
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (cc *CredentialCreate) SetPasswordChangedAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetPasswordChangedAt(t)
+	return cc
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillablePasswordChangedAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetPasswordChangedAt(*t)
+	}
+	return cc
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (cc *CredentialCreate) SetFailedAttempts(i int) *CredentialCreate {
+	cc.mutation.SetFailedAttempts(i)
+	return cc
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableFailedAttempts(i *int) *CredentialCreate {
+	if i != nil {
+		cc.SetFailedAttempts(*i)
+	}
+	return cc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (cc *CredentialCreate) SetLockedUntil(t time.Time) *CredentialCreate {
+	cc.mutation.SetLockedUntil(t)
+	return cc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableLockedUntil(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetLockedUntil(*t)
+	}
+	return cc
+}
+
+// SetResetTokenID sets the "reset_token_id" field.
+func (cc *CredentialCreate) SetResetTokenID(s string) *CredentialCreate {
+	cc.mutation.SetResetTokenID(s)
+	return cc
+}
+
+// SetNillableResetTokenID sets the "reset_token_id" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableResetTokenID(s *string) *CredentialCreate {
+	if s != nil {
+		cc.SetResetTokenID(*s)
+	}
+	return cc
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (cc *CredentialCreate) SetTotpSecret(s string) *CredentialCreate {
+	cc.mutation.SetTotpSecret(s)
+	return cc
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableTotpSecret(s *string) *CredentialCreate {
+	if s != nil {
+		cc.SetTotpSecret(*s)
+	}
+	return cc
+}
+
+// SetTotpConfirmedAt sets the "totp_confirmed_at" field.
+func (cc *CredentialCreate) SetTotpConfirmedAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetTotpConfirmedAt(t)
+	return cc
+}
+
+// SetNillableTotpConfirmedAt sets the "totp_confirmed_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableTotpConfirmedAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetTotpConfirmedAt(*t)
+	}
+	return cc
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (cc *CredentialCreate) SetTotpLastStep(i int64) *CredentialCreate {
+	cc.mutation.SetTotpLastStep(i)
+	return cc
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableTotpLastStep(i *int64) *CredentialCreate {
+	if i != nil {
+		cc.SetTotpLastStep(*i)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CredentialCreate) SetCreatedAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableCreatedAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// Mutation returns the CredentialMutation object of the builder.
+func (cc *CredentialCreate) Mutation() *CredentialMutation {
+	return cc.mutation
+}
+
+// Save creates the Credential in the database.
+func (cc *CredentialCreate) Save(ctx context.Context) (*Credential, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CredentialCreate) SaveX(ctx context.Context) *Credential {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CredentialCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CredentialCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CredentialCreate) defaults() {
+	if _, ok := cc.mutation.FailedAttempts(); !ok {
+		v := credential.DefaultFailedAttempts
+		cc.mutation.SetFailedAttempts(v)
+	}
+	if _, ok := cc.mutation.TotpLastStep(); !ok {
+		v := credential.DefaultTotpLastStep
+		cc.mutation.SetTotpLastStep(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := credential.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CredentialCreate) check() error {
+	if _, ok := cc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Credential.user_id"`)}
+	}
+	if _, ok := cc.mutation.FailedAttempts(); !ok {
+		return &ValidationError{Name: "failed_attempts", err: errors.New(`ent: missing required field "Credential.failed_attempts"`)}
+	}
+	if _, ok := cc.mutation.TotpLastStep(); !ok {
+		return &ValidationError{Name: "totp_last_step", err: errors.New(`ent: missing required field "Credential.totp_last_step"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Credential.created_at"`)}
+	}
+	return nil
+}
+
+func (cc *CredentialCreate) sqlSave(ctx context.Context) (*Credential, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CredentialCreate) createSpec() (*Credential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Credential{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(credential.Table, sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt))
+	)
+	if value, ok := cc.mutation.UserID(); ok {
+		_spec.SetField(credential.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := cc.mutation.PasswordHash(); ok {
+		_spec.SetField(credential.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = value
+	}
+	if value, ok := cc.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(credential.FieldPasswordChangedAt, field.TypeTime, value)
+		_node.PasswordChangedAt = &value
+	}
+	if value, ok := cc.mutation.FailedAttempts(); ok {
+		_spec.SetField(credential.FieldFailedAttempts, field.TypeInt, value)
+		_node.FailedAttempts = value
+	}
+	if value, ok := cc.mutation.LockedUntil(); ok {
+		_spec.SetField(credential.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := cc.mutation.ResetTokenID(); ok {
+		_spec.SetField(credential.FieldResetTokenID, field.TypeString, value)
+		_node.ResetTokenID = &value
+	}
+	if value, ok := cc.mutation.TotpSecret(); ok {
+		_spec.SetField(credential.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = &value
+	}
+	if value, ok := cc.mutation.TotpConfirmedAt(); ok {
+		_spec.SetField(credential.FieldTotpConfirmedAt, field.TypeTime, value)
+		_node.TotpConfirmedAt = &value
+	}
+	if value, ok := cc.mutation.TotpLastStep(); ok {
+		_spec.SetField(credential.FieldTotpLastStep, field.TypeInt64, value)
+		_node.TotpLastStep = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(credential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// CredentialCreateBulk is the builder for creating many Credential entities in bulk.
+type CredentialCreateBulk struct {
+	config
+	err      error
+	builders []*CredentialCreate
+}
+
+// Save creates the Credential entities in the database.
+func (ccb *CredentialCreateBulk) Save(ctx context.Context) ([]*Credential, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Credential, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CredentialCreateBulk) SaveX(ctx context.Context) []*Credential {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/credential"
+	"user-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CredentialUpdate is the builder for updating Credential entities.
+type CredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CredentialMutation
+}
+
+// Where appends a list predicates to the CredentialUpdate builder.
+func (cu *CredentialUpdate) Where(ps ...predicate.Credential) *CredentialUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (cu *CredentialUpdate) SetPasswordHash(s string) *CredentialUpdate {
+	cu.mutation.SetPasswordHash(s)
+	return cu
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillablePasswordHash(s *string) *CredentialUpdate {
+	if s != nil {
+		cu.SetPasswordHash(*s)
+	}
+	return cu
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (cu *CredentialUpdate) ClearPasswordHash() *CredentialUpdate {
+	cu.mutation.ClearPasswordHash()
+	return cu
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (cu *CredentialUpdate) SetPasswordChangedAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetPasswordChangedAt(t)
+	return cu
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillablePasswordChangedAt(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetPasswordChangedAt(*t)
+	}
+	return cu
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (cu *CredentialUpdate) ClearPasswordChangedAt() *CredentialUpdate {
+	cu.mutation.ClearPasswordChangedAt()
+	return cu
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (cu *CredentialUpdate) SetFailedAttempts(i int) *CredentialUpdate {
+	cu.mutation.ResetFailedAttempts()
+	cu.mutation.SetFailedAttempts(i)
+	return cu
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableFailedAttempts(i *int) *CredentialUpdate {
+	if i != nil {
+		cu.SetFailedAttempts(*i)
+	}
+	return cu
+}
+
+// AddFailedAttempts adds i to the "failed_attempts" field.
+func (cu *CredentialUpdate) AddFailedAttempts(i int) *CredentialUpdate {
+	cu.mutation.AddFailedAttempts(i)
+	return cu
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (cu *CredentialUpdate) SetLockedUntil(t time.Time) *CredentialUpdate {
+	cu.mutation.SetLockedUntil(t)
+	return cu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableLockedUntil(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetLockedUntil(*t)
+	}
+	return cu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (cu *CredentialUpdate) ClearLockedUntil() *CredentialUpdate {
+	cu.mutation.ClearLockedUntil()
+	return cu
+}
+
+// SetResetTokenID sets the "reset_token_id" field.
+func (cu *CredentialUpdate) SetResetTokenID(s string) *CredentialUpdate {
+	cu.mutation.SetResetTokenID(s)
+	return cu
+}
+
+// SetNillableResetTokenID sets the "reset_token_id" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableResetTokenID(s *string) *CredentialUpdate {
+	if s != nil {
+		cu.SetResetTokenID(*s)
+	}
+	return cu
+}
+
+// ClearResetTokenID clears the value of the "reset_token_id" field.
+func (cu *CredentialUpdate) ClearResetTokenID() *CredentialUpdate {
+	cu.mutation.ClearResetTokenID()
+	return cu
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (cu *CredentialUpdate) SetTotpSecret(s string) *CredentialUpdate {
+	cu.mutation.SetTotpSecret(s)
+	return cu
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableTotpSecret(s *string) *CredentialUpdate {
+	if s != nil {
+		cu.SetTotpSecret(*s)
+	}
+	return cu
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (cu *CredentialUpdate) ClearTotpSecret() *CredentialUpdate {
+	cu.mutation.ClearTotpSecret()
+	return cu
+}
+
+// SetTotpConfirmedAt sets the "totp_confirmed_at" field.
+func (cu *CredentialUpdate) SetTotpConfirmedAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetTotpConfirmedAt(t)
+	return cu
+}
+
+// SetNillableTotpConfirmedAt sets the "totp_confirmed_at" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableTotpConfirmedAt(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetTotpConfirmedAt(*t)
+	}
+	return cu
+}
+
+// ClearTotpConfirmedAt clears the value of the "totp_confirmed_at" field.
+func (cu *CredentialUpdate) ClearTotpConfirmedAt() *CredentialUpdate {
+	cu.mutation.ClearTotpConfirmedAt()
+	return cu
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (cu *CredentialUpdate) SetTotpLastStep(i int64) *CredentialUpdate {
+	cu.mutation.ResetTotpLastStep()
+	cu.mutation.SetTotpLastStep(i)
+	return cu
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableTotpLastStep(i *int64) *CredentialUpdate {
+	if i != nil {
+		cu.SetTotpLastStep(*i)
+	}
+	return cu
+}
+
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (cu *CredentialUpdate) AddTotpLastStep(i int64) *CredentialUpdate {
+	cu.mutation.AddTotpLastStep(i)
+	return cu
+}
+
+// Mutation returns the CredentialMutation object of the builder.
+func (cu *CredentialUpdate) Mutation() *CredentialMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CredentialUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CredentialUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CredentialUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cu *CredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(credential.Table, credential.Columns, sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.PasswordHash(); ok {
+		_spec.SetField(credential.FieldPasswordHash, field.TypeString, value)
+	}
+	if cu.mutation.PasswordHashCleared() {
+		_spec.ClearField(credential.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := cu.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(credential.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if cu.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(credential.FieldPasswordChangedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.FailedAttempts(); ok {
+		_spec.SetField(credential.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedFailedAttempts(); ok {
+		_spec.AddField(credential.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.LockedUntil(); ok {
+		_spec.SetField(credential.FieldLockedUntil, field.TypeTime, value)
+	}
+	if cu.mutation.LockedUntilCleared() {
+		_spec.ClearField(credential.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := cu.mutation.ResetTokenID(); ok {
+		_spec.SetField(credential.FieldResetTokenID, field.TypeString, value)
+	}
+	if cu.mutation.ResetTokenIDCleared() {
+		_spec.ClearField(credential.FieldResetTokenID, field.TypeString)
+	}
+	if value, ok := cu.mutation.TotpSecret(); ok {
+		_spec.SetField(credential.FieldTotpSecret, field.TypeString, value)
+	}
+	if cu.mutation.TotpSecretCleared() {
+		_spec.ClearField(credential.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := cu.mutation.TotpConfirmedAt(); ok {
+		_spec.SetField(credential.FieldTotpConfirmedAt, field.TypeTime, value)
+	}
+	if cu.mutation.TotpConfirmedAtCleared() {
+		_spec.ClearField(credential.FieldTotpConfirmedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.TotpLastStep(); ok {
+		_spec.SetField(credential.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(credential.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CredentialUpdateOne is the builder for updating a single Credential entity.
+type CredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CredentialMutation
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (cuo *CredentialUpdateOne) SetPasswordHash(s string) *CredentialUpdateOne {
+	cuo.mutation.SetPasswordHash(s)
+	return cuo
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillablePasswordHash(s *string) *CredentialUpdateOne {
+	if s != nil {
+		cuo.SetPasswordHash(*s)
+	}
+	return cuo
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (cuo *CredentialUpdateOne) ClearPasswordHash() *CredentialUpdateOne {
+	cuo.mutation.ClearPasswordHash()
+	return cuo
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (cuo *CredentialUpdateOne) SetPasswordChangedAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetPasswordChangedAt(t)
+	return cuo
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillablePasswordChangedAt(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetPasswordChangedAt(*t)
+	}
+	return cuo
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (cuo *CredentialUpdateOne) ClearPasswordChangedAt() *CredentialUpdateOne {
+	cuo.mutation.ClearPasswordChangedAt()
+	return cuo
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (cuo *CredentialUpdateOne) SetFailedAttempts(i int) *CredentialUpdateOne {
+	cuo.mutation.ResetFailedAttempts()
+	cuo.mutation.SetFailedAttempts(i)
+	return cuo
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableFailedAttempts(i *int) *CredentialUpdateOne {
+	if i != nil {
+		cuo.SetFailedAttempts(*i)
+	}
+	return cuo
+}
+
+// AddFailedAttempts adds i to the "failed_attempts" field.
+func (cuo *CredentialUpdateOne) AddFailedAttempts(i int) *CredentialUpdateOne {
+	cuo.mutation.AddFailedAttempts(i)
+	return cuo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (cuo *CredentialUpdateOne) SetLockedUntil(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetLockedUntil(t)
+	return cuo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableLockedUntil(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetLockedUntil(*t)
+	}
+	return cuo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (cuo *CredentialUpdateOne) ClearLockedUntil() *CredentialUpdateOne {
+	cuo.mutation.ClearLockedUntil()
+	return cuo
+}
+
+// SetResetTokenID sets the "reset_token_id" field.
+func (cuo *CredentialUpdateOne) SetResetTokenID(s string) *CredentialUpdateOne {
+	cuo.mutation.SetResetTokenID(s)
+	return cuo
+}
+
+// SetNillableResetTokenID sets the "reset_token_id" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableResetTokenID(s *string) *CredentialUpdateOne {
+	if s != nil {
+		cuo.SetResetTokenID(*s)
+	}
+	return cuo
+}
+
+// ClearResetTokenID clears the value of the "reset_token_id" field.
+func (cuo *CredentialUpdateOne) ClearResetTokenID() *CredentialUpdateOne {
+	cuo.mutation.ClearResetTokenID()
+	return cuo
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (cuo *CredentialUpdateOne) SetTotpSecret(s string) *CredentialUpdateOne {
+	cuo.mutation.SetTotpSecret(s)
+	return cuo
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableTotpSecret(s *string) *CredentialUpdateOne {
+	if s != nil {
+		cuo.SetTotpSecret(*s)
+	}
+	return cuo
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (cuo *CredentialUpdateOne) ClearTotpSecret() *CredentialUpdateOne {
+	cuo.mutation.ClearTotpSecret()
+	return cuo
+}
+
+// SetTotpConfirmedAt sets the "totp_confirmed_at" field.
+func (cuo *CredentialUpdateOne) SetTotpConfirmedAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetTotpConfirmedAt(t)
+	return cuo
+}
+
+// SetNillableTotpConfirmedAt sets the "totp_confirmed_at" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableTotpConfirmedAt(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetTotpConfirmedAt(*t)
+	}
+	return cuo
+}
+
+// ClearTotpConfirmedAt clears the value of the "totp_confirmed_at" field.
+func (cuo *CredentialUpdateOne) ClearTotpConfirmedAt() *CredentialUpdateOne {
+	cuo.mutation.ClearTotpConfirmedAt()
+	return cuo
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (cuo *CredentialUpdateOne) SetTotpLastStep(i int64) *CredentialUpdateOne {
+	cuo.mutation.ResetTotpLastStep()
+	cuo.mutation.SetTotpLastStep(i)
+	return cuo
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableTotpLastStep(i *int64) *CredentialUpdateOne {
+	if i != nil {
+		cuo.SetTotpLastStep(*i)
+	}
+	return cuo
+}
+
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (cuo *CredentialUpdateOne) AddTotpLastStep(i int64) *CredentialUpdateOne {
+	cuo.mutation.AddTotpLastStep(i)
+	return cuo
+}
+
+// Mutation returns the CredentialMutation object of the builder.
+func (cuo *CredentialUpdateOne) Mutation() *CredentialMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CredentialUpdate builder.
+func (cuo *CredentialUpdateOne) Where(ps ...predicate.Credential) *CredentialUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CredentialUpdateOne) Select(field string, fields ...string) *CredentialUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Credential entity.
+func (cuo *CredentialUpdateOne) Save(ctx context.Context) (*Credential, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CredentialUpdateOne) SaveX(ctx context.Context) *Credential {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cuo *CredentialUpdateOne) sqlSave(ctx context.Context) (_node *Credential, err error) {
+	_spec := sqlgraph.NewUpdateSpec(credential.Table, credential.Columns, sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Credential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credential.FieldID)
+		for _, f := range fields {
+			if !credential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != credential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.PasswordHash(); ok {
+		_spec.SetField(credential.FieldPasswordHash, field.TypeString, value)
+	}
+	if cuo.mutation.PasswordHashCleared() {
+		_spec.ClearField(credential.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := cuo.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(credential.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(credential.FieldPasswordChangedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.FailedAttempts(); ok {
+		_spec.SetField(credential.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedFailedAttempts(); ok {
+		_spec.AddField(credential.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.LockedUntil(); ok {
+		_spec.SetField(credential.FieldLockedUntil, field.TypeTime, value)
+	}
+	if cuo.mutation.LockedUntilCleared() {
+		_spec.ClearField(credential.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.ResetTokenID(); ok {
+		_spec.SetField(credential.FieldResetTokenID, field.TypeString, value)
+	}
+	if cuo.mutation.ResetTokenIDCleared() {
+		_spec.ClearField(credential.FieldResetTokenID, field.TypeString)
+	}
+	if value, ok := cuo.mutation.TotpSecret(); ok {
+		_spec.SetField(credential.FieldTotpSecret, field.TypeString, value)
+	}
+	if cuo.mutation.TotpSecretCleared() {
+		_spec.ClearField(credential.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := cuo.mutation.TotpConfirmedAt(); ok {
+		_spec.SetField(credential.FieldTotpConfirmedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.TotpConfirmedAtCleared() {
+		_spec.ClearField(credential.FieldTotpConfirmedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.TotpLastStep(); ok {
+		_spec.SetField(credential.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(credential.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	_node = &Credential{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"user-service/ent/datarequest"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DataRequest is the model entity for the DataRequest schema.
+type DataRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind datarequest.Kind `json:"kind,omitempty"`
+	// Status holds the value of the "status" field.
+	Status datarequest.Status `json:"status,omitempty"`
+	// RequestedBy holds the value of the "requested_by" field.
+	RequestedBy string `json:"requested_by,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// Archive holds the value of the "archive" field.
+	Archive *[]byte `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DataRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case datarequest.FieldArchive:
+			values[i] = new([]byte)
+		case datarequest.FieldUserID, datarequest.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case datarequest.FieldKind, datarequest.FieldStatus, datarequest.FieldRequestedBy, datarequest.FieldError:
+			values[i] = new(sql.NullString)
+		case datarequest.FieldExpiresAt, datarequest.FieldCreatedAt, datarequest.FieldStartedAt, datarequest.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		case datarequest.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DataRequest fields.
+func (dr *DataRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case datarequest.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				dr.ID = *value
+			}
+		case datarequest.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				dr.UserID = int(value.Int64)
+			}
+		case datarequest.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				dr.Kind = datarequest.Kind(value.String)
+			}
+		case datarequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				dr.Status = datarequest.Status(value.String)
+			}
+		case datarequest.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value.Valid {
+				dr.RequestedBy = value.String
+			}
+		case datarequest.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				dr.Attempts = int(value.Int64)
+			}
+		case datarequest.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				dr.Error = value.String
+			}
+		case datarequest.FieldArchive:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field archive", values[i])
+			} else if value != nil {
+				dr.Archive = value
+			}
+		case datarequest.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				dr.ExpiresAt = new(time.Time)
+				*dr.ExpiresAt = value.Time
+			}
+		case datarequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dr.CreatedAt = value.Time
+			}
+		case datarequest.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				dr.StartedAt = new(time.Time)
+				*dr.StartedAt = value.Time
+			}
+		case datarequest.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				dr.CompletedAt = new(time.Time)
+				*dr.CompletedAt = value.Time
+			}
+		default:
+			dr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DataRequest.
+// This includes values selected through modifiers, order, etc.
+func (dr *DataRequest) Value(name string) (ent.Value, error) {
+	return dr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DataRequest.
+// Note that you need to call DataRequest.Unwrap() before calling this method if this DataRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dr *DataRequest) Update() *DataRequestUpdateOne {
+	return NewDataRequestClient(dr.config).UpdateOne(dr)
+}
+
+// Unwrap unwraps the DataRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dr *DataRequest) Unwrap() *DataRequest {
+	_tx, ok := dr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DataRequest is not a transactional entity")
+	}
+	dr.config.driver = _tx.drv
+	return dr
+}
+
+// String implements the fmt.Stringer.
+func (dr *DataRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("DataRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dr.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", dr.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", dr.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", dr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("requested_by=")
+	builder.WriteString(dr.RequestedBy)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", dr.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(dr.Error)
+	builder.WriteString(", ")
+	builder.WriteString("archive=<sensitive>")
+	builder.WriteString(", ")
+	if v := dr.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(dr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := dr.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := dr.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DataRequests is a parsable slice of DataRequest.
+type DataRequests []*DataRequest
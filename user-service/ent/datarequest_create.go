@@ -0,0 +1,407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/datarequest"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DataRequestCreate is the builder for creating a DataRequest entity.
+type DataRequestCreate struct {
+	config
+	mutation *DataRequestMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (drc *DataRequestCreate) SetUserID(i int) *DataRequestCreate {
+	drc.mutation.SetUserID(i)
+	return drc
+}
+
+// SetKind sets the "kind" field.
+func (drc *DataRequestCreate) SetKind(d datarequest.Kind) *DataRequestCreate {
+	drc.mutation.SetKind(d)
+	return drc
+}
+
+// SetStatus sets the "status" field.
+func (drc *DataRequestCreate) SetStatus(d datarequest.Status) *DataRequestCreate {
+	drc.mutation.SetStatus(d)
+	return drc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (drc *DataRequestCreate) SetNillableStatus(d *datarequest.Status) *DataRequestCreate {
+	if d != nil {
+		drc.SetStatus(*d)
+	}
+	return drc
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (drc *DataRequestCreate) SetRequestedBy(s string) *DataRequestCreate {
+	drc.mutation.SetRequestedBy(s)
+	return drc
+}
+
+// SetAttempts sets the "attempts" field.
+func (drc *DataRequestCreate) SetAttempts(i int) *DataRequestCreate {
+	drc.mutation.SetAttempts(i)
+	return drc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (drc *DataRequestCreate) SetNillableAttempts(i *int) *DataRequestCreate {
+	if i != nil {
+		drc.SetAttempts(*i)
+	}
+	return drc
+}
+
+// SetError sets the "error" field.
+func (drc *DataRequestCreate) SetError(s string) *DataRequestCreate {
+	drc.mutation.SetError(s)
+	return drc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (drc *DataRequestCreate) SetNillableError(s *string) *DataRequestCreate {
+	if s != nil {
+		drc.SetError(*s)
+	}
+	return drc
+}
+
+// SetArchive sets the "archive" field.
+func (drc *DataRequestCreate) SetArchive(b []byte) *DataRequestCreate {
+	drc.mutation.SetArchive(b)
+	return drc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (drc *DataRequestCreate) SetExpiresAt(t time.Time) *DataRequestCreate {
+	drc.mutation.SetExpiresAt(t)
+	return drc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (drc *DataRequestCreate) SetNillableExpiresAt(t *time.Time) *DataRequestCreate {
+	if t != nil {
+		drc.SetExpiresAt(*t)
+	}
+	return drc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (drc *DataRequestCreate) SetCreatedAt(t time.Time) *DataRequestCreate {
+	drc.mutation.SetCreatedAt(t)
+	return drc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (drc *DataRequestCreate) SetNillableCreatedAt(t *time.Time) *DataRequestCreate {
+	if t != nil {
+		drc.SetCreatedAt(*t)
+	}
+	return drc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (drc *DataRequestCreate) SetStartedAt(t time.Time) *DataRequestCreate {
+	drc.mutation.SetStartedAt(t)
+	return drc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (drc *DataRequestCreate) SetNillableStartedAt(t *time.Time) *DataRequestCreate {
+	if t != nil {
+		drc.SetStartedAt(*t)
+	}
+	return drc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (drc *DataRequestCreate) SetCompletedAt(t time.Time) *DataRequestCreate {
+	drc.mutation.SetCompletedAt(t)
+	return drc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (drc *DataRequestCreate) SetNillableCompletedAt(t *time.Time) *DataRequestCreate {
+	if t != nil {
+		drc.SetCompletedAt(*t)
+	}
+	return drc
+}
+
+// SetID sets the "id" field.
+func (drc *DataRequestCreate) SetID(u uuid.UUID) *DataRequestCreate {
+	drc.mutation.SetID(u)
+	return drc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (drc *DataRequestCreate) SetNillableID(u *uuid.UUID) *DataRequestCreate {
+	if u != nil {
+		drc.SetID(*u)
+	}
+	return drc
+}
+
+// Mutation returns the DataRequestMutation object of the builder.
+func (drc *DataRequestCreate) Mutation() *DataRequestMutation {
+	return drc.mutation
+}
+
+// Save creates the DataRequest in the database.
+func (drc *DataRequestCreate) Save(ctx context.Context) (*DataRequest, error) {
+	drc.defaults()
+	return withHooks(ctx, drc.sqlSave, drc.mutation, drc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (drc *DataRequestCreate) SaveX(ctx context.Context) *DataRequest {
+	v, err := drc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (drc *DataRequestCreate) Exec(ctx context.Context) error {
+	_, err := drc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (drc *DataRequestCreate) ExecX(ctx context.Context) {
+	if err := drc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (drc *DataRequestCreate) defaults() {
+	if _, ok := drc.mutation.Status(); !ok {
+		v := datarequest.DefaultStatus
+		drc.mutation.SetStatus(v)
+	}
+	if _, ok := drc.mutation.Attempts(); !ok {
+		v := datarequest.DefaultAttempts
+		drc.mutation.SetAttempts(v)
+	}
+	if _, ok := drc.mutation.CreatedAt(); !ok {
+		v := datarequest.DefaultCreatedAt()
+		drc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := drc.mutation.ID(); !ok {
+		v := datarequest.DefaultID()
+		drc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (drc *DataRequestCreate) check() error {
+	if _, ok := drc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "DataRequest.user_id"`)}
+	}
+	if _, ok := drc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "DataRequest.kind"`)}
+	}
+	if v, ok := drc.mutation.Kind(); ok {
+		if err := datarequest.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "DataRequest.kind": %w`, err)}
+		}
+	}
+	if _, ok := drc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DataRequest.status"`)}
+	}
+	if v, ok := drc.mutation.Status(); ok {
+		if err := datarequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := drc.mutation.RequestedBy(); !ok {
+		return &ValidationError{Name: "requested_by", err: errors.New(`ent: missing required field "DataRequest.requested_by"`)}
+	}
+	if _, ok := drc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "DataRequest.attempts"`)}
+	}
+	if _, ok := drc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DataRequest.created_at"`)}
+	}
+	return nil
+}
+
+func (drc *DataRequestCreate) sqlSave(ctx context.Context) (*DataRequest, error) {
+	if err := drc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := drc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, drc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	drc.mutation.id = &_node.ID
+	drc.mutation.done = true
+	return _node, nil
+}
+
+func (drc *DataRequestCreate) createSpec() (*DataRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataRequest{config: drc.config}
+		_spec = sqlgraph.NewCreateSpec(datarequest.Table, sqlgraph.NewFieldSpec(datarequest.FieldID, field.TypeUUID))
+	)
+	if id, ok := drc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := drc.mutation.UserID(); ok {
+		_spec.SetField(datarequest.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := drc.mutation.Kind(); ok {
+		_spec.SetField(datarequest.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := drc.mutation.Status(); ok {
+		_spec.SetField(datarequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := drc.mutation.RequestedBy(); ok {
+		_spec.SetField(datarequest.FieldRequestedBy, field.TypeString, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := drc.mutation.Attempts(); ok {
+		_spec.SetField(datarequest.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := drc.mutation.Error(); ok {
+		_spec.SetField(datarequest.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := drc.mutation.Archive(); ok {
+		_spec.SetField(datarequest.FieldArchive, field.TypeBytes, value)
+		_node.Archive = &value
+	}
+	if value, ok := drc.mutation.ExpiresAt(); ok {
+		_spec.SetField(datarequest.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := drc.mutation.CreatedAt(); ok {
+		_spec.SetField(datarequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := drc.mutation.StartedAt(); ok {
+		_spec.SetField(datarequest.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := drc.mutation.CompletedAt(); ok {
+		_spec.SetField(datarequest.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// DataRequestCreateBulk is the builder for creating many DataRequest entities in bulk.
+type DataRequestCreateBulk struct {
+	config
+	err      error
+	builders []*DataRequestCreate
+}
+
+// Save creates the DataRequest entities in the database.
+func (drcb *DataRequestCreateBulk) Save(ctx context.Context) ([]*DataRequest, error) {
+	if drcb.err != nil {
+		return nil, drcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(drcb.builders))
+	nodes := make([]*DataRequest, len(drcb.builders))
+	mutators := make([]Mutator, len(drcb.builders))
+	for i := range drcb.builders {
+		func(i int, root context.Context) {
+			builder := drcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, drcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, drcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, drcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (drcb *DataRequestCreateBulk) SaveX(ctx context.Context) []*DataRequest {
+	v, err := drcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (drcb *DataRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := drcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (drcb *DataRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := drcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
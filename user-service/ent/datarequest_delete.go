@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"user-service/ent/datarequest"
+	"user-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DataRequestDelete is the builder for deleting a DataRequest entity.
+type DataRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *DataRequestMutation
+}
+
+// Where appends a list predicates to the DataRequestDelete builder.
+func (drd *DataRequestDelete) Where(ps ...predicate.DataRequest) *DataRequestDelete {
+	drd.mutation.Where(ps...)
+	return drd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (drd *DataRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, drd.sqlExec, drd.mutation, drd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (drd *DataRequestDelete) ExecX(ctx context.Context) int {
+	n, err := drd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (drd *DataRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(datarequest.Table, sqlgraph.NewFieldSpec(datarequest.FieldID, field.TypeUUID))
+	if ps := drd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, drd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	drd.mutation.done = true
+	return affected, err
+}
+
+// DataRequestDeleteOne is the builder for deleting a single DataRequest entity.
+type DataRequestDeleteOne struct {
+	drd *DataRequestDelete
+}
+
+// Where appends a list predicates to the DataRequestDelete builder.
+func (drdo *DataRequestDeleteOne) Where(ps ...predicate.DataRequest) *DataRequestDeleteOne {
+	drdo.drd.mutation.Where(ps...)
+	return drdo
+}
+
+// Exec executes the deletion query.
+func (drdo *DataRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := drdo.drd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{datarequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (drdo *DataRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := drdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,534 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/datarequest"
+	"user-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DataRequestUpdate is the builder for updating DataRequest entities.
+type DataRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DataRequestMutation
+}
+
+// Where appends a list predicates to the DataRequestUpdate builder.
+func (dru *DataRequestUpdate) Where(ps ...predicate.DataRequest) *DataRequestUpdate {
+	dru.mutation.Where(ps...)
+	return dru
+}
+
+// SetStatus sets the "status" field.
+func (dru *DataRequestUpdate) SetStatus(d datarequest.Status) *DataRequestUpdate {
+	dru.mutation.SetStatus(d)
+	return dru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dru *DataRequestUpdate) SetNillableStatus(d *datarequest.Status) *DataRequestUpdate {
+	if d != nil {
+		dru.SetStatus(*d)
+	}
+	return dru
+}
+
+// SetAttempts sets the "attempts" field.
+func (dru *DataRequestUpdate) SetAttempts(i int) *DataRequestUpdate {
+	dru.mutation.ResetAttempts()
+	dru.mutation.SetAttempts(i)
+	return dru
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (dru *DataRequestUpdate) SetNillableAttempts(i *int) *DataRequestUpdate {
+	if i != nil {
+		dru.SetAttempts(*i)
+	}
+	return dru
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (dru *DataRequestUpdate) AddAttempts(i int) *DataRequestUpdate {
+	dru.mutation.AddAttempts(i)
+	return dru
+}
+
+// SetError sets the "error" field.
+func (dru *DataRequestUpdate) SetError(s string) *DataRequestUpdate {
+	dru.mutation.SetError(s)
+	return dru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dru *DataRequestUpdate) SetNillableError(s *string) *DataRequestUpdate {
+	if s != nil {
+		dru.SetError(*s)
+	}
+	return dru
+}
+
+// ClearError clears the value of the "error" field.
+func (dru *DataRequestUpdate) ClearError() *DataRequestUpdate {
+	dru.mutation.ClearError()
+	return dru
+}
+
+// SetArchive sets the "archive" field.
+func (dru *DataRequestUpdate) SetArchive(b []byte) *DataRequestUpdate {
+	dru.mutation.SetArchive(b)
+	return dru
+}
+
+// ClearArchive clears the value of the "archive" field.
+func (dru *DataRequestUpdate) ClearArchive() *DataRequestUpdate {
+	dru.mutation.ClearArchive()
+	return dru
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (dru *DataRequestUpdate) SetExpiresAt(t time.Time) *DataRequestUpdate {
+	dru.mutation.SetExpiresAt(t)
+	return dru
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (dru *DataRequestUpdate) SetNillableExpiresAt(t *time.Time) *DataRequestUpdate {
+	if t != nil {
+		dru.SetExpiresAt(*t)
+	}
+	return dru
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (dru *DataRequestUpdate) ClearExpiresAt() *DataRequestUpdate {
+	dru.mutation.ClearExpiresAt()
+	return dru
+}
+
+// SetStartedAt sets the "started_at" field.
+func (dru *DataRequestUpdate) SetStartedAt(t time.Time) *DataRequestUpdate {
+	dru.mutation.SetStartedAt(t)
+	return dru
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (dru *DataRequestUpdate) SetNillableStartedAt(t *time.Time) *DataRequestUpdate {
+	if t != nil {
+		dru.SetStartedAt(*t)
+	}
+	return dru
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (dru *DataRequestUpdate) ClearStartedAt() *DataRequestUpdate {
+	dru.mutation.ClearStartedAt()
+	return dru
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (dru *DataRequestUpdate) SetCompletedAt(t time.Time) *DataRequestUpdate {
+	dru.mutation.SetCompletedAt(t)
+	return dru
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (dru *DataRequestUpdate) SetNillableCompletedAt(t *time.Time) *DataRequestUpdate {
+	if t != nil {
+		dru.SetCompletedAt(*t)
+	}
+	return dru
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (dru *DataRequestUpdate) ClearCompletedAt() *DataRequestUpdate {
+	dru.mutation.ClearCompletedAt()
+	return dru
+}
+
+// Mutation returns the DataRequestMutation object of the builder.
+func (dru *DataRequestUpdate) Mutation() *DataRequestMutation {
+	return dru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dru *DataRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, dru.sqlSave, dru.mutation, dru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dru *DataRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := dru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dru *DataRequestUpdate) Exec(ctx context.Context) error {
+	_, err := dru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dru *DataRequestUpdate) ExecX(ctx context.Context) {
+	if err := dru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dru *DataRequestUpdate) check() error {
+	if v, ok := dru.mutation.Status(); ok {
+		if err := datarequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dru *DataRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(datarequest.Table, datarequest.Columns, sqlgraph.NewFieldSpec(datarequest.FieldID, field.TypeUUID))
+	if ps := dru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dru.mutation.Status(); ok {
+		_spec.SetField(datarequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dru.mutation.Attempts(); ok {
+		_spec.SetField(datarequest.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedAttempts(); ok {
+		_spec.AddField(datarequest.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.Error(); ok {
+		_spec.SetField(datarequest.FieldError, field.TypeString, value)
+	}
+	if dru.mutation.ErrorCleared() {
+		_spec.ClearField(datarequest.FieldError, field.TypeString)
+	}
+	if value, ok := dru.mutation.Archive(); ok {
+		_spec.SetField(datarequest.FieldArchive, field.TypeBytes, value)
+	}
+	if dru.mutation.ArchiveCleared() {
+		_spec.ClearField(datarequest.FieldArchive, field.TypeBytes)
+	}
+	if value, ok := dru.mutation.ExpiresAt(); ok {
+		_spec.SetField(datarequest.FieldExpiresAt, field.TypeTime, value)
+	}
+	if dru.mutation.ExpiresAtCleared() {
+		_spec.ClearField(datarequest.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := dru.mutation.StartedAt(); ok {
+		_spec.SetField(datarequest.FieldStartedAt, field.TypeTime, value)
+	}
+	if dru.mutation.StartedAtCleared() {
+		_spec.ClearField(datarequest.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := dru.mutation.CompletedAt(); ok {
+		_spec.SetField(datarequest.FieldCompletedAt, field.TypeTime, value)
+	}
+	if dru.mutation.CompletedAtCleared() {
+		_spec.ClearField(datarequest.FieldCompletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datarequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dru.mutation.done = true
+	return n, nil
+}
+
+// DataRequestUpdateOne is the builder for updating a single DataRequest entity.
+type DataRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DataRequestMutation
+}
+
+// SetStatus sets the "status" field.
+func (druo *DataRequestUpdateOne) SetStatus(d datarequest.Status) *DataRequestUpdateOne {
+	druo.mutation.SetStatus(d)
+	return druo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (druo *DataRequestUpdateOne) SetNillableStatus(d *datarequest.Status) *DataRequestUpdateOne {
+	if d != nil {
+		druo.SetStatus(*d)
+	}
+	return druo
+}
+
+// SetAttempts sets the "attempts" field.
+func (druo *DataRequestUpdateOne) SetAttempts(i int) *DataRequestUpdateOne {
+	druo.mutation.ResetAttempts()
+	druo.mutation.SetAttempts(i)
+	return druo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (druo *DataRequestUpdateOne) SetNillableAttempts(i *int) *DataRequestUpdateOne {
+	if i != nil {
+		druo.SetAttempts(*i)
+	}
+	return druo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (druo *DataRequestUpdateOne) AddAttempts(i int) *DataRequestUpdateOne {
+	druo.mutation.AddAttempts(i)
+	return druo
+}
+
+// SetError sets the "error" field.
+func (druo *DataRequestUpdateOne) SetError(s string) *DataRequestUpdateOne {
+	druo.mutation.SetError(s)
+	return druo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (druo *DataRequestUpdateOne) SetNillableError(s *string) *DataRequestUpdateOne {
+	if s != nil {
+		druo.SetError(*s)
+	}
+	return druo
+}
+
+// ClearError clears the value of the "error" field.
+func (druo *DataRequestUpdateOne) ClearError() *DataRequestUpdateOne {
+	druo.mutation.ClearError()
+	return druo
+}
+
+// SetArchive sets the "archive" field.
+func (druo *DataRequestUpdateOne) SetArchive(b []byte) *DataRequestUpdateOne {
+	druo.mutation.SetArchive(b)
+	return druo
+}
+
+// ClearArchive clears the value of the "archive" field.
+func (druo *DataRequestUpdateOne) ClearArchive() *DataRequestUpdateOne {
+	druo.mutation.ClearArchive()
+	return druo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (druo *DataRequestUpdateOne) SetExpiresAt(t time.Time) *DataRequestUpdateOne {
+	druo.mutation.SetExpiresAt(t)
+	return druo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (druo *DataRequestUpdateOne) SetNillableExpiresAt(t *time.Time) *DataRequestUpdateOne {
+	if t != nil {
+		druo.SetExpiresAt(*t)
+	}
+	return druo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (druo *DataRequestUpdateOne) ClearExpiresAt() *DataRequestUpdateOne {
+	druo.mutation.ClearExpiresAt()
+	return druo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (druo *DataRequestUpdateOne) SetStartedAt(t time.Time) *DataRequestUpdateOne {
+	druo.mutation.SetStartedAt(t)
+	return druo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (druo *DataRequestUpdateOne) SetNillableStartedAt(t *time.Time) *DataRequestUpdateOne {
+	if t != nil {
+		druo.SetStartedAt(*t)
+	}
+	return druo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (druo *DataRequestUpdateOne) ClearStartedAt() *DataRequestUpdateOne {
+	druo.mutation.ClearStartedAt()
+	return druo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (druo *DataRequestUpdateOne) SetCompletedAt(t time.Time) *DataRequestUpdateOne {
+	druo.mutation.SetCompletedAt(t)
+	return druo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (druo *DataRequestUpdateOne) SetNillableCompletedAt(t *time.Time) *DataRequestUpdateOne {
+	if t != nil {
+		druo.SetCompletedAt(*t)
+	}
+	return druo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (druo *DataRequestUpdateOne) ClearCompletedAt() *DataRequestUpdateOne {
+	druo.mutation.ClearCompletedAt()
+	return druo
+}
+
+// Mutation returns the DataRequestMutation object of the builder.
+func (druo *DataRequestUpdateOne) Mutation() *DataRequestMutation {
+	return druo.mutation
+}
+
+// Where appends a list predicates to the DataRequestUpdate builder.
+func (druo *DataRequestUpdateOne) Where(ps ...predicate.DataRequest) *DataRequestUpdateOne {
+	druo.mutation.Where(ps...)
+	return druo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (druo *DataRequestUpdateOne) Select(field string, fields ...string) *DataRequestUpdateOne {
+	druo.fields = append([]string{field}, fields...)
+	return druo
+}
+
+// Save executes the query and returns the updated DataRequest entity.
+func (druo *DataRequestUpdateOne) Save(ctx context.Context) (*DataRequest, error) {
+	return withHooks(ctx, druo.sqlSave, druo.mutation, druo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (druo *DataRequestUpdateOne) SaveX(ctx context.Context) *DataRequest {
+	node, err := druo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (druo *DataRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := druo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (druo *DataRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := druo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (druo *DataRequestUpdateOne) check() error {
+	if v, ok := druo.mutation.Status(); ok {
+		if err := datarequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (druo *DataRequestUpdateOne) sqlSave(ctx context.Context) (_node *DataRequest, err error) {
+	if err := druo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(datarequest.Table, datarequest.Columns, sqlgraph.NewFieldSpec(datarequest.FieldID, field.TypeUUID))
+	id, ok := druo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DataRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := druo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, datarequest.FieldID)
+		for _, f := range fields {
+			if !datarequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != datarequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := druo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := druo.mutation.Status(); ok {
+		_spec.SetField(datarequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := druo.mutation.Attempts(); ok {
+		_spec.SetField(datarequest.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedAttempts(); ok {
+		_spec.AddField(datarequest.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.Error(); ok {
+		_spec.SetField(datarequest.FieldError, field.TypeString, value)
+	}
+	if druo.mutation.ErrorCleared() {
+		_spec.ClearField(datarequest.FieldError, field.TypeString)
+	}
+	if value, ok := druo.mutation.Archive(); ok {
+		_spec.SetField(datarequest.FieldArchive, field.TypeBytes, value)
+	}
+	if druo.mutation.ArchiveCleared() {
+		_spec.ClearField(datarequest.FieldArchive, field.TypeBytes)
+	}
+	if value, ok := druo.mutation.ExpiresAt(); ok {
+		_spec.SetField(datarequest.FieldExpiresAt, field.TypeTime, value)
+	}
+	if druo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(datarequest.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := druo.mutation.StartedAt(); ok {
+		_spec.SetField(datarequest.FieldStartedAt, field.TypeTime, value)
+	}
+	if druo.mutation.StartedAtCleared() {
+		_spec.ClearField(datarequest.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := druo.mutation.CompletedAt(); ok {
+		_spec.SetField(datarequest.FieldCompletedAt, field.TypeTime, value)
+	}
+	if druo.mutation.CompletedAtCleared() {
+		_spec.ClearField(datarequest.FieldCompletedAt, field.TypeTime)
+	}
+	_node = &DataRequest{config: druo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, druo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datarequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	druo.mutation.done = true
+	return _node, nil
+}
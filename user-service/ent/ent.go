@@ -8,6 +8,20 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"user-service/ent/alias"
+	"user-service/ent/apikey"
+	"user-service/ent/auditlog"
+	"user-service/ent/balanceprojection"
+	"user-service/ent/credential"
+	"user-service/ent/datarequest"
+	"user-service/ent/profilechange"
+	"user-service/ent/ratelimitstate"
+	"user-service/ent/recoverycode"
+	"user-service/ent/referral"
+	"user-service/ent/referralcode"
+	"user-service/ent/refreshtoken"
+	"user-service/ent/session"
+	"user-service/ent/signingkey"
 	"user-service/ent/user"
 
 	"entgo.io/ent"
@@ -73,7 +87,21 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			user.Table: user.ValidColumn,
+			apikey.Table:            apikey.ValidColumn,
+			alias.Table:             alias.ValidColumn,
+			auditlog.Table:          auditlog.ValidColumn,
+			balanceprojection.Table: balanceprojection.ValidColumn,
+			credential.Table:        credential.ValidColumn,
+			datarequest.Table:       datarequest.ValidColumn,
+			profilechange.Table:     profilechange.ValidColumn,
+			ratelimitstate.Table:    ratelimitstate.ValidColumn,
+			recoverycode.Table:      recoverycode.ValidColumn,
+			referral.Table:          referral.ValidColumn,
+			referralcode.Table:      referralcode.ValidColumn,
+			refreshtoken.Table:      refreshtoken.ValidColumn,
+			session.Table:           session.ValidColumn,
+			signingkey.Table:        signingkey.ValidColumn,
+			user.Table:              user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"user-service/ent/profilechange"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProfileChange is the model entity for the ProfileChange schema.
+type ProfileChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Field holds the value of the "field" field.
+	Field string `json:"field,omitempty"`
+	// OldValue holds the value of the "old_value" field.
+	OldValue string `json:"old_value,omitempty"`
+	// NewValue holds the value of the "new_value" field.
+	NewValue string `json:"new_value,omitempty"`
+	// ChangedBy holds the value of the "changed_by" field.
+	ChangedBy string `json:"changed_by,omitempty"`
+	// ChangedAt holds the value of the "changed_at" field.
+	ChangedAt    time.Time `json:"changed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProfileChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case profilechange.FieldID, profilechange.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case profilechange.FieldField, profilechange.FieldOldValue, profilechange.FieldNewValue, profilechange.FieldChangedBy:
+			values[i] = new(sql.NullString)
+		case profilechange.FieldChangedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProfileChange fields.
+func (pc *ProfileChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case profilechange.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pc.ID = int(value.Int64)
+		case profilechange.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pc.UserID = int(value.Int64)
+			}
+		case profilechange.FieldField:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field field", values[i])
+			} else if value.Valid {
+				pc.Field = value.String
+			}
+		case profilechange.FieldOldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_value", values[i])
+			} else if value.Valid {
+				pc.OldValue = value.String
+			}
+		case profilechange.FieldNewValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_value", values[i])
+			} else if value.Valid {
+				pc.NewValue = value.String
+			}
+		case profilechange.FieldChangedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by", values[i])
+			} else if value.Valid {
+				pc.ChangedBy = value.String
+			}
+		case profilechange.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				pc.ChangedAt = value.Time
+			}
+		default:
+			pc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProfileChange.
+// This includes values selected through modifiers, order, etc.
+func (pc *ProfileChange) Value(name string) (ent.Value, error) {
+	return pc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProfileChange.
+// Note that you need to call ProfileChange.Unwrap() before calling this method if this ProfileChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pc *ProfileChange) Update() *ProfileChangeUpdateOne {
+	return NewProfileChangeClient(pc.config).UpdateOne(pc)
+}
+
+// Unwrap unwraps the ProfileChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pc *ProfileChange) Unwrap() *ProfileChange {
+	_tx, ok := pc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProfileChange is not a transactional entity")
+	}
+	pc.config.driver = _tx.drv
+	return pc
+}
+
+// String implements the fmt.Stringer.
+func (pc *ProfileChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProfileChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pc.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", pc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("field=")
+	builder.WriteString(pc.Field)
+	builder.WriteString(", ")
+	builder.WriteString("old_value=")
+	builder.WriteString(pc.OldValue)
+	builder.WriteString(", ")
+	builder.WriteString("new_value=")
+	builder.WriteString(pc.NewValue)
+	builder.WriteString(", ")
+	builder.WriteString("changed_by=")
+	builder.WriteString(pc.ChangedBy)
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(pc.ChangedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProfileChanges is a parsable slice of ProfileChange.
+type ProfileChanges []*ProfileChange
@@ -0,0 +1,460 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profilechange
+
+import (
+	"time"
+	"user-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldUserID, v))
+}
+
+// Field applies equality check predicate on the "field" field. It's identical to FieldEQ.
+func Field(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldField, v))
+}
+
+// OldValue applies equality check predicate on the "old_value" field. It's identical to OldValueEQ.
+func OldValue(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldOldValue, v))
+}
+
+// NewValue applies equality check predicate on the "new_value" field. It's identical to NewValueEQ.
+func NewValue(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldNewValue, v))
+}
+
+// ChangedBy applies equality check predicate on the "changed_by" field. It's identical to ChangedByEQ.
+func ChangedBy(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// ChangedAt applies equality check predicate on the "changed_at" field. It's identical to ChangedAtEQ.
+func ChangedAt(v time.Time) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLTE(FieldUserID, v))
+}
+
+// FieldEQ applies the EQ predicate on the "field" field.
+func FieldEQ(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldField, v))
+}
+
+// FieldNEQ applies the NEQ predicate on the "field" field.
+func FieldNEQ(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNEQ(FieldField, v))
+}
+
+// FieldIn applies the In predicate on the "field" field.
+func FieldIn(vs ...string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldIn(FieldField, vs...))
+}
+
+// FieldNotIn applies the NotIn predicate on the "field" field.
+func FieldNotIn(vs ...string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNotIn(FieldField, vs...))
+}
+
+// FieldGT applies the GT predicate on the "field" field.
+func FieldGT(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGT(FieldField, v))
+}
+
+// FieldGTE applies the GTE predicate on the "field" field.
+func FieldGTE(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGTE(FieldField, v))
+}
+
+// FieldLT applies the LT predicate on the "field" field.
+func FieldLT(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLT(FieldField, v))
+}
+
+// FieldLTE applies the LTE predicate on the "field" field.
+func FieldLTE(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLTE(FieldField, v))
+}
+
+// FieldContains applies the Contains predicate on the "field" field.
+func FieldContains(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldContains(FieldField, v))
+}
+
+// FieldHasPrefix applies the HasPrefix predicate on the "field" field.
+func FieldHasPrefix(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldHasPrefix(FieldField, v))
+}
+
+// FieldHasSuffix applies the HasSuffix predicate on the "field" field.
+func FieldHasSuffix(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldHasSuffix(FieldField, v))
+}
+
+// FieldEqualFold applies the EqualFold predicate on the "field" field.
+func FieldEqualFold(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEqualFold(FieldField, v))
+}
+
+// FieldContainsFold applies the ContainsFold predicate on the "field" field.
+func FieldContainsFold(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldContainsFold(FieldField, v))
+}
+
+// OldValueEQ applies the EQ predicate on the "old_value" field.
+func OldValueEQ(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldOldValue, v))
+}
+
+// OldValueNEQ applies the NEQ predicate on the "old_value" field.
+func OldValueNEQ(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNEQ(FieldOldValue, v))
+}
+
+// OldValueIn applies the In predicate on the "old_value" field.
+func OldValueIn(vs ...string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldIn(FieldOldValue, vs...))
+}
+
+// OldValueNotIn applies the NotIn predicate on the "old_value" field.
+func OldValueNotIn(vs ...string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNotIn(FieldOldValue, vs...))
+}
+
+// OldValueGT applies the GT predicate on the "old_value" field.
+func OldValueGT(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGT(FieldOldValue, v))
+}
+
+// OldValueGTE applies the GTE predicate on the "old_value" field.
+func OldValueGTE(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGTE(FieldOldValue, v))
+}
+
+// OldValueLT applies the LT predicate on the "old_value" field.
+func OldValueLT(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLT(FieldOldValue, v))
+}
+
+// OldValueLTE applies the LTE predicate on the "old_value" field.
+func OldValueLTE(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLTE(FieldOldValue, v))
+}
+
+// OldValueContains applies the Contains predicate on the "old_value" field.
+func OldValueContains(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldContains(FieldOldValue, v))
+}
+
+// OldValueHasPrefix applies the HasPrefix predicate on the "old_value" field.
+func OldValueHasPrefix(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldHasPrefix(FieldOldValue, v))
+}
+
+// OldValueHasSuffix applies the HasSuffix predicate on the "old_value" field.
+func OldValueHasSuffix(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldHasSuffix(FieldOldValue, v))
+}
+
+// OldValueIsNil applies the IsNil predicate on the "old_value" field.
+func OldValueIsNil() predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldIsNull(FieldOldValue))
+}
+
+// OldValueNotNil applies the NotNil predicate on the "old_value" field.
+func OldValueNotNil() predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNotNull(FieldOldValue))
+}
+
+// OldValueEqualFold applies the EqualFold predicate on the "old_value" field.
+func OldValueEqualFold(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEqualFold(FieldOldValue, v))
+}
+
+// OldValueContainsFold applies the ContainsFold predicate on the "old_value" field.
+func OldValueContainsFold(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldContainsFold(FieldOldValue, v))
+}
+
+// NewValueEQ applies the EQ predicate on the "new_value" field.
+func NewValueEQ(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldNewValue, v))
+}
+
+// NewValueNEQ applies the NEQ predicate on the "new_value" field.
+func NewValueNEQ(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNEQ(FieldNewValue, v))
+}
+
+// NewValueIn applies the In predicate on the "new_value" field.
+func NewValueIn(vs ...string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldIn(FieldNewValue, vs...))
+}
+
+// NewValueNotIn applies the NotIn predicate on the "new_value" field.
+func NewValueNotIn(vs ...string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNotIn(FieldNewValue, vs...))
+}
+
+// NewValueGT applies the GT predicate on the "new_value" field.
+func NewValueGT(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGT(FieldNewValue, v))
+}
+
+// NewValueGTE applies the GTE predicate on the "new_value" field.
+func NewValueGTE(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGTE(FieldNewValue, v))
+}
+
+// NewValueLT applies the LT predicate on the "new_value" field.
+func NewValueLT(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLT(FieldNewValue, v))
+}
+
+// NewValueLTE applies the LTE predicate on the "new_value" field.
+func NewValueLTE(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLTE(FieldNewValue, v))
+}
+
+// NewValueContains applies the Contains predicate on the "new_value" field.
+func NewValueContains(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldContains(FieldNewValue, v))
+}
+
+// NewValueHasPrefix applies the HasPrefix predicate on the "new_value" field.
+func NewValueHasPrefix(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldHasPrefix(FieldNewValue, v))
+}
+
+// NewValueHasSuffix applies the HasSuffix predicate on the "new_value" field.
+func NewValueHasSuffix(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldHasSuffix(FieldNewValue, v))
+}
+
+// NewValueIsNil applies the IsNil predicate on the "new_value" field.
+func NewValueIsNil() predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldIsNull(FieldNewValue))
+}
+
+// NewValueNotNil applies the NotNil predicate on the "new_value" field.
+func NewValueNotNil() predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNotNull(FieldNewValue))
+}
+
+// NewValueEqualFold applies the EqualFold predicate on the "new_value" field.
+func NewValueEqualFold(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEqualFold(FieldNewValue, v))
+}
+
+// NewValueContainsFold applies the ContainsFold predicate on the "new_value" field.
+func NewValueContainsFold(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldContainsFold(FieldNewValue, v))
+}
+
+// ChangedByEQ applies the EQ predicate on the "changed_by" field.
+func ChangedByEQ(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// ChangedByNEQ applies the NEQ predicate on the "changed_by" field.
+func ChangedByNEQ(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNEQ(FieldChangedBy, v))
+}
+
+// ChangedByIn applies the In predicate on the "changed_by" field.
+func ChangedByIn(vs ...string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldIn(FieldChangedBy, vs...))
+}
+
+// ChangedByNotIn applies the NotIn predicate on the "changed_by" field.
+func ChangedByNotIn(vs ...string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNotIn(FieldChangedBy, vs...))
+}
+
+// ChangedByGT applies the GT predicate on the "changed_by" field.
+func ChangedByGT(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGT(FieldChangedBy, v))
+}
+
+// ChangedByGTE applies the GTE predicate on the "changed_by" field.
+func ChangedByGTE(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGTE(FieldChangedBy, v))
+}
+
+// ChangedByLT applies the LT predicate on the "changed_by" field.
+func ChangedByLT(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLT(FieldChangedBy, v))
+}
+
+// ChangedByLTE applies the LTE predicate on the "changed_by" field.
+func ChangedByLTE(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLTE(FieldChangedBy, v))
+}
+
+// ChangedByContains applies the Contains predicate on the "changed_by" field.
+func ChangedByContains(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldContains(FieldChangedBy, v))
+}
+
+// ChangedByHasPrefix applies the HasPrefix predicate on the "changed_by" field.
+func ChangedByHasPrefix(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldHasPrefix(FieldChangedBy, v))
+}
+
+// ChangedByHasSuffix applies the HasSuffix predicate on the "changed_by" field.
+func ChangedByHasSuffix(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldHasSuffix(FieldChangedBy, v))
+}
+
+// ChangedByEqualFold applies the EqualFold predicate on the "changed_by" field.
+func ChangedByEqualFold(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEqualFold(FieldChangedBy, v))
+}
+
+// ChangedByContainsFold applies the ContainsFold predicate on the "changed_by" field.
+func ChangedByContainsFold(v string) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldContainsFold(FieldChangedBy, v))
+}
+
+// ChangedAtEQ applies the EQ predicate on the "changed_at" field.
+func ChangedAtEQ(v time.Time) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ChangedAtNEQ applies the NEQ predicate on the "changed_at" field.
+func ChangedAtNEQ(v time.Time) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNEQ(FieldChangedAt, v))
+}
+
+// ChangedAtIn applies the In predicate on the "changed_at" field.
+func ChangedAtIn(vs ...time.Time) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtNotIn applies the NotIn predicate on the "changed_at" field.
+func ChangedAtNotIn(vs ...time.Time) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldNotIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtGT applies the GT predicate on the "changed_at" field.
+func ChangedAtGT(v time.Time) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGT(FieldChangedAt, v))
+}
+
+// ChangedAtGTE applies the GTE predicate on the "changed_at" field.
+func ChangedAtGTE(v time.Time) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldGTE(FieldChangedAt, v))
+}
+
+// ChangedAtLT applies the LT predicate on the "changed_at" field.
+func ChangedAtLT(v time.Time) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLT(FieldChangedAt, v))
+}
+
+// ChangedAtLTE applies the LTE predicate on the "changed_at" field.
+func ChangedAtLTE(v time.Time) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.FieldLTE(FieldChangedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProfileChange) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProfileChange) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProfileChange) predicate.ProfileChange {
+	return predicate.ProfileChange(sql.NotPredicates(p))
+}
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/profilechange"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileChangeCreate is the builder for creating a ProfileChange entity.
+type ProfileChangeCreate struct {
+	config
+	mutation *ProfileChangeMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (pcc *ProfileChangeCreate) SetUserID(i int) *ProfileChangeCreate {
+	pcc.mutation.SetUserID(i)
+	return pcc
+}
+
+// SetField sets the "field" field.
+func (pcc *ProfileChangeCreate) SetField(s string) *ProfileChangeCreate {
+	pcc.mutation.SetFieldField(s)
+	return pcc
+}
+
+// SetOldValue sets the "old_value" field.
+func (pcc *ProfileChangeCreate) SetOldValue(s string) *ProfileChangeCreate {
+	pcc.mutation.SetOldValue(s)
+	return pcc
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (pcc *ProfileChangeCreate) SetNillableOldValue(s *string) *ProfileChangeCreate {
+	if s != nil {
+		pcc.SetOldValue(*s)
+	}
+	return pcc
+}
+
+// SetNewValue sets the "new_value" field.
+func (pcc *ProfileChangeCreate) SetNewValue(s string) *ProfileChangeCreate {
+	pcc.mutation.SetNewValue(s)
+	return pcc
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (pcc *ProfileChangeCreate) SetNillableNewValue(s *string) *ProfileChangeCreate {
+	if s != nil {
+		pcc.SetNewValue(*s)
+	}
+	return pcc
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (pcc *ProfileChangeCreate) SetChangedBy(s string) *ProfileChangeCreate {
+	pcc.mutation.SetChangedBy(s)
+	return pcc
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (pcc *ProfileChangeCreate) SetChangedAt(t time.Time) *ProfileChangeCreate {
+	pcc.mutation.SetChangedAt(t)
+	return pcc
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (pcc *ProfileChangeCreate) SetNillableChangedAt(t *time.Time) *ProfileChangeCreate {
+	if t != nil {
+		pcc.SetChangedAt(*t)
+	}
+	return pcc
+}
+
+// Mutation returns the ProfileChangeMutation object of the builder.
+func (pcc *ProfileChangeCreate) Mutation() *ProfileChangeMutation {
+	return pcc.mutation
+}
+
+// Save creates the ProfileChange in the database.
+func (pcc *ProfileChangeCreate) Save(ctx context.Context) (*ProfileChange, error) {
+	pcc.defaults()
+	return withHooks(ctx, pcc.sqlSave, pcc.mutation, pcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcc *ProfileChangeCreate) SaveX(ctx context.Context) *ProfileChange {
+	v, err := pcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcc *ProfileChangeCreate) Exec(ctx context.Context) error {
+	_, err := pcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcc *ProfileChangeCreate) ExecX(ctx context.Context) {
+	if err := pcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcc *ProfileChangeCreate) defaults() {
+	if _, ok := pcc.mutation.ChangedAt(); !ok {
+		v := profilechange.DefaultChangedAt()
+		pcc.mutation.SetChangedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcc *ProfileChangeCreate) check() error {
+	if _, ok := pcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ProfileChange.user_id"`)}
+	}
+	if _, ok := pcc.mutation.GetField(); !ok {
+		return &ValidationError{Name: "field", err: errors.New(`ent: missing required field "ProfileChange.field"`)}
+	}
+	if _, ok := pcc.mutation.ChangedBy(); !ok {
+		return &ValidationError{Name: "changed_by", err: errors.New(`ent: missing required field "ProfileChange.changed_by"`)}
+	}
+	if _, ok := pcc.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "ProfileChange.changed_at"`)}
+	}
+	return nil
+}
+
+func (pcc *ProfileChangeCreate) sqlSave(ctx context.Context) (*ProfileChange, error) {
+	if err := pcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pcc.mutation.id = &_node.ID
+	pcc.mutation.done = true
+	return _node, nil
+}
+
+func (pcc *ProfileChangeCreate) createSpec() (*ProfileChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProfileChange{config: pcc.config}
+		_spec = sqlgraph.NewCreateSpec(profilechange.Table, sqlgraph.NewFieldSpec(profilechange.FieldID, field.TypeInt))
+	)
+	if value, ok := pcc.mutation.UserID(); ok {
+		_spec.SetField(profilechange.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := pcc.mutation.GetField(); ok {
+		_spec.SetField(profilechange.FieldField, field.TypeString, value)
+		_node.Field = value
+	}
+	if value, ok := pcc.mutation.OldValue(); ok {
+		_spec.SetField(profilechange.FieldOldValue, field.TypeString, value)
+		_node.OldValue = value
+	}
+	if value, ok := pcc.mutation.NewValue(); ok {
+		_spec.SetField(profilechange.FieldNewValue, field.TypeString, value)
+		_node.NewValue = value
+	}
+	if value, ok := pcc.mutation.ChangedBy(); ok {
+		_spec.SetField(profilechange.FieldChangedBy, field.TypeString, value)
+		_node.ChangedBy = value
+	}
+	if value, ok := pcc.mutation.ChangedAt(); ok {
+		_spec.SetField(profilechange.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	return _node, _spec
+}
+
+// ProfileChangeCreateBulk is the builder for creating many ProfileChange entities in bulk.
+type ProfileChangeCreateBulk struct {
+	config
+	err      error
+	builders []*ProfileChangeCreate
+}
+
+// Save creates the ProfileChange entities in the database.
+func (pccb *ProfileChangeCreateBulk) Save(ctx context.Context) ([]*ProfileChange, error) {
+	if pccb.err != nil {
+		return nil, pccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pccb.builders))
+	nodes := make([]*ProfileChange, len(pccb.builders))
+	mutators := make([]Mutator, len(pccb.builders))
+	for i := range pccb.builders {
+		func(i int, root context.Context) {
+			builder := pccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProfileChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pccb *ProfileChangeCreateBulk) SaveX(ctx context.Context) []*ProfileChange {
+	v, err := pccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pccb *ProfileChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := pccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pccb *ProfileChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := pccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
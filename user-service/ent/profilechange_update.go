@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"user-service/ent/predicate"
+	"user-service/ent/profilechange"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileChangeUpdate is the builder for updating ProfileChange entities.
+type ProfileChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProfileChangeMutation
+}
+
+// Where appends a list predicates to the ProfileChangeUpdate builder.
+func (pcu *ProfileChangeUpdate) Where(ps ...predicate.ProfileChange) *ProfileChangeUpdate {
+	pcu.mutation.Where(ps...)
+	return pcu
+}
+
+// Mutation returns the ProfileChangeMutation object of the builder.
+func (pcu *ProfileChangeUpdate) Mutation() *ProfileChangeMutation {
+	return pcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcu *ProfileChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcu.sqlSave, pcu.mutation, pcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcu *ProfileChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcu *ProfileChangeUpdate) Exec(ctx context.Context) error {
+	_, err := pcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcu *ProfileChangeUpdate) ExecX(ctx context.Context) {
+	if err := pcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pcu *ProfileChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(profilechange.Table, profilechange.Columns, sqlgraph.NewFieldSpec(profilechange.FieldID, field.TypeInt))
+	if ps := pcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pcu.mutation.OldValueCleared() {
+		_spec.ClearField(profilechange.FieldOldValue, field.TypeString)
+	}
+	if pcu.mutation.NewValueCleared() {
+		_spec.ClearField(profilechange.FieldNewValue, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profilechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pcu.mutation.done = true
+	return n, nil
+}
+
+// ProfileChangeUpdateOne is the builder for updating a single ProfileChange entity.
+type ProfileChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProfileChangeMutation
+}
+
+// Mutation returns the ProfileChangeMutation object of the builder.
+func (pcuo *ProfileChangeUpdateOne) Mutation() *ProfileChangeMutation {
+	return pcuo.mutation
+}
+
+// Where appends a list predicates to the ProfileChangeUpdate builder.
+func (pcuo *ProfileChangeUpdateOne) Where(ps ...predicate.ProfileChange) *ProfileChangeUpdateOne {
+	pcuo.mutation.Where(ps...)
+	return pcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcuo *ProfileChangeUpdateOne) Select(field string, fields ...string) *ProfileChangeUpdateOne {
+	pcuo.fields = append([]string{field}, fields...)
+	return pcuo
+}
+
+// Save executes the query and returns the updated ProfileChange entity.
+func (pcuo *ProfileChangeUpdateOne) Save(ctx context.Context) (*ProfileChange, error) {
+	return withHooks(ctx, pcuo.sqlSave, pcuo.mutation, pcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcuo *ProfileChangeUpdateOne) SaveX(ctx context.Context) *ProfileChange {
+	node, err := pcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcuo *ProfileChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcuo *ProfileChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := pcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pcuo *ProfileChangeUpdateOne) sqlSave(ctx context.Context) (_node *ProfileChange, err error) {
+	_spec := sqlgraph.NewUpdateSpec(profilechange.Table, profilechange.Columns, sqlgraph.NewFieldSpec(profilechange.FieldID, field.TypeInt))
+	id, ok := pcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProfileChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profilechange.FieldID)
+		for _, f := range fields {
+			if !profilechange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != profilechange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pcuo.mutation.OldValueCleared() {
+		_spec.ClearField(profilechange.FieldOldValue, field.TypeString)
+	}
+	if pcuo.mutation.NewValueCleared() {
+		_spec.ClearField(profilechange.FieldNewValue, field.TypeString)
+	}
+	_node = &ProfileChange{config: pcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profilechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"user-service/ent/ratelimitstate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RateLimitState is the model entity for the RateLimitState schema.
+type RateLimitState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Value holds the value of the "value" field.
+	Value float64 `json:"value,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version      int `json:"version,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RateLimitState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ratelimitstate.FieldValue:
+			values[i] = new(sql.NullFloat64)
+		case ratelimitstate.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case ratelimitstate.FieldID:
+			values[i] = new(sql.NullString)
+		case ratelimitstate.FieldUpdatedAt, ratelimitstate.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RateLimitState fields.
+func (rls *RateLimitState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ratelimitstate.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				rls.ID = value.String
+			}
+		case ratelimitstate.FieldValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				rls.Value = value.Float64
+			}
+		case ratelimitstate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rls.UpdatedAt = value.Time
+			}
+		case ratelimitstate.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				rls.ExpiresAt = value.Time
+			}
+		case ratelimitstate.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				rls.Version = int(value.Int64)
+			}
+		default:
+			rls.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the RateLimitState.
+// This includes values selected through modifiers, order, etc.
+func (rls *RateLimitState) GetValue(name string) (ent.Value, error) {
+	return rls.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RateLimitState.
+// Note that you need to call RateLimitState.Unwrap() before calling this method if this RateLimitState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rls *RateLimitState) Update() *RateLimitStateUpdateOne {
+	return NewRateLimitStateClient(rls.config).UpdateOne(rls)
+}
+
+// Unwrap unwraps the RateLimitState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rls *RateLimitState) Unwrap() *RateLimitState {
+	_tx, ok := rls.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RateLimitState is not a transactional entity")
+	}
+	rls.config.driver = _tx.drv
+	return rls
+}
+
+// String implements the fmt.Stringer.
+func (rls *RateLimitState) String() string {
+	var builder strings.Builder
+	builder.WriteString("RateLimitState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rls.ID))
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", rls.Value))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rls.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(rls.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", rls.Version))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RateLimitStates is a parsable slice of RateLimitState.
+type RateLimitStates []*RateLimitState
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/predicate"
+	"user-service/ent/ratelimitstate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RateLimitStateUpdate is the builder for updating RateLimitState entities.
+type RateLimitStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RateLimitStateMutation
+}
+
+// Where appends a list predicates to the RateLimitStateUpdate builder.
+func (rlsu *RateLimitStateUpdate) Where(ps ...predicate.RateLimitState) *RateLimitStateUpdate {
+	rlsu.mutation.Where(ps...)
+	return rlsu
+}
+
+// SetValue sets the "value" field.
+func (rlsu *RateLimitStateUpdate) SetValue(f float64) *RateLimitStateUpdate {
+	rlsu.mutation.ResetValue()
+	rlsu.mutation.SetValue(f)
+	return rlsu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (rlsu *RateLimitStateUpdate) SetNillableValue(f *float64) *RateLimitStateUpdate {
+	if f != nil {
+		rlsu.SetValue(*f)
+	}
+	return rlsu
+}
+
+// AddValue adds f to the "value" field.
+func (rlsu *RateLimitStateUpdate) AddValue(f float64) *RateLimitStateUpdate {
+	rlsu.mutation.AddValue(f)
+	return rlsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rlsu *RateLimitStateUpdate) SetUpdatedAt(t time.Time) *RateLimitStateUpdate {
+	rlsu.mutation.SetUpdatedAt(t)
+	return rlsu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rlsu *RateLimitStateUpdate) SetNillableUpdatedAt(t *time.Time) *RateLimitStateUpdate {
+	if t != nil {
+		rlsu.SetUpdatedAt(*t)
+	}
+	return rlsu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rlsu *RateLimitStateUpdate) SetExpiresAt(t time.Time) *RateLimitStateUpdate {
+	rlsu.mutation.SetExpiresAt(t)
+	return rlsu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rlsu *RateLimitStateUpdate) SetNillableExpiresAt(t *time.Time) *RateLimitStateUpdate {
+	if t != nil {
+		rlsu.SetExpiresAt(*t)
+	}
+	return rlsu
+}
+
+// SetVersion sets the "version" field.
+func (rlsu *RateLimitStateUpdate) SetVersion(i int) *RateLimitStateUpdate {
+	rlsu.mutation.ResetVersion()
+	rlsu.mutation.SetVersion(i)
+	return rlsu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (rlsu *RateLimitStateUpdate) SetNillableVersion(i *int) *RateLimitStateUpdate {
+	if i != nil {
+		rlsu.SetVersion(*i)
+	}
+	return rlsu
+}
+
+// AddVersion adds i to the "version" field.
+func (rlsu *RateLimitStateUpdate) AddVersion(i int) *RateLimitStateUpdate {
+	rlsu.mutation.AddVersion(i)
+	return rlsu
+}
+
+// Mutation returns the RateLimitStateMutation object of the builder.
+func (rlsu *RateLimitStateUpdate) Mutation() *RateLimitStateMutation {
+	return rlsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rlsu *RateLimitStateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rlsu.sqlSave, rlsu.mutation, rlsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rlsu *RateLimitStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := rlsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rlsu *RateLimitStateUpdate) Exec(ctx context.Context) error {
+	_, err := rlsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlsu *RateLimitStateUpdate) ExecX(ctx context.Context) {
+	if err := rlsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rlsu *RateLimitStateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ratelimitstate.Table, ratelimitstate.Columns, sqlgraph.NewFieldSpec(ratelimitstate.FieldID, field.TypeString))
+	if ps := rlsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rlsu.mutation.Value(); ok {
+		_spec.SetField(ratelimitstate.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := rlsu.mutation.AddedValue(); ok {
+		_spec.AddField(ratelimitstate.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := rlsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratelimitstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rlsu.mutation.ExpiresAt(); ok {
+		_spec.SetField(ratelimitstate.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := rlsu.mutation.Version(); ok {
+		_spec.SetField(ratelimitstate.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := rlsu.mutation.AddedVersion(); ok {
+		_spec.AddField(ratelimitstate.FieldVersion, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rlsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratelimitstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rlsu.mutation.done = true
+	return n, nil
+}
+
+// RateLimitStateUpdateOne is the builder for updating a single RateLimitState entity.
+type RateLimitStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RateLimitStateMutation
+}
+
+// SetValue sets the "value" field.
+func (rlsuo *RateLimitStateUpdateOne) SetValue(f float64) *RateLimitStateUpdateOne {
+	rlsuo.mutation.ResetValue()
+	rlsuo.mutation.SetValue(f)
+	return rlsuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (rlsuo *RateLimitStateUpdateOne) SetNillableValue(f *float64) *RateLimitStateUpdateOne {
+	if f != nil {
+		rlsuo.SetValue(*f)
+	}
+	return rlsuo
+}
+
+// AddValue adds f to the "value" field.
+func (rlsuo *RateLimitStateUpdateOne) AddValue(f float64) *RateLimitStateUpdateOne {
+	rlsuo.mutation.AddValue(f)
+	return rlsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rlsuo *RateLimitStateUpdateOne) SetUpdatedAt(t time.Time) *RateLimitStateUpdateOne {
+	rlsuo.mutation.SetUpdatedAt(t)
+	return rlsuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rlsuo *RateLimitStateUpdateOne) SetNillableUpdatedAt(t *time.Time) *RateLimitStateUpdateOne {
+	if t != nil {
+		rlsuo.SetUpdatedAt(*t)
+	}
+	return rlsuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rlsuo *RateLimitStateUpdateOne) SetExpiresAt(t time.Time) *RateLimitStateUpdateOne {
+	rlsuo.mutation.SetExpiresAt(t)
+	return rlsuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rlsuo *RateLimitStateUpdateOne) SetNillableExpiresAt(t *time.Time) *RateLimitStateUpdateOne {
+	if t != nil {
+		rlsuo.SetExpiresAt(*t)
+	}
+	return rlsuo
+}
+
+// SetVersion sets the "version" field.
+func (rlsuo *RateLimitStateUpdateOne) SetVersion(i int) *RateLimitStateUpdateOne {
+	rlsuo.mutation.ResetVersion()
+	rlsuo.mutation.SetVersion(i)
+	return rlsuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (rlsuo *RateLimitStateUpdateOne) SetNillableVersion(i *int) *RateLimitStateUpdateOne {
+	if i != nil {
+		rlsuo.SetVersion(*i)
+	}
+	return rlsuo
+}
+
+// AddVersion adds i to the "version" field.
+func (rlsuo *RateLimitStateUpdateOne) AddVersion(i int) *RateLimitStateUpdateOne {
+	rlsuo.mutation.AddVersion(i)
+	return rlsuo
+}
+
+// Mutation returns the RateLimitStateMutation object of the builder.
+func (rlsuo *RateLimitStateUpdateOne) Mutation() *RateLimitStateMutation {
+	return rlsuo.mutation
+}
+
+// Where appends a list predicates to the RateLimitStateUpdate builder.
+func (rlsuo *RateLimitStateUpdateOne) Where(ps ...predicate.RateLimitState) *RateLimitStateUpdateOne {
+	rlsuo.mutation.Where(ps...)
+	return rlsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rlsuo *RateLimitStateUpdateOne) Select(field string, fields ...string) *RateLimitStateUpdateOne {
+	rlsuo.fields = append([]string{field}, fields...)
+	return rlsuo
+}
+
+// Save executes the query and returns the updated RateLimitState entity.
+func (rlsuo *RateLimitStateUpdateOne) Save(ctx context.Context) (*RateLimitState, error) {
+	return withHooks(ctx, rlsuo.sqlSave, rlsuo.mutation, rlsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rlsuo *RateLimitStateUpdateOne) SaveX(ctx context.Context) *RateLimitState {
+	node, err := rlsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rlsuo *RateLimitStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := rlsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlsuo *RateLimitStateUpdateOne) ExecX(ctx context.Context) {
+	if err := rlsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rlsuo *RateLimitStateUpdateOne) sqlSave(ctx context.Context) (_node *RateLimitState, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ratelimitstate.Table, ratelimitstate.Columns, sqlgraph.NewFieldSpec(ratelimitstate.FieldID, field.TypeString))
+	id, ok := rlsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RateLimitState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rlsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratelimitstate.FieldID)
+		for _, f := range fields {
+			if !ratelimitstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ratelimitstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rlsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rlsuo.mutation.Value(); ok {
+		_spec.SetField(ratelimitstate.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := rlsuo.mutation.AddedValue(); ok {
+		_spec.AddField(ratelimitstate.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := rlsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratelimitstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rlsuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(ratelimitstate.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := rlsuo.mutation.Version(); ok {
+		_spec.SetField(ratelimitstate.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := rlsuo.mutation.AddedVersion(); ok {
+		_spec.AddField(ratelimitstate.FieldVersion, field.TypeInt, value)
+	}
+	_node = &RateLimitState{config: rlsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rlsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratelimitstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rlsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"user-service/ent/referral"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Referral is the model entity for the Referral schema.
+type Referral struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ReferrerID holds the value of the "referrer_id" field.
+	ReferrerID int `json:"referrer_id,omitempty"`
+	// ReferredID holds the value of the "referred_id" field.
+	ReferredID int `json:"referred_id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// Status holds the value of the "status" field.
+	Status referral.Status `json:"status,omitempty"`
+	// ReferrerBonus holds the value of the "referrer_bonus" field.
+	ReferrerBonus float64 `json:"referrer_bonus,omitempty"`
+	// ReferredBonus holds the value of the "referred_bonus" field.
+	ReferredBonus float64 `json:"referred_bonus,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// QualifiedAt holds the value of the "qualified_at" field.
+	QualifiedAt *time.Time `json:"qualified_at,omitempty"`
+	// PaidAt holds the value of the "paid_at" field.
+	PaidAt *time.Time `json:"paid_at,omitempty"`
+	// PayoutClaimedAt holds the value of the "payout_claimed_at" field.
+	PayoutClaimedAt *time.Time `json:"payout_claimed_at,omitempty"`
+	// CheckedAt holds the value of the "checked_at" field.
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+	// PayoutError holds the value of the "payout_error" field.
+	PayoutError string `json:"payout_error,omitempty"`
+	// PayoutAttempts holds the value of the "payout_attempts" field.
+	PayoutAttempts int `json:"payout_attempts,omitempty"`
+	// NextPayoutAt holds the value of the "next_payout_at" field.
+	NextPayoutAt *time.Time `json:"next_payout_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Referral) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case referral.FieldReferrerBonus, referral.FieldReferredBonus:
+			values[i] = new(sql.NullFloat64)
+		case referral.FieldID, referral.FieldReferrerID, referral.FieldReferredID, referral.FieldPayoutAttempts:
+			values[i] = new(sql.NullInt64)
+		case referral.FieldCode, referral.FieldStatus, referral.FieldPayoutError:
+			values[i] = new(sql.NullString)
+		case referral.FieldCreatedAt, referral.FieldQualifiedAt, referral.FieldPaidAt, referral.FieldPayoutClaimedAt, referral.FieldCheckedAt, referral.FieldNextPayoutAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Referral fields.
+func (r *Referral) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case referral.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case referral.FieldReferrerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field referrer_id", values[i])
+			} else if value.Valid {
+				r.ReferrerID = int(value.Int64)
+			}
+		case referral.FieldReferredID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field referred_id", values[i])
+			} else if value.Valid {
+				r.ReferredID = int(value.Int64)
+			}
+		case referral.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				r.Code = value.String
+			}
+		case referral.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = referral.Status(value.String)
+			}
+		case referral.FieldReferrerBonus:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field referrer_bonus", values[i])
+			} else if value.Valid {
+				r.ReferrerBonus = value.Float64
+			}
+		case referral.FieldReferredBonus:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field referred_bonus", values[i])
+			} else if value.Valid {
+				r.ReferredBonus = value.Float64
+			}
+		case referral.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case referral.FieldQualifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field qualified_at", values[i])
+			} else if value.Valid {
+				r.QualifiedAt = new(time.Time)
+				*r.QualifiedAt = value.Time
+			}
+		case referral.FieldPaidAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_at", values[i])
+			} else if value.Valid {
+				r.PaidAt = new(time.Time)
+				*r.PaidAt = value.Time
+			}
+		case referral.FieldPayoutClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_claimed_at", values[i])
+			} else if value.Valid {
+				r.PayoutClaimedAt = new(time.Time)
+				*r.PayoutClaimedAt = value.Time
+			}
+		case referral.FieldCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field checked_at", values[i])
+			} else if value.Valid {
+				r.CheckedAt = new(time.Time)
+				*r.CheckedAt = value.Time
+			}
+		case referral.FieldPayoutError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_error", values[i])
+			} else if value.Valid {
+				r.PayoutError = value.String
+			}
+		case referral.FieldPayoutAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_attempts", values[i])
+			} else if value.Valid {
+				r.PayoutAttempts = int(value.Int64)
+			}
+		case referral.FieldNextPayoutAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_payout_at", values[i])
+			} else if value.Valid {
+				r.NextPayoutAt = new(time.Time)
+				*r.NextPayoutAt = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Referral.
+// This includes values selected through modifiers, order, etc.
+func (r *Referral) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Referral.
+// Note that you need to call Referral.Unwrap() before calling this method if this Referral
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Referral) Update() *ReferralUpdateOne {
+	return NewReferralClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Referral entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Referral) Unwrap() *Referral {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Referral is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Referral) String() string {
+	var builder strings.Builder
+	builder.WriteString("Referral(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("referrer_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.ReferrerID))
+	builder.WriteString(", ")
+	builder.WriteString("referred_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.ReferredID))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(r.Code)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", ")
+	builder.WriteString("referrer_bonus=")
+	builder.WriteString(fmt.Sprintf("%v", r.ReferrerBonus))
+	builder.WriteString(", ")
+	builder.WriteString("referred_bonus=")
+	builder.WriteString(fmt.Sprintf("%v", r.ReferredBonus))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := r.QualifiedAt; v != nil {
+		builder.WriteString("qualified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := r.PaidAt; v != nil {
+		builder.WriteString("paid_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := r.PayoutClaimedAt; v != nil {
+		builder.WriteString("payout_claimed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := r.CheckedAt; v != nil {
+		builder.WriteString("checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("payout_error=")
+	builder.WriteString(r.PayoutError)
+	builder.WriteString(", ")
+	builder.WriteString("payout_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", r.PayoutAttempts))
+	builder.WriteString(", ")
+	if v := r.NextPayoutAt; v != nil {
+		builder.WriteString("next_payout_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Referrals is a parsable slice of Referral.
+type Referrals []*Referral
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referral
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the referral type in the database.
+	Label = "referral"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReferrerID holds the string denoting the referrer_id field in the database.
+	FieldReferrerID = "referrer_id"
+	// FieldReferredID holds the string denoting the referred_id field in the database.
+	FieldReferredID = "referred_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReferrerBonus holds the string denoting the referrer_bonus field in the database.
+	FieldReferrerBonus = "referrer_bonus"
+	// FieldReferredBonus holds the string denoting the referred_bonus field in the database.
+	FieldReferredBonus = "referred_bonus"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldQualifiedAt holds the string denoting the qualified_at field in the database.
+	FieldQualifiedAt = "qualified_at"
+	// FieldPaidAt holds the string denoting the paid_at field in the database.
+	FieldPaidAt = "paid_at"
+	// FieldPayoutClaimedAt holds the string denoting the payout_claimed_at field in the database.
+	FieldPayoutClaimedAt = "payout_claimed_at"
+	// FieldCheckedAt holds the string denoting the checked_at field in the database.
+	FieldCheckedAt = "checked_at"
+	// FieldPayoutError holds the string denoting the payout_error field in the database.
+	FieldPayoutError = "payout_error"
+	// FieldPayoutAttempts holds the string denoting the payout_attempts field in the database.
+	FieldPayoutAttempts = "payout_attempts"
+	// FieldNextPayoutAt holds the string denoting the next_payout_at field in the database.
+	FieldNextPayoutAt = "next_payout_at"
+	// Table holds the table name of the referral in the database.
+	Table = "referrals"
+)
+
+// Columns holds all SQL columns for referral fields.
+var Columns = []string{
+	FieldID,
+	FieldReferrerID,
+	FieldReferredID,
+	FieldCode,
+	FieldStatus,
+	FieldReferrerBonus,
+	FieldReferredBonus,
+	FieldCreatedAt,
+	FieldQualifiedAt,
+	FieldPaidAt,
+	FieldPayoutClaimedAt,
+	FieldCheckedAt,
+	FieldPayoutError,
+	FieldPayoutAttempts,
+	FieldNextPayoutAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReferrerBonus holds the default value on creation for the "referrer_bonus" field.
+	DefaultReferrerBonus float64
+	// DefaultReferredBonus holds the default value on creation for the "referred_bonus" field.
+	DefaultReferredBonus float64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultPayoutAttempts holds the default value on creation for the "payout_attempts" field.
+	DefaultPayoutAttempts int
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusQualified Status = "qualified"
+	StatusPaid      Status = "paid"
+	StatusFailed    Status = "failed"
+	StatusCapped    Status = "capped"
+	StatusExpired   Status = "expired"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusQualified, StatusPaid, StatusFailed, StatusCapped, StatusExpired:
+		return nil
+	default:
+		return fmt.Errorf("referral: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Referral queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReferrerID orders the results by the referrer_id field.
+func ByReferrerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferrerID, opts...).ToFunc()
+}
+
+// ByReferredID orders the results by the referred_id field.
+func ByReferredID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferredID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReferrerBonus orders the results by the referrer_bonus field.
+func ByReferrerBonus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferrerBonus, opts...).ToFunc()
+}
+
+// ByReferredBonus orders the results by the referred_bonus field.
+func ByReferredBonus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferredBonus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByQualifiedAt orders the results by the qualified_at field.
+func ByQualifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQualifiedAt, opts...).ToFunc()
+}
+
+// ByPaidAt orders the results by the paid_at field.
+func ByPaidAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaidAt, opts...).ToFunc()
+}
+
+// ByPayoutClaimedAt orders the results by the payout_claimed_at field.
+func ByPayoutClaimedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutClaimedAt, opts...).ToFunc()
+}
+
+// ByCheckedAt orders the results by the checked_at field.
+func ByCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckedAt, opts...).ToFunc()
+}
+
+// ByPayoutError orders the results by the payout_error field.
+func ByPayoutError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutError, opts...).ToFunc()
+}
+
+// ByPayoutAttempts orders the results by the payout_attempts field.
+func ByPayoutAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutAttempts, opts...).ToFunc()
+}
+
+// ByNextPayoutAt orders the results by the next_payout_at field.
+func ByNextPayoutAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextPayoutAt, opts...).ToFunc()
+}
@@ -0,0 +1,785 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referral
+
+import (
+	"time"
+	"user-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldID, id))
+}
+
+// ReferrerID applies equality check predicate on the "referrer_id" field. It's identical to ReferrerIDEQ.
+func ReferrerID(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerID, v))
+}
+
+// ReferredID applies equality check predicate on the "referred_id" field. It's identical to ReferredIDEQ.
+func ReferredID(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferredID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCode, v))
+}
+
+// ReferrerBonus applies equality check predicate on the "referrer_bonus" field. It's identical to ReferrerBonusEQ.
+func ReferrerBonus(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerBonus, v))
+}
+
+// ReferredBonus applies equality check predicate on the "referred_bonus" field. It's identical to ReferredBonusEQ.
+func ReferredBonus(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferredBonus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// QualifiedAt applies equality check predicate on the "qualified_at" field. It's identical to QualifiedAtEQ.
+func QualifiedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldQualifiedAt, v))
+}
+
+// PaidAt applies equality check predicate on the "paid_at" field. It's identical to PaidAtEQ.
+func PaidAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// PayoutClaimedAt applies equality check predicate on the "payout_claimed_at" field. It's identical to PayoutClaimedAtEQ.
+func PayoutClaimedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldPayoutClaimedAt, v))
+}
+
+// CheckedAt applies equality check predicate on the "checked_at" field. It's identical to CheckedAtEQ.
+func CheckedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// PayoutError applies equality check predicate on the "payout_error" field. It's identical to PayoutErrorEQ.
+func PayoutError(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldPayoutError, v))
+}
+
+// PayoutAttempts applies equality check predicate on the "payout_attempts" field. It's identical to PayoutAttemptsEQ.
+func PayoutAttempts(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldPayoutAttempts, v))
+}
+
+// NextPayoutAt applies equality check predicate on the "next_payout_at" field. It's identical to NextPayoutAtEQ.
+func NextPayoutAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldNextPayoutAt, v))
+}
+
+// ReferrerIDEQ applies the EQ predicate on the "referrer_id" field.
+func ReferrerIDEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerID, v))
+}
+
+// ReferrerIDNEQ applies the NEQ predicate on the "referrer_id" field.
+func ReferrerIDNEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReferrerID, v))
+}
+
+// ReferrerIDIn applies the In predicate on the "referrer_id" field.
+func ReferrerIDIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReferrerID, vs...))
+}
+
+// ReferrerIDNotIn applies the NotIn predicate on the "referrer_id" field.
+func ReferrerIDNotIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReferrerID, vs...))
+}
+
+// ReferrerIDGT applies the GT predicate on the "referrer_id" field.
+func ReferrerIDGT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldReferrerID, v))
+}
+
+// ReferrerIDGTE applies the GTE predicate on the "referrer_id" field.
+func ReferrerIDGTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldReferrerID, v))
+}
+
+// ReferrerIDLT applies the LT predicate on the "referrer_id" field.
+func ReferrerIDLT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldReferrerID, v))
+}
+
+// ReferrerIDLTE applies the LTE predicate on the "referrer_id" field.
+func ReferrerIDLTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldReferrerID, v))
+}
+
+// ReferredIDEQ applies the EQ predicate on the "referred_id" field.
+func ReferredIDEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferredID, v))
+}
+
+// ReferredIDNEQ applies the NEQ predicate on the "referred_id" field.
+func ReferredIDNEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReferredID, v))
+}
+
+// ReferredIDIn applies the In predicate on the "referred_id" field.
+func ReferredIDIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReferredID, vs...))
+}
+
+// ReferredIDNotIn applies the NotIn predicate on the "referred_id" field.
+func ReferredIDNotIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReferredID, vs...))
+}
+
+// ReferredIDGT applies the GT predicate on the "referred_id" field.
+func ReferredIDGT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldReferredID, v))
+}
+
+// ReferredIDGTE applies the GTE predicate on the "referred_id" field.
+func ReferredIDGTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldReferredID, v))
+}
+
+// ReferredIDLT applies the LT predicate on the "referred_id" field.
+func ReferredIDLT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldReferredID, v))
+}
+
+// ReferredIDLTE applies the LTE predicate on the "referred_id" field.
+func ReferredIDLTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldReferredID, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldCode, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReferrerBonusEQ applies the EQ predicate on the "referrer_bonus" field.
+func ReferrerBonusEQ(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerBonus, v))
+}
+
+// ReferrerBonusNEQ applies the NEQ predicate on the "referrer_bonus" field.
+func ReferrerBonusNEQ(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReferrerBonus, v))
+}
+
+// ReferrerBonusIn applies the In predicate on the "referrer_bonus" field.
+func ReferrerBonusIn(vs ...float64) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReferrerBonus, vs...))
+}
+
+// ReferrerBonusNotIn applies the NotIn predicate on the "referrer_bonus" field.
+func ReferrerBonusNotIn(vs ...float64) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReferrerBonus, vs...))
+}
+
+// ReferrerBonusGT applies the GT predicate on the "referrer_bonus" field.
+func ReferrerBonusGT(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldReferrerBonus, v))
+}
+
+// ReferrerBonusGTE applies the GTE predicate on the "referrer_bonus" field.
+func ReferrerBonusGTE(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldReferrerBonus, v))
+}
+
+// ReferrerBonusLT applies the LT predicate on the "referrer_bonus" field.
+func ReferrerBonusLT(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldReferrerBonus, v))
+}
+
+// ReferrerBonusLTE applies the LTE predicate on the "referrer_bonus" field.
+func ReferrerBonusLTE(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldReferrerBonus, v))
+}
+
+// ReferredBonusEQ applies the EQ predicate on the "referred_bonus" field.
+func ReferredBonusEQ(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferredBonus, v))
+}
+
+// ReferredBonusNEQ applies the NEQ predicate on the "referred_bonus" field.
+func ReferredBonusNEQ(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReferredBonus, v))
+}
+
+// ReferredBonusIn applies the In predicate on the "referred_bonus" field.
+func ReferredBonusIn(vs ...float64) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReferredBonus, vs...))
+}
+
+// ReferredBonusNotIn applies the NotIn predicate on the "referred_bonus" field.
+func ReferredBonusNotIn(vs ...float64) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReferredBonus, vs...))
+}
+
+// ReferredBonusGT applies the GT predicate on the "referred_bonus" field.
+func ReferredBonusGT(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldReferredBonus, v))
+}
+
+// ReferredBonusGTE applies the GTE predicate on the "referred_bonus" field.
+func ReferredBonusGTE(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldReferredBonus, v))
+}
+
+// ReferredBonusLT applies the LT predicate on the "referred_bonus" field.
+func ReferredBonusLT(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldReferredBonus, v))
+}
+
+// ReferredBonusLTE applies the LTE predicate on the "referred_bonus" field.
+func ReferredBonusLTE(v float64) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldReferredBonus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// QualifiedAtEQ applies the EQ predicate on the "qualified_at" field.
+func QualifiedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldQualifiedAt, v))
+}
+
+// QualifiedAtNEQ applies the NEQ predicate on the "qualified_at" field.
+func QualifiedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldQualifiedAt, v))
+}
+
+// QualifiedAtIn applies the In predicate on the "qualified_at" field.
+func QualifiedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldQualifiedAt, vs...))
+}
+
+// QualifiedAtNotIn applies the NotIn predicate on the "qualified_at" field.
+func QualifiedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldQualifiedAt, vs...))
+}
+
+// QualifiedAtGT applies the GT predicate on the "qualified_at" field.
+func QualifiedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldQualifiedAt, v))
+}
+
+// QualifiedAtGTE applies the GTE predicate on the "qualified_at" field.
+func QualifiedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldQualifiedAt, v))
+}
+
+// QualifiedAtLT applies the LT predicate on the "qualified_at" field.
+func QualifiedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldQualifiedAt, v))
+}
+
+// QualifiedAtLTE applies the LTE predicate on the "qualified_at" field.
+func QualifiedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldQualifiedAt, v))
+}
+
+// QualifiedAtIsNil applies the IsNil predicate on the "qualified_at" field.
+func QualifiedAtIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldQualifiedAt))
+}
+
+// QualifiedAtNotNil applies the NotNil predicate on the "qualified_at" field.
+func QualifiedAtNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldQualifiedAt))
+}
+
+// PaidAtEQ applies the EQ predicate on the "paid_at" field.
+func PaidAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// PaidAtNEQ applies the NEQ predicate on the "paid_at" field.
+func PaidAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldPaidAt, v))
+}
+
+// PaidAtIn applies the In predicate on the "paid_at" field.
+func PaidAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldPaidAt, vs...))
+}
+
+// PaidAtNotIn applies the NotIn predicate on the "paid_at" field.
+func PaidAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldPaidAt, vs...))
+}
+
+// PaidAtGT applies the GT predicate on the "paid_at" field.
+func PaidAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldPaidAt, v))
+}
+
+// PaidAtGTE applies the GTE predicate on the "paid_at" field.
+func PaidAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldPaidAt, v))
+}
+
+// PaidAtLT applies the LT predicate on the "paid_at" field.
+func PaidAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldPaidAt, v))
+}
+
+// PaidAtLTE applies the LTE predicate on the "paid_at" field.
+func PaidAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldPaidAt, v))
+}
+
+// PaidAtIsNil applies the IsNil predicate on the "paid_at" field.
+func PaidAtIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldPaidAt))
+}
+
+// PaidAtNotNil applies the NotNil predicate on the "paid_at" field.
+func PaidAtNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldPaidAt))
+}
+
+// PayoutClaimedAtEQ applies the EQ predicate on the "payout_claimed_at" field.
+func PayoutClaimedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldPayoutClaimedAt, v))
+}
+
+// PayoutClaimedAtNEQ applies the NEQ predicate on the "payout_claimed_at" field.
+func PayoutClaimedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldPayoutClaimedAt, v))
+}
+
+// PayoutClaimedAtIn applies the In predicate on the "payout_claimed_at" field.
+func PayoutClaimedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldPayoutClaimedAt, vs...))
+}
+
+// PayoutClaimedAtNotIn applies the NotIn predicate on the "payout_claimed_at" field.
+func PayoutClaimedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldPayoutClaimedAt, vs...))
+}
+
+// PayoutClaimedAtGT applies the GT predicate on the "payout_claimed_at" field.
+func PayoutClaimedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldPayoutClaimedAt, v))
+}
+
+// PayoutClaimedAtGTE applies the GTE predicate on the "payout_claimed_at" field.
+func PayoutClaimedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldPayoutClaimedAt, v))
+}
+
+// PayoutClaimedAtLT applies the LT predicate on the "payout_claimed_at" field.
+func PayoutClaimedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldPayoutClaimedAt, v))
+}
+
+// PayoutClaimedAtLTE applies the LTE predicate on the "payout_claimed_at" field.
+func PayoutClaimedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldPayoutClaimedAt, v))
+}
+
+// PayoutClaimedAtIsNil applies the IsNil predicate on the "payout_claimed_at" field.
+func PayoutClaimedAtIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldPayoutClaimedAt))
+}
+
+// PayoutClaimedAtNotNil applies the NotNil predicate on the "payout_claimed_at" field.
+func PayoutClaimedAtNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldPayoutClaimedAt))
+}
+
+// CheckedAtEQ applies the EQ predicate on the "checked_at" field.
+func CheckedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtNEQ applies the NEQ predicate on the "checked_at" field.
+func CheckedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtIn applies the In predicate on the "checked_at" field.
+func CheckedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtNotIn applies the NotIn predicate on the "checked_at" field.
+func CheckedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtGT applies the GT predicate on the "checked_at" field.
+func CheckedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldCheckedAt, v))
+}
+
+// CheckedAtGTE applies the GTE predicate on the "checked_at" field.
+func CheckedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldCheckedAt, v))
+}
+
+// CheckedAtLT applies the LT predicate on the "checked_at" field.
+func CheckedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldCheckedAt, v))
+}
+
+// CheckedAtLTE applies the LTE predicate on the "checked_at" field.
+func CheckedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldCheckedAt, v))
+}
+
+// CheckedAtIsNil applies the IsNil predicate on the "checked_at" field.
+func CheckedAtIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldCheckedAt))
+}
+
+// CheckedAtNotNil applies the NotNil predicate on the "checked_at" field.
+func CheckedAtNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldCheckedAt))
+}
+
+// PayoutErrorEQ applies the EQ predicate on the "payout_error" field.
+func PayoutErrorEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldPayoutError, v))
+}
+
+// PayoutErrorNEQ applies the NEQ predicate on the "payout_error" field.
+func PayoutErrorNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldPayoutError, v))
+}
+
+// PayoutErrorIn applies the In predicate on the "payout_error" field.
+func PayoutErrorIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldPayoutError, vs...))
+}
+
+// PayoutErrorNotIn applies the NotIn predicate on the "payout_error" field.
+func PayoutErrorNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldPayoutError, vs...))
+}
+
+// PayoutErrorGT applies the GT predicate on the "payout_error" field.
+func PayoutErrorGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldPayoutError, v))
+}
+
+// PayoutErrorGTE applies the GTE predicate on the "payout_error" field.
+func PayoutErrorGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldPayoutError, v))
+}
+
+// PayoutErrorLT applies the LT predicate on the "payout_error" field.
+func PayoutErrorLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldPayoutError, v))
+}
+
+// PayoutErrorLTE applies the LTE predicate on the "payout_error" field.
+func PayoutErrorLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldPayoutError, v))
+}
+
+// PayoutErrorContains applies the Contains predicate on the "payout_error" field.
+func PayoutErrorContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldPayoutError, v))
+}
+
+// PayoutErrorHasPrefix applies the HasPrefix predicate on the "payout_error" field.
+func PayoutErrorHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldPayoutError, v))
+}
+
+// PayoutErrorHasSuffix applies the HasSuffix predicate on the "payout_error" field.
+func PayoutErrorHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldPayoutError, v))
+}
+
+// PayoutErrorIsNil applies the IsNil predicate on the "payout_error" field.
+func PayoutErrorIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldPayoutError))
+}
+
+// PayoutErrorNotNil applies the NotNil predicate on the "payout_error" field.
+func PayoutErrorNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldPayoutError))
+}
+
+// PayoutErrorEqualFold applies the EqualFold predicate on the "payout_error" field.
+func PayoutErrorEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldPayoutError, v))
+}
+
+// PayoutErrorContainsFold applies the ContainsFold predicate on the "payout_error" field.
+func PayoutErrorContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldPayoutError, v))
+}
+
+// PayoutAttemptsEQ applies the EQ predicate on the "payout_attempts" field.
+func PayoutAttemptsEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldPayoutAttempts, v))
+}
+
+// PayoutAttemptsNEQ applies the NEQ predicate on the "payout_attempts" field.
+func PayoutAttemptsNEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldPayoutAttempts, v))
+}
+
+// PayoutAttemptsIn applies the In predicate on the "payout_attempts" field.
+func PayoutAttemptsIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldPayoutAttempts, vs...))
+}
+
+// PayoutAttemptsNotIn applies the NotIn predicate on the "payout_attempts" field.
+func PayoutAttemptsNotIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldPayoutAttempts, vs...))
+}
+
+// PayoutAttemptsGT applies the GT predicate on the "payout_attempts" field.
+func PayoutAttemptsGT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldPayoutAttempts, v))
+}
+
+// PayoutAttemptsGTE applies the GTE predicate on the "payout_attempts" field.
+func PayoutAttemptsGTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldPayoutAttempts, v))
+}
+
+// PayoutAttemptsLT applies the LT predicate on the "payout_attempts" field.
+func PayoutAttemptsLT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldPayoutAttempts, v))
+}
+
+// PayoutAttemptsLTE applies the LTE predicate on the "payout_attempts" field.
+func PayoutAttemptsLTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldPayoutAttempts, v))
+}
+
+// NextPayoutAtEQ applies the EQ predicate on the "next_payout_at" field.
+func NextPayoutAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldNextPayoutAt, v))
+}
+
+// NextPayoutAtNEQ applies the NEQ predicate on the "next_payout_at" field.
+func NextPayoutAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldNextPayoutAt, v))
+}
+
+// NextPayoutAtIn applies the In predicate on the "next_payout_at" field.
+func NextPayoutAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldNextPayoutAt, vs...))
+}
+
+// NextPayoutAtNotIn applies the NotIn predicate on the "next_payout_at" field.
+func NextPayoutAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldNextPayoutAt, vs...))
+}
+
+// NextPayoutAtGT applies the GT predicate on the "next_payout_at" field.
+func NextPayoutAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldNextPayoutAt, v))
+}
+
+// NextPayoutAtGTE applies the GTE predicate on the "next_payout_at" field.
+func NextPayoutAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldNextPayoutAt, v))
+}
+
+// NextPayoutAtLT applies the LT predicate on the "next_payout_at" field.
+func NextPayoutAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldNextPayoutAt, v))
+}
+
+// NextPayoutAtLTE applies the LTE predicate on the "next_payout_at" field.
+func NextPayoutAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldNextPayoutAt, v))
+}
+
+// NextPayoutAtIsNil applies the IsNil predicate on the "next_payout_at" field.
+func NextPayoutAtIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldNextPayoutAt))
+}
+
+// NextPayoutAtNotNil applies the NotNil predicate on the "next_payout_at" field.
+func NextPayoutAtNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldNextPayoutAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.NotPredicates(p))
+}
@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/referral"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReferralCreate is the builder for creating a Referral entity.
+type ReferralCreate struct {
+	config
+	mutation *ReferralMutation
+	hooks    []Hook
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (rc *ReferralCreate) SetReferrerID(i int) *ReferralCreate {
+	rc.mutation.SetReferrerID(i)
+	return rc
+}
+
+// SetReferredID sets the "referred_id" field.
+func (rc *ReferralCreate) SetReferredID(i int) *ReferralCreate {
+	rc.mutation.SetReferredID(i)
+	return rc
+}
+
+// SetCode sets the "code" field.
+func (rc *ReferralCreate) SetCode(s string) *ReferralCreate {
+	rc.mutation.SetCode(s)
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReferralCreate) SetStatus(r referral.Status) *ReferralCreate {
+	rc.mutation.SetStatus(r)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableStatus(r *referral.Status) *ReferralCreate {
+	if r != nil {
+		rc.SetStatus(*r)
+	}
+	return rc
+}
+
+// SetReferrerBonus sets the "referrer_bonus" field.
+func (rc *ReferralCreate) SetReferrerBonus(f float64) *ReferralCreate {
+	rc.mutation.SetReferrerBonus(f)
+	return rc
+}
+
+// SetNillableReferrerBonus sets the "referrer_bonus" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableReferrerBonus(f *float64) *ReferralCreate {
+	if f != nil {
+		rc.SetReferrerBonus(*f)
+	}
+	return rc
+}
+
+// SetReferredBonus sets the "referred_bonus" field.
+func (rc *ReferralCreate) SetReferredBonus(f float64) *ReferralCreate {
+	rc.mutation.SetReferredBonus(f)
+	return rc
+}
+
+// SetNillableReferredBonus sets the "referred_bonus" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableReferredBonus(f *float64) *ReferralCreate {
+	if f != nil {
+		rc.SetReferredBonus(*f)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReferralCreate) SetCreatedAt(t time.Time) *ReferralCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableCreatedAt(t *time.Time) *ReferralCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetQualifiedAt sets the "qualified_at" field.
+func (rc *ReferralCreate) SetQualifiedAt(t time.Time) *ReferralCreate {
+	rc.mutation.SetQualifiedAt(t)
+	return rc
+}
+
+// SetNillableQualifiedAt sets the "qualified_at" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableQualifiedAt(t *time.Time) *ReferralCreate {
+	if t != nil {
+		rc.SetQualifiedAt(*t)
+	}
+	return rc
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (rc *ReferralCreate) SetPaidAt(t time.Time) *ReferralCreate {
+	rc.mutation.SetPaidAt(t)
+	return rc
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillablePaidAt(t *time.Time) *ReferralCreate {
+	if t != nil {
+		rc.SetPaidAt(*t)
+	}
+	return rc
+}
+
+// SetPayoutClaimedAt sets the "payout_claimed_at" field.
+func (rc *ReferralCreate) SetPayoutClaimedAt(t time.Time) *ReferralCreate {
+	rc.mutation.SetPayoutClaimedAt(t)
+	return rc
+}
+
+// SetNillablePayoutClaimedAt sets the "payout_claimed_at" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillablePayoutClaimedAt(t *time.Time) *ReferralCreate {
+	if t != nil {
+		rc.SetPayoutClaimedAt(*t)
+	}
+	return rc
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (rc *ReferralCreate) SetCheckedAt(t time.Time) *ReferralCreate {
+	rc.mutation.SetCheckedAt(t)
+	return rc
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableCheckedAt(t *time.Time) *ReferralCreate {
+	if t != nil {
+		rc.SetCheckedAt(*t)
+	}
+	return rc
+}
+
+// SetPayoutError sets the "payout_error" field.
+func (rc *ReferralCreate) SetPayoutError(s string) *ReferralCreate {
+	rc.mutation.SetPayoutError(s)
+	return rc
+}
+
+// SetNillablePayoutError sets the "payout_error" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillablePayoutError(s *string) *ReferralCreate {
+	if s != nil {
+		rc.SetPayoutError(*s)
+	}
+	return rc
+}
+
+// SetPayoutAttempts sets the "payout_attempts" field.
+func (rc *ReferralCreate) SetPayoutAttempts(i int) *ReferralCreate {
+	rc.mutation.SetPayoutAttempts(i)
+	return rc
+}
+
+// SetNillablePayoutAttempts sets the "payout_attempts" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillablePayoutAttempts(i *int) *ReferralCreate {
+	if i != nil {
+		rc.SetPayoutAttempts(*i)
+	}
+	return rc
+}
+
+// SetNextPayoutAt sets the "next_payout_at" field.
+func (rc *ReferralCreate) SetNextPayoutAt(t time.Time) *ReferralCreate {
+	rc.mutation.SetNextPayoutAt(t)
+	return rc
+}
+
+// SetNillableNextPayoutAt sets the "next_payout_at" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableNextPayoutAt(t *time.Time) *ReferralCreate {
+	if t != nil {
+		rc.SetNextPayoutAt(*t)
+	}
+	return rc
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (rc *ReferralCreate) Mutation() *ReferralMutation {
+	return rc.mutation
+}
+
+// Save creates the Referral in the database.
+func (rc *ReferralCreate) Save(ctx context.Context) (*Referral, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReferralCreate) SaveX(ctx context.Context) *Referral {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReferralCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReferralCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReferralCreate) defaults() {
+	if _, ok := rc.mutation.Status(); !ok {
+		v := referral.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.ReferrerBonus(); !ok {
+		v := referral.DefaultReferrerBonus
+		rc.mutation.SetReferrerBonus(v)
+	}
+	if _, ok := rc.mutation.ReferredBonus(); !ok {
+		v := referral.DefaultReferredBonus
+		rc.mutation.SetReferredBonus(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := referral.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.PayoutAttempts(); !ok {
+		v := referral.DefaultPayoutAttempts
+		rc.mutation.SetPayoutAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReferralCreate) check() error {
+	if _, ok := rc.mutation.ReferrerID(); !ok {
+		return &ValidationError{Name: "referrer_id", err: errors.New(`ent: missing required field "Referral.referrer_id"`)}
+	}
+	if _, ok := rc.mutation.ReferredID(); !ok {
+		return &ValidationError{Name: "referred_id", err: errors.New(`ent: missing required field "Referral.referred_id"`)}
+	}
+	if _, ok := rc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Referral.code"`)}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Referral.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := referral.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Referral.status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.ReferrerBonus(); !ok {
+		return &ValidationError{Name: "referrer_bonus", err: errors.New(`ent: missing required field "Referral.referrer_bonus"`)}
+	}
+	if _, ok := rc.mutation.ReferredBonus(); !ok {
+		return &ValidationError{Name: "referred_bonus", err: errors.New(`ent: missing required field "Referral.referred_bonus"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Referral.created_at"`)}
+	}
+	if _, ok := rc.mutation.PayoutAttempts(); !ok {
+		return &ValidationError{Name: "payout_attempts", err: errors.New(`ent: missing required field "Referral.payout_attempts"`)}
+	}
+	return nil
+}
+
+func (rc *ReferralCreate) sqlSave(ctx context.Context) (*Referral, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReferralCreate) createSpec() (*Referral, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Referral{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(referral.Table, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt))
+	)
+	if value, ok := rc.mutation.ReferrerID(); ok {
+		_spec.SetField(referral.FieldReferrerID, field.TypeInt, value)
+		_node.ReferrerID = value
+	}
+	if value, ok := rc.mutation.ReferredID(); ok {
+		_spec.SetField(referral.FieldReferredID, field.TypeInt, value)
+		_node.ReferredID = value
+	}
+	if value, ok := rc.mutation.Code(); ok {
+		_spec.SetField(referral.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(referral.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.ReferrerBonus(); ok {
+		_spec.SetField(referral.FieldReferrerBonus, field.TypeFloat64, value)
+		_node.ReferrerBonus = value
+	}
+	if value, ok := rc.mutation.ReferredBonus(); ok {
+		_spec.SetField(referral.FieldReferredBonus, field.TypeFloat64, value)
+		_node.ReferredBonus = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(referral.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.QualifiedAt(); ok {
+		_spec.SetField(referral.FieldQualifiedAt, field.TypeTime, value)
+		_node.QualifiedAt = &value
+	}
+	if value, ok := rc.mutation.PaidAt(); ok {
+		_spec.SetField(referral.FieldPaidAt, field.TypeTime, value)
+		_node.PaidAt = &value
+	}
+	if value, ok := rc.mutation.PayoutClaimedAt(); ok {
+		_spec.SetField(referral.FieldPayoutClaimedAt, field.TypeTime, value)
+		_node.PayoutClaimedAt = &value
+	}
+	if value, ok := rc.mutation.CheckedAt(); ok {
+		_spec.SetField(referral.FieldCheckedAt, field.TypeTime, value)
+		_node.CheckedAt = &value
+	}
+	if value, ok := rc.mutation.PayoutError(); ok {
+		_spec.SetField(referral.FieldPayoutError, field.TypeString, value)
+		_node.PayoutError = value
+	}
+	if value, ok := rc.mutation.PayoutAttempts(); ok {
+		_spec.SetField(referral.FieldPayoutAttempts, field.TypeInt, value)
+		_node.PayoutAttempts = value
+	}
+	if value, ok := rc.mutation.NextPayoutAt(); ok {
+		_spec.SetField(referral.FieldNextPayoutAt, field.TypeTime, value)
+		_node.NextPayoutAt = &value
+	}
+	return _node, _spec
+}
+
+// ReferralCreateBulk is the builder for creating many Referral entities in bulk.
+type ReferralCreateBulk struct {
+	config
+	err      error
+	builders []*ReferralCreate
+}
+
+// Save creates the Referral entities in the database.
+func (rcb *ReferralCreateBulk) Save(ctx context.Context) ([]*Referral, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Referral, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReferralMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReferralCreateBulk) SaveX(ctx context.Context) []*Referral {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReferralCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReferralCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,710 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/predicate"
+	"user-service/ent/referral"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReferralUpdate is the builder for updating Referral entities.
+type ReferralUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReferralMutation
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (ru *ReferralUpdate) Where(ps ...predicate.Referral) *ReferralUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReferralUpdate) SetStatus(r referral.Status) *ReferralUpdate {
+	ru.mutation.SetStatus(r)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableStatus(r *referral.Status) *ReferralUpdate {
+	if r != nil {
+		ru.SetStatus(*r)
+	}
+	return ru
+}
+
+// SetReferrerBonus sets the "referrer_bonus" field.
+func (ru *ReferralUpdate) SetReferrerBonus(f float64) *ReferralUpdate {
+	ru.mutation.ResetReferrerBonus()
+	ru.mutation.SetReferrerBonus(f)
+	return ru
+}
+
+// SetNillableReferrerBonus sets the "referrer_bonus" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableReferrerBonus(f *float64) *ReferralUpdate {
+	if f != nil {
+		ru.SetReferrerBonus(*f)
+	}
+	return ru
+}
+
+// AddReferrerBonus adds f to the "referrer_bonus" field.
+func (ru *ReferralUpdate) AddReferrerBonus(f float64) *ReferralUpdate {
+	ru.mutation.AddReferrerBonus(f)
+	return ru
+}
+
+// SetReferredBonus sets the "referred_bonus" field.
+func (ru *ReferralUpdate) SetReferredBonus(f float64) *ReferralUpdate {
+	ru.mutation.ResetReferredBonus()
+	ru.mutation.SetReferredBonus(f)
+	return ru
+}
+
+// SetNillableReferredBonus sets the "referred_bonus" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableReferredBonus(f *float64) *ReferralUpdate {
+	if f != nil {
+		ru.SetReferredBonus(*f)
+	}
+	return ru
+}
+
+// AddReferredBonus adds f to the "referred_bonus" field.
+func (ru *ReferralUpdate) AddReferredBonus(f float64) *ReferralUpdate {
+	ru.mutation.AddReferredBonus(f)
+	return ru
+}
+
+// SetQualifiedAt sets the "qualified_at" field.
+func (ru *ReferralUpdate) SetQualifiedAt(t time.Time) *ReferralUpdate {
+	ru.mutation.SetQualifiedAt(t)
+	return ru
+}
+
+// SetNillableQualifiedAt sets the "qualified_at" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableQualifiedAt(t *time.Time) *ReferralUpdate {
+	if t != nil {
+		ru.SetQualifiedAt(*t)
+	}
+	return ru
+}
+
+// ClearQualifiedAt clears the value of the "qualified_at" field.
+func (ru *ReferralUpdate) ClearQualifiedAt() *ReferralUpdate {
+	ru.mutation.ClearQualifiedAt()
+	return ru
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (ru *ReferralUpdate) SetPaidAt(t time.Time) *ReferralUpdate {
+	ru.mutation.SetPaidAt(t)
+	return ru
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillablePaidAt(t *time.Time) *ReferralUpdate {
+	if t != nil {
+		ru.SetPaidAt(*t)
+	}
+	return ru
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (ru *ReferralUpdate) ClearPaidAt() *ReferralUpdate {
+	ru.mutation.ClearPaidAt()
+	return ru
+}
+
+// SetPayoutClaimedAt sets the "payout_claimed_at" field.
+func (ru *ReferralUpdate) SetPayoutClaimedAt(t time.Time) *ReferralUpdate {
+	ru.mutation.SetPayoutClaimedAt(t)
+	return ru
+}
+
+// SetNillablePayoutClaimedAt sets the "payout_claimed_at" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillablePayoutClaimedAt(t *time.Time) *ReferralUpdate {
+	if t != nil {
+		ru.SetPayoutClaimedAt(*t)
+	}
+	return ru
+}
+
+// ClearPayoutClaimedAt clears the value of the "payout_claimed_at" field.
+func (ru *ReferralUpdate) ClearPayoutClaimedAt() *ReferralUpdate {
+	ru.mutation.ClearPayoutClaimedAt()
+	return ru
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (ru *ReferralUpdate) SetCheckedAt(t time.Time) *ReferralUpdate {
+	ru.mutation.SetCheckedAt(t)
+	return ru
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableCheckedAt(t *time.Time) *ReferralUpdate {
+	if t != nil {
+		ru.SetCheckedAt(*t)
+	}
+	return ru
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (ru *ReferralUpdate) ClearCheckedAt() *ReferralUpdate {
+	ru.mutation.ClearCheckedAt()
+	return ru
+}
+
+// SetPayoutError sets the "payout_error" field.
+func (ru *ReferralUpdate) SetPayoutError(s string) *ReferralUpdate {
+	ru.mutation.SetPayoutError(s)
+	return ru
+}
+
+// SetNillablePayoutError sets the "payout_error" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillablePayoutError(s *string) *ReferralUpdate {
+	if s != nil {
+		ru.SetPayoutError(*s)
+	}
+	return ru
+}
+
+// ClearPayoutError clears the value of the "payout_error" field.
+func (ru *ReferralUpdate) ClearPayoutError() *ReferralUpdate {
+	ru.mutation.ClearPayoutError()
+	return ru
+}
+
+// SetPayoutAttempts sets the "payout_attempts" field.
+func (ru *ReferralUpdate) SetPayoutAttempts(i int) *ReferralUpdate {
+	ru.mutation.ResetPayoutAttempts()
+	ru.mutation.SetPayoutAttempts(i)
+	return ru
+}
+
+// SetNillablePayoutAttempts sets the "payout_attempts" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillablePayoutAttempts(i *int) *ReferralUpdate {
+	if i != nil {
+		ru.SetPayoutAttempts(*i)
+	}
+	return ru
+}
+
+// AddPayoutAttempts adds i to the "payout_attempts" field.
+func (ru *ReferralUpdate) AddPayoutAttempts(i int) *ReferralUpdate {
+	ru.mutation.AddPayoutAttempts(i)
+	return ru
+}
+
+// SetNextPayoutAt sets the "next_payout_at" field.
+func (ru *ReferralUpdate) SetNextPayoutAt(t time.Time) *ReferralUpdate {
+	ru.mutation.SetNextPayoutAt(t)
+	return ru
+}
+
+// SetNillableNextPayoutAt sets the "next_payout_at" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableNextPayoutAt(t *time.Time) *ReferralUpdate {
+	if t != nil {
+		ru.SetNextPayoutAt(*t)
+	}
+	return ru
+}
+
+// ClearNextPayoutAt clears the value of the "next_payout_at" field.
+func (ru *ReferralUpdate) ClearNextPayoutAt() *ReferralUpdate {
+	ru.mutation.ClearNextPayoutAt()
+	return ru
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (ru *ReferralUpdate) Mutation() *ReferralMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReferralUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReferralUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReferralUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReferralUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReferralUpdate) check() error {
+	if v, ok := ru.mutation.Status(); ok {
+		if err := referral.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Referral.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ru *ReferralUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(referral.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.ReferrerBonus(); ok {
+		_spec.SetField(referral.FieldReferrerBonus, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.AddedReferrerBonus(); ok {
+		_spec.AddField(referral.FieldReferrerBonus, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.ReferredBonus(); ok {
+		_spec.SetField(referral.FieldReferredBonus, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.AddedReferredBonus(); ok {
+		_spec.AddField(referral.FieldReferredBonus, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.QualifiedAt(); ok {
+		_spec.SetField(referral.FieldQualifiedAt, field.TypeTime, value)
+	}
+	if ru.mutation.QualifiedAtCleared() {
+		_spec.ClearField(referral.FieldQualifiedAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.PaidAt(); ok {
+		_spec.SetField(referral.FieldPaidAt, field.TypeTime, value)
+	}
+	if ru.mutation.PaidAtCleared() {
+		_spec.ClearField(referral.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.PayoutClaimedAt(); ok {
+		_spec.SetField(referral.FieldPayoutClaimedAt, field.TypeTime, value)
+	}
+	if ru.mutation.PayoutClaimedAtCleared() {
+		_spec.ClearField(referral.FieldPayoutClaimedAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.CheckedAt(); ok {
+		_spec.SetField(referral.FieldCheckedAt, field.TypeTime, value)
+	}
+	if ru.mutation.CheckedAtCleared() {
+		_spec.ClearField(referral.FieldCheckedAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.PayoutError(); ok {
+		_spec.SetField(referral.FieldPayoutError, field.TypeString, value)
+	}
+	if ru.mutation.PayoutErrorCleared() {
+		_spec.ClearField(referral.FieldPayoutError, field.TypeString)
+	}
+	if value, ok := ru.mutation.PayoutAttempts(); ok {
+		_spec.SetField(referral.FieldPayoutAttempts, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedPayoutAttempts(); ok {
+		_spec.AddField(referral.FieldPayoutAttempts, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.NextPayoutAt(); ok {
+		_spec.SetField(referral.FieldNextPayoutAt, field.TypeTime, value)
+	}
+	if ru.mutation.NextPayoutAtCleared() {
+		_spec.ClearField(referral.FieldNextPayoutAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReferralUpdateOne is the builder for updating a single Referral entity.
+type ReferralUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReferralMutation
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReferralUpdateOne) SetStatus(r referral.Status) *ReferralUpdateOne {
+	ruo.mutation.SetStatus(r)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableStatus(r *referral.Status) *ReferralUpdateOne {
+	if r != nil {
+		ruo.SetStatus(*r)
+	}
+	return ruo
+}
+
+// SetReferrerBonus sets the "referrer_bonus" field.
+func (ruo *ReferralUpdateOne) SetReferrerBonus(f float64) *ReferralUpdateOne {
+	ruo.mutation.ResetReferrerBonus()
+	ruo.mutation.SetReferrerBonus(f)
+	return ruo
+}
+
+// SetNillableReferrerBonus sets the "referrer_bonus" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableReferrerBonus(f *float64) *ReferralUpdateOne {
+	if f != nil {
+		ruo.SetReferrerBonus(*f)
+	}
+	return ruo
+}
+
+// AddReferrerBonus adds f to the "referrer_bonus" field.
+func (ruo *ReferralUpdateOne) AddReferrerBonus(f float64) *ReferralUpdateOne {
+	ruo.mutation.AddReferrerBonus(f)
+	return ruo
+}
+
+// SetReferredBonus sets the "referred_bonus" field.
+func (ruo *ReferralUpdateOne) SetReferredBonus(f float64) *ReferralUpdateOne {
+	ruo.mutation.ResetReferredBonus()
+	ruo.mutation.SetReferredBonus(f)
+	return ruo
+}
+
+// SetNillableReferredBonus sets the "referred_bonus" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableReferredBonus(f *float64) *ReferralUpdateOne {
+	if f != nil {
+		ruo.SetReferredBonus(*f)
+	}
+	return ruo
+}
+
+// AddReferredBonus adds f to the "referred_bonus" field.
+func (ruo *ReferralUpdateOne) AddReferredBonus(f float64) *ReferralUpdateOne {
+	ruo.mutation.AddReferredBonus(f)
+	return ruo
+}
+
+// SetQualifiedAt sets the "qualified_at" field.
+func (ruo *ReferralUpdateOne) SetQualifiedAt(t time.Time) *ReferralUpdateOne {
+	ruo.mutation.SetQualifiedAt(t)
+	return ruo
+}
+
+// SetNillableQualifiedAt sets the "qualified_at" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableQualifiedAt(t *time.Time) *ReferralUpdateOne {
+	if t != nil {
+		ruo.SetQualifiedAt(*t)
+	}
+	return ruo
+}
+
+// ClearQualifiedAt clears the value of the "qualified_at" field.
+func (ruo *ReferralUpdateOne) ClearQualifiedAt() *ReferralUpdateOne {
+	ruo.mutation.ClearQualifiedAt()
+	return ruo
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (ruo *ReferralUpdateOne) SetPaidAt(t time.Time) *ReferralUpdateOne {
+	ruo.mutation.SetPaidAt(t)
+	return ruo
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillablePaidAt(t *time.Time) *ReferralUpdateOne {
+	if t != nil {
+		ruo.SetPaidAt(*t)
+	}
+	return ruo
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (ruo *ReferralUpdateOne) ClearPaidAt() *ReferralUpdateOne {
+	ruo.mutation.ClearPaidAt()
+	return ruo
+}
+
+// SetPayoutClaimedAt sets the "payout_claimed_at" field.
+func (ruo *ReferralUpdateOne) SetPayoutClaimedAt(t time.Time) *ReferralUpdateOne {
+	ruo.mutation.SetPayoutClaimedAt(t)
+	return ruo
+}
+
+// SetNillablePayoutClaimedAt sets the "payout_claimed_at" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillablePayoutClaimedAt(t *time.Time) *ReferralUpdateOne {
+	if t != nil {
+		ruo.SetPayoutClaimedAt(*t)
+	}
+	return ruo
+}
+
+// ClearPayoutClaimedAt clears the value of the "payout_claimed_at" field.
+func (ruo *ReferralUpdateOne) ClearPayoutClaimedAt() *ReferralUpdateOne {
+	ruo.mutation.ClearPayoutClaimedAt()
+	return ruo
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (ruo *ReferralUpdateOne) SetCheckedAt(t time.Time) *ReferralUpdateOne {
+	ruo.mutation.SetCheckedAt(t)
+	return ruo
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableCheckedAt(t *time.Time) *ReferralUpdateOne {
+	if t != nil {
+		ruo.SetCheckedAt(*t)
+	}
+	return ruo
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (ruo *ReferralUpdateOne) ClearCheckedAt() *ReferralUpdateOne {
+	ruo.mutation.ClearCheckedAt()
+	return ruo
+}
+
+// SetPayoutError sets the "payout_error" field.
+func (ruo *ReferralUpdateOne) SetPayoutError(s string) *ReferralUpdateOne {
+	ruo.mutation.SetPayoutError(s)
+	return ruo
+}
+
+// SetNillablePayoutError sets the "payout_error" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillablePayoutError(s *string) *ReferralUpdateOne {
+	if s != nil {
+		ruo.SetPayoutError(*s)
+	}
+	return ruo
+}
+
+// ClearPayoutError clears the value of the "payout_error" field.
+func (ruo *ReferralUpdateOne) ClearPayoutError() *ReferralUpdateOne {
+	ruo.mutation.ClearPayoutError()
+	return ruo
+}
+
+// SetPayoutAttempts sets the "payout_attempts" field.
+func (ruo *ReferralUpdateOne) SetPayoutAttempts(i int) *ReferralUpdateOne {
+	ruo.mutation.ResetPayoutAttempts()
+	ruo.mutation.SetPayoutAttempts(i)
+	return ruo
+}
+
+// SetNillablePayoutAttempts sets the "payout_attempts" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillablePayoutAttempts(i *int) *ReferralUpdateOne {
+	if i != nil {
+		ruo.SetPayoutAttempts(*i)
+	}
+	return ruo
+}
+
+// AddPayoutAttempts adds i to the "payout_attempts" field.
+func (ruo *ReferralUpdateOne) AddPayoutAttempts(i int) *ReferralUpdateOne {
+	ruo.mutation.AddPayoutAttempts(i)
+	return ruo
+}
+
+// SetNextPayoutAt sets the "next_payout_at" field.
+func (ruo *ReferralUpdateOne) SetNextPayoutAt(t time.Time) *ReferralUpdateOne {
+	ruo.mutation.SetNextPayoutAt(t)
+	return ruo
+}
+
+// SetNillableNextPayoutAt sets the "next_payout_at" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableNextPayoutAt(t *time.Time) *ReferralUpdateOne {
+	if t != nil {
+		ruo.SetNextPayoutAt(*t)
+	}
+	return ruo
+}
+
+// ClearNextPayoutAt clears the value of the "next_payout_at" field.
+func (ruo *ReferralUpdateOne) ClearNextPayoutAt() *ReferralUpdateOne {
+	ruo.mutation.ClearNextPayoutAt()
+	return ruo
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (ruo *ReferralUpdateOne) Mutation() *ReferralMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (ruo *ReferralUpdateOne) Where(ps ...predicate.Referral) *ReferralUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReferralUpdateOne) Select(field string, fields ...string) *ReferralUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Referral entity.
+func (ruo *ReferralUpdateOne) Save(ctx context.Context) (*Referral, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReferralUpdateOne) SaveX(ctx context.Context) *Referral {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReferralUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReferralUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReferralUpdateOne) check() error {
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := referral.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Referral.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ruo *ReferralUpdateOne) sqlSave(ctx context.Context) (_node *Referral, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Referral.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, referral.FieldID)
+		for _, f := range fields {
+			if !referral.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != referral.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(referral.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.ReferrerBonus(); ok {
+		_spec.SetField(referral.FieldReferrerBonus, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.AddedReferrerBonus(); ok {
+		_spec.AddField(referral.FieldReferrerBonus, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.ReferredBonus(); ok {
+		_spec.SetField(referral.FieldReferredBonus, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.AddedReferredBonus(); ok {
+		_spec.AddField(referral.FieldReferredBonus, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.QualifiedAt(); ok {
+		_spec.SetField(referral.FieldQualifiedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.QualifiedAtCleared() {
+		_spec.ClearField(referral.FieldQualifiedAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.PaidAt(); ok {
+		_spec.SetField(referral.FieldPaidAt, field.TypeTime, value)
+	}
+	if ruo.mutation.PaidAtCleared() {
+		_spec.ClearField(referral.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.PayoutClaimedAt(); ok {
+		_spec.SetField(referral.FieldPayoutClaimedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.PayoutClaimedAtCleared() {
+		_spec.ClearField(referral.FieldPayoutClaimedAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.CheckedAt(); ok {
+		_spec.SetField(referral.FieldCheckedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.CheckedAtCleared() {
+		_spec.ClearField(referral.FieldCheckedAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.PayoutError(); ok {
+		_spec.SetField(referral.FieldPayoutError, field.TypeString, value)
+	}
+	if ruo.mutation.PayoutErrorCleared() {
+		_spec.ClearField(referral.FieldPayoutError, field.TypeString)
+	}
+	if value, ok := ruo.mutation.PayoutAttempts(); ok {
+		_spec.SetField(referral.FieldPayoutAttempts, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedPayoutAttempts(); ok {
+		_spec.AddField(referral.FieldPayoutAttempts, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.NextPayoutAt(); ok {
+		_spec.SetField(referral.FieldNextPayoutAt, field.TypeTime, value)
+	}
+	if ruo.mutation.NextPayoutAtCleared() {
+		_spec.ClearField(referral.FieldNextPayoutAt, field.TypeTime)
+	}
+	_node = &Referral{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referralcode
+
+import (
+	"time"
+	"user-service/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldEQ(FieldUserID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldEQ(FieldCode, v))
+}
+
+// Rewarded applies equality check predicate on the "rewarded" field. It's identical to RewardedEQ.
+func Rewarded(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldEQ(FieldRewarded, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldLTE(FieldUserID, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldContainsFold(FieldCode, v))
+}
+
+// RewardedEQ applies the EQ predicate on the "rewarded" field.
+func RewardedEQ(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldEQ(FieldRewarded, v))
+}
+
+// RewardedNEQ applies the NEQ predicate on the "rewarded" field.
+func RewardedNEQ(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldNEQ(FieldRewarded, v))
+}
+
+// RewardedIn applies the In predicate on the "rewarded" field.
+func RewardedIn(vs ...int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldIn(FieldRewarded, vs...))
+}
+
+// RewardedNotIn applies the NotIn predicate on the "rewarded" field.
+func RewardedNotIn(vs ...int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldNotIn(FieldRewarded, vs...))
+}
+
+// RewardedGT applies the GT predicate on the "rewarded" field.
+func RewardedGT(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldGT(FieldRewarded, v))
+}
+
+// RewardedGTE applies the GTE predicate on the "rewarded" field.
+func RewardedGTE(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldGTE(FieldRewarded, v))
+}
+
+// RewardedLT applies the LT predicate on the "rewarded" field.
+func RewardedLT(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldLT(FieldRewarded, v))
+}
+
+// RewardedLTE applies the LTE predicate on the "rewarded" field.
+func RewardedLTE(v int) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldLTE(FieldRewarded, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReferralCode) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReferralCode) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReferralCode) predicate.ReferralCode {
+	return predicate.ReferralCode(sql.NotPredicates(p))
+}
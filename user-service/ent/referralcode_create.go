@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/ent/referralcode"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReferralCodeCreate is the builder for creating a ReferralCode entity.
+type ReferralCodeCreate struct {
+	config
+	mutation *ReferralCodeMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (rcc *ReferralCodeCreate) SetUserID(i int) *ReferralCodeCreate {
+	rcc.mutation.SetUserID(i)
+	return rcc
+}
+
+// SetCode sets the "code" field.
+func (rcc *ReferralCodeCreate) SetCode(s string) *ReferralCodeCreate {
+	rcc.mutation.SetCode(s)
+	return rcc
+}
+
+// SetRewarded sets the "rewarded" field.
+func (rcc *ReferralCodeCreate) SetRewarded(i int) *ReferralCodeCreate {
+	rcc.mutation.SetRewarded(i)
+	return rcc
+}
+
+// SetNillableRewarded sets the "rewarded" field if the given value is not nil.
+func (rcc *ReferralCodeCreate) SetNillableRewarded(i *int) *ReferralCodeCreate {
+	if i != nil {
+		rcc.SetRewarded(*i)
+	}
+	return rcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcc *ReferralCodeCreate) SetCreatedAt(t time.Time) *ReferralCodeCreate {
+	rcc.mutation.SetCreatedAt(t)
+	return rcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcc *ReferralCodeCreate) SetNillableCreatedAt(t *time.Time) *ReferralCodeCreate {
+	if t != nil {
+		rcc.SetCreatedAt(*t)
+	}
+	return rcc
+}
+
+// Mutation returns the ReferralCodeMutation object of the builder.
+func (rcc *ReferralCodeCreate) Mutation() *ReferralCodeMutation {
+	return rcc.mutation
+}
+
+// Save creates the ReferralCode in the database.
+func (rcc *ReferralCodeCreate) Save(ctx context.Context) (*ReferralCode, error) {
+	rcc.defaults()
+	return withHooks(ctx, rcc.sqlSave, rcc.mutation, rcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcc *ReferralCodeCreate) SaveX(ctx context.Context) *ReferralCode {
+	v, err := rcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcc *ReferralCodeCreate) Exec(ctx context.Context) error {
+	_, err := rcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcc *ReferralCodeCreate) ExecX(ctx context.Context) {
+	if err := rcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcc *ReferralCodeCreate) defaults() {
+	if _, ok := rcc.mutation.Rewarded(); !ok {
+		v := referralcode.DefaultRewarded
+		rcc.mutation.SetRewarded(v)
+	}
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		v := referralcode.DefaultCreatedAt()
+		rcc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcc *ReferralCodeCreate) check() error {
+	if _, ok := rcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ReferralCode.user_id"`)}
+	}
+	if _, ok := rcc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "ReferralCode.code"`)}
+	}
+	if _, ok := rcc.mutation.Rewarded(); !ok {
+		return &ValidationError{Name: "rewarded", err: errors.New(`ent: missing required field "ReferralCode.rewarded"`)}
+	}
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReferralCode.created_at"`)}
+	}
+	return nil
+}
+
+func (rcc *ReferralCodeCreate) sqlSave(ctx context.Context) (*ReferralCode, error) {
+	if err := rcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rcc.mutation.id = &_node.ID
+	rcc.mutation.done = true
+	return _node, nil
+}
+
+func (rcc *ReferralCodeCreate) createSpec() (*ReferralCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReferralCode{config: rcc.config}
+		_spec = sqlgraph.NewCreateSpec(referralcode.Table, sqlgraph.NewFieldSpec(referralcode.FieldID, field.TypeInt))
+	)
+	if value, ok := rcc.mutation.UserID(); ok {
+		_spec.SetField(referralcode.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := rcc.mutation.Code(); ok {
+		_spec.SetField(referralcode.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := rcc.mutation.Rewarded(); ok {
+		_spec.SetField(referralcode.FieldRewarded, field.TypeInt, value)
+		_node.Rewarded = value
+	}
+	if value, ok := rcc.mutation.CreatedAt(); ok {
+		_spec.SetField(referralcode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ReferralCodeCreateBulk is the builder for creating many ReferralCode entities in bulk.
+type ReferralCodeCreateBulk struct {
+	config
+	err      error
+	builders []*ReferralCodeCreate
+}
+
+// Save creates the ReferralCode entities in the database.
+func (rccb *ReferralCodeCreateBulk) Save(ctx context.Context) ([]*ReferralCode, error) {
+	if rccb.err != nil {
+		return nil, rccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rccb.builders))
+	nodes := make([]*ReferralCode, len(rccb.builders))
+	mutators := make([]Mutator, len(rccb.builders))
+	for i := range rccb.builders {
+		func(i int, root context.Context) {
+			builder := rccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReferralCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rccb *ReferralCodeCreateBulk) SaveX(ctx context.Context) []*ReferralCode {
+	v, err := rccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rccb *ReferralCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := rccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rccb *ReferralCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := rccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
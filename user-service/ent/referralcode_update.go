@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"user-service/ent/predicate"
+	"user-service/ent/referralcode"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReferralCodeUpdate is the builder for updating ReferralCode entities.
+type ReferralCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReferralCodeMutation
+}
+
+// Where appends a list predicates to the ReferralCodeUpdate builder.
+func (rcu *ReferralCodeUpdate) Where(ps ...predicate.ReferralCode) *ReferralCodeUpdate {
+	rcu.mutation.Where(ps...)
+	return rcu
+}
+
+// SetRewarded sets the "rewarded" field.
+func (rcu *ReferralCodeUpdate) SetRewarded(i int) *ReferralCodeUpdate {
+	rcu.mutation.ResetRewarded()
+	rcu.mutation.SetRewarded(i)
+	return rcu
+}
+
+// SetNillableRewarded sets the "rewarded" field if the given value is not nil.
+func (rcu *ReferralCodeUpdate) SetNillableRewarded(i *int) *ReferralCodeUpdate {
+	if i != nil {
+		rcu.SetRewarded(*i)
+	}
+	return rcu
+}
+
+// AddRewarded adds i to the "rewarded" field.
+func (rcu *ReferralCodeUpdate) AddRewarded(i int) *ReferralCodeUpdate {
+	rcu.mutation.AddRewarded(i)
+	return rcu
+}
+
+// Mutation returns the ReferralCodeMutation object of the builder.
+func (rcu *ReferralCodeUpdate) Mutation() *ReferralCodeMutation {
+	return rcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rcu *ReferralCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rcu.sqlSave, rcu.mutation, rcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcu *ReferralCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := rcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rcu *ReferralCodeUpdate) Exec(ctx context.Context) error {
+	_, err := rcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcu *ReferralCodeUpdate) ExecX(ctx context.Context) {
+	if err := rcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rcu *ReferralCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(referralcode.Table, referralcode.Columns, sqlgraph.NewFieldSpec(referralcode.FieldID, field.TypeInt))
+	if ps := rcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcu.mutation.Rewarded(); ok {
+		_spec.SetField(referralcode.FieldRewarded, field.TypeInt, value)
+	}
+	if value, ok := rcu.mutation.AddedRewarded(); ok {
+		_spec.AddField(referralcode.FieldRewarded, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referralcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rcu.mutation.done = true
+	return n, nil
+}
+
+// ReferralCodeUpdateOne is the builder for updating a single ReferralCode entity.
+type ReferralCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReferralCodeMutation
+}
+
+// SetRewarded sets the "rewarded" field.
+func (rcuo *ReferralCodeUpdateOne) SetRewarded(i int) *ReferralCodeUpdateOne {
+	rcuo.mutation.ResetRewarded()
+	rcuo.mutation.SetRewarded(i)
+	return rcuo
+}
+
+// SetNillableRewarded sets the "rewarded" field if the given value is not nil.
+func (rcuo *ReferralCodeUpdateOne) SetNillableRewarded(i *int) *ReferralCodeUpdateOne {
+	if i != nil {
+		rcuo.SetRewarded(*i)
+	}
+	return rcuo
+}
+
+// AddRewarded adds i to the "rewarded" field.
+func (rcuo *ReferralCodeUpdateOne) AddRewarded(i int) *ReferralCodeUpdateOne {
+	rcuo.mutation.AddRewarded(i)
+	return rcuo
+}
+
+// Mutation returns the ReferralCodeMutation object of the builder.
+func (rcuo *ReferralCodeUpdateOne) Mutation() *ReferralCodeMutation {
+	return rcuo.mutation
+}
+
+// Where appends a list predicates to the ReferralCodeUpdate builder.
+func (rcuo *ReferralCodeUpdateOne) Where(ps ...predicate.ReferralCode) *ReferralCodeUpdateOne {
+	rcuo.mutation.Where(ps...)
+	return rcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rcuo *ReferralCodeUpdateOne) Select(field string, fields ...string) *ReferralCodeUpdateOne {
+	rcuo.fields = append([]string{field}, fields...)
+	return rcuo
+}
+
+// Save executes the query and returns the updated ReferralCode entity.
+func (rcuo *ReferralCodeUpdateOne) Save(ctx context.Context) (*ReferralCode, error) {
+	return withHooks(ctx, rcuo.sqlSave, rcuo.mutation, rcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcuo *ReferralCodeUpdateOne) SaveX(ctx context.Context) *ReferralCode {
+	node, err := rcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rcuo *ReferralCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := rcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcuo *ReferralCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := rcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rcuo *ReferralCodeUpdateOne) sqlSave(ctx context.Context) (_node *ReferralCode, err error) {
+	_spec := sqlgraph.NewUpdateSpec(referralcode.Table, referralcode.Columns, sqlgraph.NewFieldSpec(referralcode.FieldID, field.TypeInt))
+	id, ok := rcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReferralCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, referralcode.FieldID)
+		for _, f := range fields {
+			if !referralcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != referralcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcuo.mutation.Rewarded(); ok {
+		_spec.SetField(referralcode.FieldRewarded, field.TypeInt, value)
+	}
+	if value, ok := rcuo.mutation.AddedRewarded(); ok {
+		_spec.AddField(referralcode.FieldRewarded, field.TypeInt, value)
+	}
+	_node = &ReferralCode{config: rcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referralcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rcuo.mutation.done = true
+	return _node, nil
+}
@@ -4,18 +4,176 @@ package ent
 
 import (
 	"time"
+	"user-service/ent/alias"
+	"user-service/ent/apikey"
+	"user-service/ent/auditlog"
+	"user-service/ent/balanceprojection"
+	"user-service/ent/credential"
+	"user-service/ent/datarequest"
+	"user-service/ent/profilechange"
+	"user-service/ent/ratelimitstate"
+	"user-service/ent/recoverycode"
+	"user-service/ent/referral"
+	"user-service/ent/referralcode"
+	"user-service/ent/refreshtoken"
 	"user-service/ent/schema"
+	"user-service/ent/session"
+	"user-service/ent/signingkey"
 	"user-service/ent/user"
+
+	"github.com/google/uuid"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[1].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[9].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	aliasFields := schema.Alias{}.Fields()
+	_ = aliasFields
+	// aliasDescCreatedAt is the schema descriptor for created_at field.
+	aliasDescCreatedAt := aliasFields[3].Descriptor()
+	// alias.DefaultCreatedAt holds the default value on creation for the created_at field.
+	alias.DefaultCreatedAt = aliasDescCreatedAt.Default.(func() time.Time)
+	// aliasDescUpdatedAt is the schema descriptor for updated_at field.
+	aliasDescUpdatedAt := aliasFields[4].Descriptor()
+	// alias.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	alias.DefaultUpdatedAt = aliasDescUpdatedAt.Default.(func() time.Time)
+	// alias.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	alias.UpdateDefaultUpdatedAt = aliasDescUpdatedAt.UpdateDefault.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[6].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	balanceprojectionFields := schema.BalanceProjection{}.Fields()
+	_ = balanceprojectionFields
+	// balanceprojectionDescUpdatedAt is the schema descriptor for updated_at field.
+	balanceprojectionDescUpdatedAt := balanceprojectionFields[6].Descriptor()
+	// balanceprojection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	balanceprojection.DefaultUpdatedAt = balanceprojectionDescUpdatedAt.Default.(func() time.Time)
+	// balanceprojection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	balanceprojection.UpdateDefaultUpdatedAt = balanceprojectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	credentialFields := schema.Credential{}.Fields()
+	_ = credentialFields
+	// credentialDescFailedAttempts is the schema descriptor for failed_attempts field.
+	credentialDescFailedAttempts := credentialFields[3].Descriptor()
+	// credential.DefaultFailedAttempts holds the default value on creation for the failed_attempts field.
+	credential.DefaultFailedAttempts = credentialDescFailedAttempts.Default.(int)
+	// credentialDescTotpLastStep is the schema descriptor for totp_last_step field.
+	credentialDescTotpLastStep := credentialFields[8].Descriptor()
+	// credential.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	credential.DefaultTotpLastStep = credentialDescTotpLastStep.Default.(int64)
+	// credentialDescCreatedAt is the schema descriptor for created_at field.
+	credentialDescCreatedAt := credentialFields[9].Descriptor()
+	// credential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credential.DefaultCreatedAt = credentialDescCreatedAt.Default.(func() time.Time)
+	datarequestFields := schema.DataRequest{}.Fields()
+	_ = datarequestFields
+	// datarequestDescAttempts is the schema descriptor for attempts field.
+	datarequestDescAttempts := datarequestFields[5].Descriptor()
+	// datarequest.DefaultAttempts holds the default value on creation for the attempts field.
+	datarequest.DefaultAttempts = datarequestDescAttempts.Default.(int)
+	// datarequestDescCreatedAt is the schema descriptor for created_at field.
+	datarequestDescCreatedAt := datarequestFields[9].Descriptor()
+	// datarequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	datarequest.DefaultCreatedAt = datarequestDescCreatedAt.Default.(func() time.Time)
+	// datarequestDescID is the schema descriptor for id field.
+	datarequestDescID := datarequestFields[0].Descriptor()
+	// datarequest.DefaultID holds the default value on creation for the id field.
+	datarequest.DefaultID = datarequestDescID.Default.(func() uuid.UUID)
+	profilechangeFields := schema.ProfileChange{}.Fields()
+	_ = profilechangeFields
+	// profilechangeDescChangedAt is the schema descriptor for changed_at field.
+	profilechangeDescChangedAt := profilechangeFields[5].Descriptor()
+	// profilechange.DefaultChangedAt holds the default value on creation for the changed_at field.
+	profilechange.DefaultChangedAt = profilechangeDescChangedAt.Default.(func() time.Time)
+	ratelimitstateFields := schema.RateLimitState{}.Fields()
+	_ = ratelimitstateFields
+	// ratelimitstateDescVersion is the schema descriptor for version field.
+	ratelimitstateDescVersion := ratelimitstateFields[4].Descriptor()
+	// ratelimitstate.DefaultVersion holds the default value on creation for the version field.
+	ratelimitstate.DefaultVersion = ratelimitstateDescVersion.Default.(int)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[3].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	referralFields := schema.Referral{}.Fields()
+	_ = referralFields
+	// referralDescReferrerBonus is the schema descriptor for referrer_bonus field.
+	referralDescReferrerBonus := referralFields[4].Descriptor()
+	// referral.DefaultReferrerBonus holds the default value on creation for the referrer_bonus field.
+	referral.DefaultReferrerBonus = referralDescReferrerBonus.Default.(float64)
+	// referralDescReferredBonus is the schema descriptor for referred_bonus field.
+	referralDescReferredBonus := referralFields[5].Descriptor()
+	// referral.DefaultReferredBonus holds the default value on creation for the referred_bonus field.
+	referral.DefaultReferredBonus = referralDescReferredBonus.Default.(float64)
+	// referralDescCreatedAt is the schema descriptor for created_at field.
+	referralDescCreatedAt := referralFields[6].Descriptor()
+	// referral.DefaultCreatedAt holds the default value on creation for the created_at field.
+	referral.DefaultCreatedAt = referralDescCreatedAt.Default.(func() time.Time)
+	// referralDescPayoutAttempts is the schema descriptor for payout_attempts field.
+	referralDescPayoutAttempts := referralFields[12].Descriptor()
+	// referral.DefaultPayoutAttempts holds the default value on creation for the payout_attempts field.
+	referral.DefaultPayoutAttempts = referralDescPayoutAttempts.Default.(int)
+	referralcodeFields := schema.ReferralCode{}.Fields()
+	_ = referralcodeFields
+	// referralcodeDescRewarded is the schema descriptor for rewarded field.
+	referralcodeDescRewarded := referralcodeFields[2].Descriptor()
+	// referralcode.DefaultRewarded holds the default value on creation for the rewarded field.
+	referralcode.DefaultRewarded = referralcodeDescRewarded.Default.(int)
+	// referralcodeDescCreatedAt is the schema descriptor for created_at field.
+	referralcodeDescCreatedAt := referralcodeFields[3].Descriptor()
+	// referralcode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	referralcode.DefaultCreatedAt = referralcodeDescCreatedAt.Default.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[5].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[5].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	sessionDescLastSeenAt := sessionFields[6].Descriptor()
+	// session.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	session.DefaultLastSeenAt = sessionDescLastSeenAt.Default.(func() time.Time)
+	signingkeyFields := schema.SigningKey{}.Fields()
+	_ = signingkeyFields
+	// signingkeyDescCreatedAt is the schema descriptor for created_at field.
+	signingkeyDescCreatedAt := signingkeyFields[3].Descriptor()
+	// signingkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signingkey.DefaultCreatedAt = signingkeyDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	// userDescPhoneCodeAttempts is the schema descriptor for phone_code_attempts field.
+	userDescPhoneCodeAttempts := userFields[9].Descriptor()
+	// user.DefaultPhoneCodeAttempts holds the default value on creation for the phone_code_attempts field.
+	user.DefaultPhoneCodeAttempts = userDescPhoneCodeAttempts.Default.(int)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[2].Descriptor()
+	userDescCreatedAt := userFields[13].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[14].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
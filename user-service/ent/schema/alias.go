@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Alias holds the schema definition for the Alias entity, a handle, email or
+// phone number that payers can send money to instead of a wallet ID.
+type Alias struct {
+	ent.Schema
+}
+
+// Fields of the Alias.
+func (Alias) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Immutable(),
+		field.Enum("type").Values("handle", "email", "phone").Immutable(),
+		// value is normalized: handles in lower case without the leading @,
+		// phone numbers in E.164 format.
+		field.String("value"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Alias.
+func (Alias) Edges() []ent.Edge { return nil }
+
+// Indexes of the Alias.
+func (Alias) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user has at most one alias of each type, and an alias belongs to
+		// a single user.
+		index.Fields("user_id", "type").Unique(),
+		index.Fields("type", "value").Unique(),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity, a record of
+// a privileged action and the operator who performed it.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		// actor is the subject of the operator's token.
+		field.String("actor").Immutable(),
+		field.Strings("actor_roles").Optional().Immutable(),
+		field.String("action").Immutable(),
+		field.String("target_type").Immutable(),
+		field.String("target_id").Immutable(),
+		field.JSON("details", map[string]any{}).Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge { return nil }
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_type", "target_id"),
+		index.Fields("actor"),
+		index.Fields("created_at"),
+	}
+}
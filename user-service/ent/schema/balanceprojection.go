@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// BalanceProjection holds the schema definition for the BalanceProjection entity,
+// a local read model of wallet balances fed by transactions-service events.
+type BalanceProjection struct {
+	ent.Schema
+}
+
+// Fields of the BalanceProjection.
+func (BalanceProjection) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.String("email"),
+		field.Float("balance"),
+		field.String("currency"),
+		field.Int("sequence"),
+		// as_of is when transactions-service produced the balance: the time of
+		// its event, or of the snapshot it was rebuilt from. Rows projected
+		// before it was recorded have none until the projection catches up.
+		field.Time("as_of").Optional(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the BalanceProjection.
+func (BalanceProjection) Edges() []ent.Edge { return nil }
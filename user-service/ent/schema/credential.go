@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Credential holds the password and the TOTP secret of a user, kept apart from
+// the User so that the secrets and the lockout state are only read where they
+// are needed. Only an Argon2id hash of the password is stored.
+type Credential struct {
+	ent.Schema
+}
+
+// Fields of the Credential.
+func (Credential) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Unique().Immutable(),
+		// password_hash is a PHC string; it is empty until a password is set
+		// through a password reset.
+		field.String("password_hash").Sensitive().Optional(),
+		field.Time("password_changed_at").Optional().Nillable(),
+		// failed_attempts counts the failed logins since the last successful one.
+		field.Int("failed_attempts").Default(0),
+		field.Time("locked_until").Optional().Nillable(),
+		// reset_token_id is the ID of the only password reset token that may be
+		// used; it is cleared once used.
+		field.String("reset_token_id").Sensitive().Optional().Nillable(),
+		// totp_secret is the base32 TOTP secret; it only protects logins once
+		// the user confirmed it with a code, at totp_confirmed_at.
+		field.String("totp_secret").Sensitive().Optional().Nillable(),
+		field.Time("totp_confirmed_at").Optional().Nillable(),
+		// totp_last_step is the time step of the last accepted code, which
+		// cannot be used again.
+		field.Int64("totp_last_step").Default(0),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Credential.
+func (Credential) Edges() []ent.Edge { return nil }
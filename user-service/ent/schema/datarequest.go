@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// DataRequest holds a data-subject request of a user: an export of their data
+// or the erasure of their account. Requests are worked off in the background
+// together with transactions-service.
+type DataRequest struct {
+	ent.Schema
+}
+
+// Fields of the DataRequest.
+func (DataRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.Int("user_id").Immutable(),
+		field.Enum("kind").Values("export", "erasure").Immutable(),
+		field.Enum("status").Values("pending", "running", "completed", "failed").Default("pending"),
+		// requested_by is the subject of the token or API key owner that
+		// made the request.
+		field.String("requested_by").Immutable(),
+		field.Int("attempts").Default(0),
+		field.Text("error").Optional(),
+		// archive is the zip of an export; it is dropped when it expires.
+		field.Bytes("archive").Sensitive().Optional().Nillable(),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("started_at").Optional().Nillable(),
+		field.Time("completed_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the DataRequest.
+func (DataRequest) Edges() []ent.Edge { return nil }
+
+// Indexes of the DataRequest.
+func (DataRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// ProfileChange holds the schema definition for the ProfileChange entity,
+// one changed field of a user's profile together with who changed it.
+type ProfileChange struct {
+	ent.Schema
+}
+
+// Fields of the ProfileChange.
+func (ProfileChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Immutable(),
+		field.String("field").Immutable(),
+		// old_value and new_value are empty when the field was unset or is
+		// removed; addresses are stored as JSON.
+		field.Text("old_value").Optional().Immutable(),
+		field.Text("new_value").Optional().Immutable(),
+		// changed_by is the subject of the token that made the change.
+		field.String("changed_by").Immutable(),
+		field.Time("changed_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ProfileChange.
+func (ProfileChange) Edges() []ent.Edge { return nil }
+
+// Indexes of the ProfileChange.
+func (ProfileChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "changed_at"),
+	}
+}
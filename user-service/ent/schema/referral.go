@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Referral holds the schema definition for the Referral entity: a user who
+// signed up with the referral code of another.
+type Referral struct {
+	ent.Schema
+}
+
+// Fields of the Referral.
+func (Referral) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("referrer_id").Immutable(),
+		// referred_id is unique: a user is referred at most once, when they
+		// sign up.
+		field.Int("referred_id").Unique().Immutable(),
+		field.String("code").Immutable(),
+		// status moves from pending to qualified once the referred user meets
+		// the criteria, and on to paid once both bonuses are paid, or failed
+		// once every attempt to pay them did. Referrals past the cap of their
+		// referrer are capped, and those that did not qualify in time
+		// expired; neither earns a bonus.
+		field.Enum("status").Values("pending", "qualified", "paid", "failed", "capped", "expired").Default("pending"),
+		// The bonuses are fixed when the referral qualifies.
+		field.Float("referrer_bonus").Default(0),
+		field.Float("referred_bonus").Default(0),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("qualified_at").Optional().Nillable(),
+		field.Time("paid_at").Optional().Nillable(),
+		// payout_claimed_at is set while a replica pays the bonuses, so that
+		// no other one pays them at the same time.
+		field.Time("payout_claimed_at").Optional().Nillable(),
+		// checked_at is when the deposits of the referred user were last
+		// looked up, for a top-up whose event was missed.
+		field.Time("checked_at").Optional().Nillable(),
+		// payout_error is why the last attempt to pay the bonuses failed.
+		field.String("payout_error").Optional(),
+		// payout_attempts counts the failed attempts to pay the bonuses, and
+		// next_payout_at is when the next one is due.
+		field.Int("payout_attempts").Default(0),
+		field.Time("next_payout_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Referral.
+func (Referral) Edges() []ent.Edge { return nil }
+
+// Indexes of the Referral.
+func (Referral) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("referrer_id"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// ReferralCode holds the schema definition for the ReferralCode entity, the
+// code a user shares to refer new users.
+type ReferralCode struct {
+	ent.Schema
+}
+
+// Fields of the ReferralCode.
+func (ReferralCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Unique().Immutable(),
+		field.String("code").Unique().Immutable(),
+		// rewarded counts the referrals of the user that qualified for a
+		// bonus, against the cap of bonuses per referrer.
+		field.Int("rewarded").Default(0),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ReferralCode.
+func (ReferralCode) Edges() []ent.Edge { return nil }
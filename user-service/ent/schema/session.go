@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Session holds the schema definition for the Session entity: a login on a
+// device, whose ID is the family of the refresh tokens issued to it.
+type Session struct {
+	ent.Schema
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.Int("user_id").Immutable(),
+		// device is the name the client gave the device, or one derived from
+		// its user agent.
+		field.String("device"),
+		field.String("user_agent"),
+		field.String("ip"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		// last_seen_at is when the session last logged in or refreshed its
+		// tokens, from ip with user_agent.
+		field.Time("last_seen_at").Default(time.Now),
+		// expires_at is when the latest refresh token of the session expires.
+		field.Time("expires_at"),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge { return nil }
+
+// Indexes of the Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("revoked_at"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"time"
+	"user-service/profiles"
 )
 
 // User holds the schema definition for the User entity.
@@ -16,7 +17,42 @@ func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id").Unique().Immutable(),
 		field.String("email").Unique(),
+		field.String("name").Optional(),
+		// The profile fields below are validated and normalized by the
+		// profiles package; every change is kept as a ProfileChange.
+		field.String("legal_name").Optional(),
+		field.Time("date_of_birth").Optional().Nillable(),
+		// phone is in E.164 format.
+		field.String("phone").Optional(),
+		// phone_verified_at is when the user proved to own the phone with a
+		// texted code; it is cleared when the phone changes.
+		field.Time("phone_verified_at").Optional().Nillable(),
+		// phone_code_hash is the hash of the last code texted to the phone,
+		// until it is used, expires or was guessed at too often.
+		field.String("phone_code_hash").Optional().Sensitive(),
+		// phone_code_sent_at is when that code was sent, used to expire it
+		// and to throttle resends.
+		field.Time("phone_code_sent_at").Optional().Nillable(),
+		// phone_code_attempts counts the wrong codes entered for it.
+		field.Int("phone_code_attempts").Default(0),
+		field.JSON("address", &profiles.Address{}).Optional(),
+		// locale is a BCP 47 language tag.
+		field.String("locale").Optional(),
+		// timezone is an IANA time zone name.
+		field.String("timezone").Optional(),
 		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		// verified_at is when the user proved to own the email; it is cleared
+		// when the email changes.
+		field.Time("verified_at").Optional().Nillable(),
+		// verification_sent_at is when the last verification email was sent,
+		// used to throttle resends.
+		field.Time("verification_sent_at").Optional().Nillable(),
+		// roles are the back-office roles of operators.
+		field.Strings("roles").Optional(),
+		// erased_at is set once the user was erased on request: the email is
+		// replaced by a pseudonym and the personal data is cleared.
+		field.Time("erased_at").Optional().Nillable(),
 	}
 }
 
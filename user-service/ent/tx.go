@@ -12,6 +12,34 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// Alias is the client for interacting with the Alias builders.
+	Alias *AliasClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// BalanceProjection is the client for interacting with the BalanceProjection builders.
+	BalanceProjection *BalanceProjectionClient
+	// Credential is the client for interacting with the Credential builders.
+	Credential *CredentialClient
+	// DataRequest is the client for interacting with the DataRequest builders.
+	DataRequest *DataRequestClient
+	// ProfileChange is the client for interacting with the ProfileChange builders.
+	ProfileChange *ProfileChangeClient
+	// RateLimitState is the client for interacting with the RateLimitState builders.
+	RateLimitState *RateLimitStateClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// Referral is the client for interacting with the Referral builders.
+	Referral *ReferralClient
+	// ReferralCode is the client for interacting with the ReferralCode builders.
+	ReferralCode *ReferralCodeClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -145,6 +173,20 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.Alias = NewAliasClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.BalanceProjection = NewBalanceProjectionClient(tx.config)
+	tx.Credential = NewCredentialClient(tx.config)
+	tx.DataRequest = NewDataRequestClient(tx.config)
+	tx.ProfileChange = NewProfileChangeClient(tx.config)
+	tx.RateLimitState = NewRateLimitStateClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
+	tx.Referral = NewReferralClient(tx.config)
+	tx.ReferralCode = NewReferralCodeClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.SigningKey = NewSigningKeyClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -155,7 +197,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -15,8 +15,40 @@ const (
 	FieldID = "id"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldLegalName holds the string denoting the legal_name field in the database.
+	FieldLegalName = "legal_name"
+	// FieldDateOfBirth holds the string denoting the date_of_birth field in the database.
+	FieldDateOfBirth = "date_of_birth"
+	// FieldPhone holds the string denoting the phone field in the database.
+	FieldPhone = "phone"
+	// FieldPhoneVerifiedAt holds the string denoting the phone_verified_at field in the database.
+	FieldPhoneVerifiedAt = "phone_verified_at"
+	// FieldPhoneCodeHash holds the string denoting the phone_code_hash field in the database.
+	FieldPhoneCodeHash = "phone_code_hash"
+	// FieldPhoneCodeSentAt holds the string denoting the phone_code_sent_at field in the database.
+	FieldPhoneCodeSentAt = "phone_code_sent_at"
+	// FieldPhoneCodeAttempts holds the string denoting the phone_code_attempts field in the database.
+	FieldPhoneCodeAttempts = "phone_code_attempts"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldVerifiedAt holds the string denoting the verified_at field in the database.
+	FieldVerifiedAt = "verified_at"
+	// FieldVerificationSentAt holds the string denoting the verification_sent_at field in the database.
+	FieldVerificationSentAt = "verification_sent_at"
+	// FieldRoles holds the string denoting the roles field in the database.
+	FieldRoles = "roles"
+	// FieldErasedAt holds the string denoting the erased_at field in the database.
+	FieldErasedAt = "erased_at"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 )
@@ -25,7 +57,23 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldEmail,
+	FieldName,
+	FieldLegalName,
+	FieldDateOfBirth,
+	FieldPhone,
+	FieldPhoneVerifiedAt,
+	FieldPhoneCodeHash,
+	FieldPhoneCodeSentAt,
+	FieldPhoneCodeAttempts,
+	FieldAddress,
+	FieldLocale,
+	FieldTimezone,
 	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldVerifiedAt,
+	FieldVerificationSentAt,
+	FieldRoles,
+	FieldErasedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -39,8 +87,14 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultPhoneCodeAttempts holds the default value on creation for the "phone_code_attempts" field.
+	DefaultPhoneCodeAttempts int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
 )
 
 // OrderOption defines the ordering options for the User queries.
@@ -56,7 +110,77 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByLegalName orders the results by the legal_name field.
+func ByLegalName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLegalName, opts...).ToFunc()
+}
+
+// ByDateOfBirth orders the results by the date_of_birth field.
+func ByDateOfBirth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDateOfBirth, opts...).ToFunc()
+}
+
+// ByPhone orders the results by the phone field.
+func ByPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhone, opts...).ToFunc()
+}
+
+// ByPhoneVerifiedAt orders the results by the phone_verified_at field.
+func ByPhoneVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhoneVerifiedAt, opts...).ToFunc()
+}
+
+// ByPhoneCodeHash orders the results by the phone_code_hash field.
+func ByPhoneCodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhoneCodeHash, opts...).ToFunc()
+}
+
+// ByPhoneCodeSentAt orders the results by the phone_code_sent_at field.
+func ByPhoneCodeSentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhoneCodeSentAt, opts...).ToFunc()
+}
+
+// ByPhoneCodeAttempts orders the results by the phone_code_attempts field.
+func ByPhoneCodeAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhoneCodeAttempts, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByVerifiedAt orders the results by the verified_at field.
+func ByVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedAt, opts...).ToFunc()
+}
+
+// ByVerificationSentAt orders the results by the verification_sent_at field.
+func ByVerificationSentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationSentAt, opts...).ToFunc()
+}
+
+// ByErasedAt orders the results by the erased_at field.
+func ByErasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErasedAt, opts...).ToFunc()
+}
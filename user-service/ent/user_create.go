@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 	"user-service/ent/user"
+	"user-service/profiles"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,6 +27,152 @@ func (uc *UserCreate) SetEmail(s string) *UserCreate {
 	return uc
 }
 
+// SetName sets the "name" field.
+func (uc *UserCreate) SetName(s string) *UserCreate {
+	uc.mutation.SetName(s)
+	return uc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (uc *UserCreate) SetNillableName(s *string) *UserCreate {
+	if s != nil {
+		uc.SetName(*s)
+	}
+	return uc
+}
+
+// SetLegalName sets the "legal_name" field.
+func (uc *UserCreate) SetLegalName(s string) *UserCreate {
+	uc.mutation.SetLegalName(s)
+	return uc
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLegalName(s *string) *UserCreate {
+	if s != nil {
+		uc.SetLegalName(*s)
+	}
+	return uc
+}
+
+// SetDateOfBirth sets the "date_of_birth" field.
+func (uc *UserCreate) SetDateOfBirth(t time.Time) *UserCreate {
+	uc.mutation.SetDateOfBirth(t)
+	return uc
+}
+
+// SetNillableDateOfBirth sets the "date_of_birth" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDateOfBirth(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDateOfBirth(*t)
+	}
+	return uc
+}
+
+// SetPhone sets the "phone" field.
+func (uc *UserCreate) SetPhone(s string) *UserCreate {
+	uc.mutation.SetPhone(s)
+	return uc
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePhone(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPhone(*s)
+	}
+	return uc
+}
+
+// SetPhoneVerifiedAt sets the "phone_verified_at" field.
+func (uc *UserCreate) SetPhoneVerifiedAt(t time.Time) *UserCreate {
+	uc.mutation.SetPhoneVerifiedAt(t)
+	return uc
+}
+
+// SetNillablePhoneVerifiedAt sets the "phone_verified_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePhoneVerifiedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetPhoneVerifiedAt(*t)
+	}
+	return uc
+}
+
+// SetPhoneCodeHash sets the "phone_code_hash" field.
+func (uc *UserCreate) SetPhoneCodeHash(s string) *UserCreate {
+	uc.mutation.SetPhoneCodeHash(s)
+	return uc
+}
+
+// SetNillablePhoneCodeHash sets the "phone_code_hash" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePhoneCodeHash(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPhoneCodeHash(*s)
+	}
+	return uc
+}
+
+// SetPhoneCodeSentAt sets the "phone_code_sent_at" field.
+func (uc *UserCreate) SetPhoneCodeSentAt(t time.Time) *UserCreate {
+	uc.mutation.SetPhoneCodeSentAt(t)
+	return uc
+}
+
+// SetNillablePhoneCodeSentAt sets the "phone_code_sent_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePhoneCodeSentAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetPhoneCodeSentAt(*t)
+	}
+	return uc
+}
+
+// SetPhoneCodeAttempts sets the "phone_code_attempts" field.
+func (uc *UserCreate) SetPhoneCodeAttempts(i int) *UserCreate {
+	uc.mutation.SetPhoneCodeAttempts(i)
+	return uc
+}
+
+// SetNillablePhoneCodeAttempts sets the "phone_code_attempts" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePhoneCodeAttempts(i *int) *UserCreate {
+	if i != nil {
+		uc.SetPhoneCodeAttempts(*i)
+	}
+	return uc
+}
+
+// SetAddress sets the "address" field.
+func (uc *UserCreate) SetAddress(pr *profiles.Address) *UserCreate {
+	uc.mutation.SetAddress(pr)
+	return uc
+}
+
+// SetLocale sets the "locale" field.
+func (uc *UserCreate) SetLocale(s string) *UserCreate {
+	uc.mutation.SetLocale(s)
+	return uc
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLocale(s *string) *UserCreate {
+	if s != nil {
+		uc.SetLocale(*s)
+	}
+	return uc
+}
+
+// SetTimezone sets the "timezone" field.
+func (uc *UserCreate) SetTimezone(s string) *UserCreate {
+	uc.mutation.SetTimezone(s)
+	return uc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTimezone(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTimezone(*s)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -40,6 +187,68 @@ func (uc *UserCreate) SetNillableCreatedAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (uc *UserCreate) SetUpdatedAt(t time.Time) *UserCreate {
+	uc.mutation.SetUpdatedAt(t)
+	return uc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableUpdatedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetUpdatedAt(*t)
+	}
+	return uc
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (uc *UserCreate) SetVerifiedAt(t time.Time) *UserCreate {
+	uc.mutation.SetVerifiedAt(t)
+	return uc
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableVerifiedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetVerifiedAt(*t)
+	}
+	return uc
+}
+
+// SetVerificationSentAt sets the "verification_sent_at" field.
+func (uc *UserCreate) SetVerificationSentAt(t time.Time) *UserCreate {
+	uc.mutation.SetVerificationSentAt(t)
+	return uc
+}
+
+// SetNillableVerificationSentAt sets the "verification_sent_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableVerificationSentAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetVerificationSentAt(*t)
+	}
+	return uc
+}
+
+// SetRoles sets the "roles" field.
+func (uc *UserCreate) SetRoles(s []string) *UserCreate {
+	uc.mutation.SetRoles(s)
+	return uc
+}
+
+// SetErasedAt sets the "erased_at" field.
+func (uc *UserCreate) SetErasedAt(t time.Time) *UserCreate {
+	uc.mutation.SetErasedAt(t)
+	return uc
+}
+
+// SetNillableErasedAt sets the "erased_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableErasedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetErasedAt(*t)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(i int) *UserCreate {
 	uc.mutation.SetID(i)
@@ -81,10 +290,18 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (uc *UserCreate) defaults() {
+	if _, ok := uc.mutation.PhoneCodeAttempts(); !ok {
+		v := user.DefaultPhoneCodeAttempts
+		uc.mutation.SetPhoneCodeAttempts(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := uc.mutation.UpdatedAt(); !ok {
+		v := user.DefaultUpdatedAt()
+		uc.mutation.SetUpdatedAt(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -92,9 +309,15 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Email(); !ok {
 		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "User.email"`)}
 	}
+	if _, ok := uc.mutation.PhoneCodeAttempts(); !ok {
+		return &ValidationError{Name: "phone_code_attempts", err: errors.New(`ent: missing required field "User.phone_code_attempts"`)}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
+	if _, ok := uc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "User.updated_at"`)}
+	}
 	return nil
 }
 
@@ -131,10 +354,74 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := uc.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := uc.mutation.LegalName(); ok {
+		_spec.SetField(user.FieldLegalName, field.TypeString, value)
+		_node.LegalName = value
+	}
+	if value, ok := uc.mutation.DateOfBirth(); ok {
+		_spec.SetField(user.FieldDateOfBirth, field.TypeTime, value)
+		_node.DateOfBirth = &value
+	}
+	if value, ok := uc.mutation.Phone(); ok {
+		_spec.SetField(user.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := uc.mutation.PhoneVerifiedAt(); ok {
+		_spec.SetField(user.FieldPhoneVerifiedAt, field.TypeTime, value)
+		_node.PhoneVerifiedAt = &value
+	}
+	if value, ok := uc.mutation.PhoneCodeHash(); ok {
+		_spec.SetField(user.FieldPhoneCodeHash, field.TypeString, value)
+		_node.PhoneCodeHash = value
+	}
+	if value, ok := uc.mutation.PhoneCodeSentAt(); ok {
+		_spec.SetField(user.FieldPhoneCodeSentAt, field.TypeTime, value)
+		_node.PhoneCodeSentAt = &value
+	}
+	if value, ok := uc.mutation.PhoneCodeAttempts(); ok {
+		_spec.SetField(user.FieldPhoneCodeAttempts, field.TypeInt, value)
+		_node.PhoneCodeAttempts = value
+	}
+	if value, ok := uc.mutation.Address(); ok {
+		_spec.SetField(user.FieldAddress, field.TypeJSON, value)
+		_node.Address = value
+	}
+	if value, ok := uc.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := uc.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := uc.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := uc.mutation.VerifiedAt(); ok {
+		_spec.SetField(user.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	if value, ok := uc.mutation.VerificationSentAt(); ok {
+		_spec.SetField(user.FieldVerificationSentAt, field.TypeTime, value)
+		_node.VerificationSentAt = &value
+	}
+	if value, ok := uc.mutation.Roles(); ok {
+		_spec.SetField(user.FieldRoles, field.TypeJSON, value)
+		_node.Roles = value
+	}
+	if value, ok := uc.mutation.ErasedAt(); ok {
+		_spec.SetField(user.FieldErasedAt, field.TypeTime, value)
+		_node.ErasedAt = &value
+	}
 	return _node, _spec
 }
 
@@ -9,9 +9,11 @@ import (
 	"time"
 	"user-service/ent/predicate"
 	"user-service/ent/user"
+	"user-service/profiles"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -42,6 +44,219 @@ func (uu *UserUpdate) SetNillableEmail(s *string) *UserUpdate {
 	return uu
 }
 
+// SetName sets the "name" field.
+func (uu *UserUpdate) SetName(s string) *UserUpdate {
+	uu.mutation.SetName(s)
+	return uu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableName(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetName(*s)
+	}
+	return uu
+}
+
+// ClearName clears the value of the "name" field.
+func (uu *UserUpdate) ClearName() *UserUpdate {
+	uu.mutation.ClearName()
+	return uu
+}
+
+// SetLegalName sets the "legal_name" field.
+func (uu *UserUpdate) SetLegalName(s string) *UserUpdate {
+	uu.mutation.SetLegalName(s)
+	return uu
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLegalName(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetLegalName(*s)
+	}
+	return uu
+}
+
+// ClearLegalName clears the value of the "legal_name" field.
+func (uu *UserUpdate) ClearLegalName() *UserUpdate {
+	uu.mutation.ClearLegalName()
+	return uu
+}
+
+// SetDateOfBirth sets the "date_of_birth" field.
+func (uu *UserUpdate) SetDateOfBirth(t time.Time) *UserUpdate {
+	uu.mutation.SetDateOfBirth(t)
+	return uu
+}
+
+// SetNillableDateOfBirth sets the "date_of_birth" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDateOfBirth(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDateOfBirth(*t)
+	}
+	return uu
+}
+
+// ClearDateOfBirth clears the value of the "date_of_birth" field.
+func (uu *UserUpdate) ClearDateOfBirth() *UserUpdate {
+	uu.mutation.ClearDateOfBirth()
+	return uu
+}
+
+// SetPhone sets the "phone" field.
+func (uu *UserUpdate) SetPhone(s string) *UserUpdate {
+	uu.mutation.SetPhone(s)
+	return uu
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePhone(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetPhone(*s)
+	}
+	return uu
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (uu *UserUpdate) ClearPhone() *UserUpdate {
+	uu.mutation.ClearPhone()
+	return uu
+}
+
+// SetPhoneVerifiedAt sets the "phone_verified_at" field.
+func (uu *UserUpdate) SetPhoneVerifiedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetPhoneVerifiedAt(t)
+	return uu
+}
+
+// SetNillablePhoneVerifiedAt sets the "phone_verified_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePhoneVerifiedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetPhoneVerifiedAt(*t)
+	}
+	return uu
+}
+
+// ClearPhoneVerifiedAt clears the value of the "phone_verified_at" field.
+func (uu *UserUpdate) ClearPhoneVerifiedAt() *UserUpdate {
+	uu.mutation.ClearPhoneVerifiedAt()
+	return uu
+}
+
+// SetPhoneCodeHash sets the "phone_code_hash" field.
+func (uu *UserUpdate) SetPhoneCodeHash(s string) *UserUpdate {
+	uu.mutation.SetPhoneCodeHash(s)
+	return uu
+}
+
+// SetNillablePhoneCodeHash sets the "phone_code_hash" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePhoneCodeHash(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetPhoneCodeHash(*s)
+	}
+	return uu
+}
+
+// ClearPhoneCodeHash clears the value of the "phone_code_hash" field.
+func (uu *UserUpdate) ClearPhoneCodeHash() *UserUpdate {
+	uu.mutation.ClearPhoneCodeHash()
+	return uu
+}
+
+// SetPhoneCodeSentAt sets the "phone_code_sent_at" field.
+func (uu *UserUpdate) SetPhoneCodeSentAt(t time.Time) *UserUpdate {
+	uu.mutation.SetPhoneCodeSentAt(t)
+	return uu
+}
+
+// SetNillablePhoneCodeSentAt sets the "phone_code_sent_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePhoneCodeSentAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetPhoneCodeSentAt(*t)
+	}
+	return uu
+}
+
+// ClearPhoneCodeSentAt clears the value of the "phone_code_sent_at" field.
+func (uu *UserUpdate) ClearPhoneCodeSentAt() *UserUpdate {
+	uu.mutation.ClearPhoneCodeSentAt()
+	return uu
+}
+
+// SetPhoneCodeAttempts sets the "phone_code_attempts" field.
+func (uu *UserUpdate) SetPhoneCodeAttempts(i int) *UserUpdate {
+	uu.mutation.ResetPhoneCodeAttempts()
+	uu.mutation.SetPhoneCodeAttempts(i)
+	return uu
+}
+
+// SetNillablePhoneCodeAttempts sets the "phone_code_attempts" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePhoneCodeAttempts(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetPhoneCodeAttempts(*i)
+	}
+	return uu
+}
+
+// AddPhoneCodeAttempts adds i to the "phone_code_attempts" field.
+func (uu *UserUpdate) AddPhoneCodeAttempts(i int) *UserUpdate {
+	uu.mutation.AddPhoneCodeAttempts(i)
+	return uu
+}
+
+// SetAddress sets the "address" field.
+func (uu *UserUpdate) SetAddress(pr *profiles.Address) *UserUpdate {
+	uu.mutation.SetAddress(pr)
+	return uu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (uu *UserUpdate) ClearAddress() *UserUpdate {
+	uu.mutation.ClearAddress()
+	return uu
+}
+
+// SetLocale sets the "locale" field.
+func (uu *UserUpdate) SetLocale(s string) *UserUpdate {
+	uu.mutation.SetLocale(s)
+	return uu
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLocale(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetLocale(*s)
+	}
+	return uu
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (uu *UserUpdate) ClearLocale() *UserUpdate {
+	uu.mutation.ClearLocale()
+	return uu
+}
+
+// SetTimezone sets the "timezone" field.
+func (uu *UserUpdate) SetTimezone(s string) *UserUpdate {
+	uu.mutation.SetTimezone(s)
+	return uu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTimezone(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTimezone(*s)
+	}
+	return uu
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (uu *UserUpdate) ClearTimezone() *UserUpdate {
+	uu.mutation.ClearTimezone()
+	return uu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uu *UserUpdate) SetCreatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetCreatedAt(t)
@@ -56,6 +271,90 @@ func (uu *UserUpdate) SetNillableCreatedAt(t *time.Time) *UserUpdate {
 	return uu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (uu *UserUpdate) SetUpdatedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetUpdatedAt(t)
+	return uu
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (uu *UserUpdate) SetVerifiedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetVerifiedAt(t)
+	return uu
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableVerifiedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetVerifiedAt(*t)
+	}
+	return uu
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (uu *UserUpdate) ClearVerifiedAt() *UserUpdate {
+	uu.mutation.ClearVerifiedAt()
+	return uu
+}
+
+// SetVerificationSentAt sets the "verification_sent_at" field.
+func (uu *UserUpdate) SetVerificationSentAt(t time.Time) *UserUpdate {
+	uu.mutation.SetVerificationSentAt(t)
+	return uu
+}
+
+// SetNillableVerificationSentAt sets the "verification_sent_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableVerificationSentAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetVerificationSentAt(*t)
+	}
+	return uu
+}
+
+// ClearVerificationSentAt clears the value of the "verification_sent_at" field.
+func (uu *UserUpdate) ClearVerificationSentAt() *UserUpdate {
+	uu.mutation.ClearVerificationSentAt()
+	return uu
+}
+
+// SetRoles sets the "roles" field.
+func (uu *UserUpdate) SetRoles(s []string) *UserUpdate {
+	uu.mutation.SetRoles(s)
+	return uu
+}
+
+// AppendRoles appends s to the "roles" field.
+func (uu *UserUpdate) AppendRoles(s []string) *UserUpdate {
+	uu.mutation.AppendRoles(s)
+	return uu
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (uu *UserUpdate) ClearRoles() *UserUpdate {
+	uu.mutation.ClearRoles()
+	return uu
+}
+
+// SetErasedAt sets the "erased_at" field.
+func (uu *UserUpdate) SetErasedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetErasedAt(t)
+	return uu
+}
+
+// SetNillableErasedAt sets the "erased_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableErasedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetErasedAt(*t)
+	}
+	return uu
+}
+
+// ClearErasedAt clears the value of the "erased_at" field.
+func (uu *UserUpdate) ClearErasedAt() *UserUpdate {
+	uu.mutation.ClearErasedAt()
+	return uu
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -63,6 +362,7 @@ func (uu *UserUpdate) Mutation() *UserMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+	uu.defaults()
 	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
 }
 
@@ -88,6 +388,14 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (uu *UserUpdate) defaults() {
+	if _, ok := uu.mutation.UpdatedAt(); !ok {
+		v := user.UpdateDefaultUpdatedAt()
+		uu.mutation.SetUpdatedAt(v)
+	}
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	if ps := uu.mutation.predicates; len(ps) > 0 {
@@ -100,9 +408,107 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if uu.mutation.NameCleared() {
+		_spec.ClearField(user.FieldName, field.TypeString)
+	}
+	if value, ok := uu.mutation.LegalName(); ok {
+		_spec.SetField(user.FieldLegalName, field.TypeString, value)
+	}
+	if uu.mutation.LegalNameCleared() {
+		_spec.ClearField(user.FieldLegalName, field.TypeString)
+	}
+	if value, ok := uu.mutation.DateOfBirth(); ok {
+		_spec.SetField(user.FieldDateOfBirth, field.TypeTime, value)
+	}
+	if uu.mutation.DateOfBirthCleared() {
+		_spec.ClearField(user.FieldDateOfBirth, field.TypeTime)
+	}
+	if value, ok := uu.mutation.Phone(); ok {
+		_spec.SetField(user.FieldPhone, field.TypeString, value)
+	}
+	if uu.mutation.PhoneCleared() {
+		_spec.ClearField(user.FieldPhone, field.TypeString)
+	}
+	if value, ok := uu.mutation.PhoneVerifiedAt(); ok {
+		_spec.SetField(user.FieldPhoneVerifiedAt, field.TypeTime, value)
+	}
+	if uu.mutation.PhoneVerifiedAtCleared() {
+		_spec.ClearField(user.FieldPhoneVerifiedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.PhoneCodeHash(); ok {
+		_spec.SetField(user.FieldPhoneCodeHash, field.TypeString, value)
+	}
+	if uu.mutation.PhoneCodeHashCleared() {
+		_spec.ClearField(user.FieldPhoneCodeHash, field.TypeString)
+	}
+	if value, ok := uu.mutation.PhoneCodeSentAt(); ok {
+		_spec.SetField(user.FieldPhoneCodeSentAt, field.TypeTime, value)
+	}
+	if uu.mutation.PhoneCodeSentAtCleared() {
+		_spec.ClearField(user.FieldPhoneCodeSentAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.PhoneCodeAttempts(); ok {
+		_spec.SetField(user.FieldPhoneCodeAttempts, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedPhoneCodeAttempts(); ok {
+		_spec.AddField(user.FieldPhoneCodeAttempts, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.Address(); ok {
+		_spec.SetField(user.FieldAddress, field.TypeJSON, value)
+	}
+	if uu.mutation.AddressCleared() {
+		_spec.ClearField(user.FieldAddress, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if uu.mutation.LocaleCleared() {
+		_spec.ClearField(user.FieldLocale, field.TypeString)
+	}
+	if value, ok := uu.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	}
+	if uu.mutation.TimezoneCleared() {
+		_spec.ClearField(user.FieldTimezone, field.TypeString)
+	}
 	if value, ok := uu.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := uu.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uu.mutation.VerifiedAt(); ok {
+		_spec.SetField(user.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if uu.mutation.VerifiedAtCleared() {
+		_spec.ClearField(user.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.VerificationSentAt(); ok {
+		_spec.SetField(user.FieldVerificationSentAt, field.TypeTime, value)
+	}
+	if uu.mutation.VerificationSentAtCleared() {
+		_spec.ClearField(user.FieldVerificationSentAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.Roles(); ok {
+		_spec.SetField(user.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := uu.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldRoles, value)
+		})
+	}
+	if uu.mutation.RolesCleared() {
+		_spec.ClearField(user.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.ErasedAt(); ok {
+		_spec.SetField(user.FieldErasedAt, field.TypeTime, value)
+	}
+	if uu.mutation.ErasedAtCleared() {
+		_spec.ClearField(user.FieldErasedAt, field.TypeTime)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -137,6 +543,219 @@ func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// SetName sets the "name" field.
+func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
+	uuo.mutation.SetName(s)
+	return uuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetName(*s)
+	}
+	return uuo
+}
+
+// ClearName clears the value of the "name" field.
+func (uuo *UserUpdateOne) ClearName() *UserUpdateOne {
+	uuo.mutation.ClearName()
+	return uuo
+}
+
+// SetLegalName sets the "legal_name" field.
+func (uuo *UserUpdateOne) SetLegalName(s string) *UserUpdateOne {
+	uuo.mutation.SetLegalName(s)
+	return uuo
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLegalName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetLegalName(*s)
+	}
+	return uuo
+}
+
+// ClearLegalName clears the value of the "legal_name" field.
+func (uuo *UserUpdateOne) ClearLegalName() *UserUpdateOne {
+	uuo.mutation.ClearLegalName()
+	return uuo
+}
+
+// SetDateOfBirth sets the "date_of_birth" field.
+func (uuo *UserUpdateOne) SetDateOfBirth(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDateOfBirth(t)
+	return uuo
+}
+
+// SetNillableDateOfBirth sets the "date_of_birth" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDateOfBirth(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDateOfBirth(*t)
+	}
+	return uuo
+}
+
+// ClearDateOfBirth clears the value of the "date_of_birth" field.
+func (uuo *UserUpdateOne) ClearDateOfBirth() *UserUpdateOne {
+	uuo.mutation.ClearDateOfBirth()
+	return uuo
+}
+
+// SetPhone sets the "phone" field.
+func (uuo *UserUpdateOne) SetPhone(s string) *UserUpdateOne {
+	uuo.mutation.SetPhone(s)
+	return uuo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePhone(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPhone(*s)
+	}
+	return uuo
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (uuo *UserUpdateOne) ClearPhone() *UserUpdateOne {
+	uuo.mutation.ClearPhone()
+	return uuo
+}
+
+// SetPhoneVerifiedAt sets the "phone_verified_at" field.
+func (uuo *UserUpdateOne) SetPhoneVerifiedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetPhoneVerifiedAt(t)
+	return uuo
+}
+
+// SetNillablePhoneVerifiedAt sets the "phone_verified_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePhoneVerifiedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetPhoneVerifiedAt(*t)
+	}
+	return uuo
+}
+
+// ClearPhoneVerifiedAt clears the value of the "phone_verified_at" field.
+func (uuo *UserUpdateOne) ClearPhoneVerifiedAt() *UserUpdateOne {
+	uuo.mutation.ClearPhoneVerifiedAt()
+	return uuo
+}
+
+// SetPhoneCodeHash sets the "phone_code_hash" field.
+func (uuo *UserUpdateOne) SetPhoneCodeHash(s string) *UserUpdateOne {
+	uuo.mutation.SetPhoneCodeHash(s)
+	return uuo
+}
+
+// SetNillablePhoneCodeHash sets the "phone_code_hash" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePhoneCodeHash(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPhoneCodeHash(*s)
+	}
+	return uuo
+}
+
+// ClearPhoneCodeHash clears the value of the "phone_code_hash" field.
+func (uuo *UserUpdateOne) ClearPhoneCodeHash() *UserUpdateOne {
+	uuo.mutation.ClearPhoneCodeHash()
+	return uuo
+}
+
+// SetPhoneCodeSentAt sets the "phone_code_sent_at" field.
+func (uuo *UserUpdateOne) SetPhoneCodeSentAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetPhoneCodeSentAt(t)
+	return uuo
+}
+
+// SetNillablePhoneCodeSentAt sets the "phone_code_sent_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePhoneCodeSentAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetPhoneCodeSentAt(*t)
+	}
+	return uuo
+}
+
+// ClearPhoneCodeSentAt clears the value of the "phone_code_sent_at" field.
+func (uuo *UserUpdateOne) ClearPhoneCodeSentAt() *UserUpdateOne {
+	uuo.mutation.ClearPhoneCodeSentAt()
+	return uuo
+}
+
+// SetPhoneCodeAttempts sets the "phone_code_attempts" field.
+func (uuo *UserUpdateOne) SetPhoneCodeAttempts(i int) *UserUpdateOne {
+	uuo.mutation.ResetPhoneCodeAttempts()
+	uuo.mutation.SetPhoneCodeAttempts(i)
+	return uuo
+}
+
+// SetNillablePhoneCodeAttempts sets the "phone_code_attempts" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePhoneCodeAttempts(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetPhoneCodeAttempts(*i)
+	}
+	return uuo
+}
+
+// AddPhoneCodeAttempts adds i to the "phone_code_attempts" field.
+func (uuo *UserUpdateOne) AddPhoneCodeAttempts(i int) *UserUpdateOne {
+	uuo.mutation.AddPhoneCodeAttempts(i)
+	return uuo
+}
+
+// SetAddress sets the "address" field.
+func (uuo *UserUpdateOne) SetAddress(pr *profiles.Address) *UserUpdateOne {
+	uuo.mutation.SetAddress(pr)
+	return uuo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (uuo *UserUpdateOne) ClearAddress() *UserUpdateOne {
+	uuo.mutation.ClearAddress()
+	return uuo
+}
+
+// SetLocale sets the "locale" field.
+func (uuo *UserUpdateOne) SetLocale(s string) *UserUpdateOne {
+	uuo.mutation.SetLocale(s)
+	return uuo
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLocale(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetLocale(*s)
+	}
+	return uuo
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (uuo *UserUpdateOne) ClearLocale() *UserUpdateOne {
+	uuo.mutation.ClearLocale()
+	return uuo
+}
+
+// SetTimezone sets the "timezone" field.
+func (uuo *UserUpdateOne) SetTimezone(s string) *UserUpdateOne {
+	uuo.mutation.SetTimezone(s)
+	return uuo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTimezone(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTimezone(*s)
+	}
+	return uuo
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (uuo *UserUpdateOne) ClearTimezone() *UserUpdateOne {
+	uuo.mutation.ClearTimezone()
+	return uuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetCreatedAt(t)
@@ -151,6 +770,90 @@ func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
 	return uuo
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
+	return uuo
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (uuo *UserUpdateOne) SetVerifiedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetVerifiedAt(t)
+	return uuo
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableVerifiedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetVerifiedAt(*t)
+	}
+	return uuo
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (uuo *UserUpdateOne) ClearVerifiedAt() *UserUpdateOne {
+	uuo.mutation.ClearVerifiedAt()
+	return uuo
+}
+
+// SetVerificationSentAt sets the "verification_sent_at" field.
+func (uuo *UserUpdateOne) SetVerificationSentAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetVerificationSentAt(t)
+	return uuo
+}
+
+// SetNillableVerificationSentAt sets the "verification_sent_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableVerificationSentAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetVerificationSentAt(*t)
+	}
+	return uuo
+}
+
+// ClearVerificationSentAt clears the value of the "verification_sent_at" field.
+func (uuo *UserUpdateOne) ClearVerificationSentAt() *UserUpdateOne {
+	uuo.mutation.ClearVerificationSentAt()
+	return uuo
+}
+
+// SetRoles sets the "roles" field.
+func (uuo *UserUpdateOne) SetRoles(s []string) *UserUpdateOne {
+	uuo.mutation.SetRoles(s)
+	return uuo
+}
+
+// AppendRoles appends s to the "roles" field.
+func (uuo *UserUpdateOne) AppendRoles(s []string) *UserUpdateOne {
+	uuo.mutation.AppendRoles(s)
+	return uuo
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (uuo *UserUpdateOne) ClearRoles() *UserUpdateOne {
+	uuo.mutation.ClearRoles()
+	return uuo
+}
+
+// SetErasedAt sets the "erased_at" field.
+func (uuo *UserUpdateOne) SetErasedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetErasedAt(t)
+	return uuo
+}
+
+// SetNillableErasedAt sets the "erased_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableErasedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetErasedAt(*t)
+	}
+	return uuo
+}
+
+// ClearErasedAt clears the value of the "erased_at" field.
+func (uuo *UserUpdateOne) ClearErasedAt() *UserUpdateOne {
+	uuo.mutation.ClearErasedAt()
+	return uuo
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -171,6 +874,7 @@ func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne
 
 // Save executes the query and returns the updated User entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	uuo.defaults()
 	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
 }
 
@@ -196,6 +900,14 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (uuo *UserUpdateOne) defaults() {
+	if _, ok := uuo.mutation.UpdatedAt(); !ok {
+		v := user.UpdateDefaultUpdatedAt()
+		uuo.mutation.SetUpdatedAt(v)
+	}
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	id, ok := uuo.mutation.ID()
@@ -225,9 +937,107 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := uuo.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if uuo.mutation.NameCleared() {
+		_spec.ClearField(user.FieldName, field.TypeString)
+	}
+	if value, ok := uuo.mutation.LegalName(); ok {
+		_spec.SetField(user.FieldLegalName, field.TypeString, value)
+	}
+	if uuo.mutation.LegalNameCleared() {
+		_spec.ClearField(user.FieldLegalName, field.TypeString)
+	}
+	if value, ok := uuo.mutation.DateOfBirth(); ok {
+		_spec.SetField(user.FieldDateOfBirth, field.TypeTime, value)
+	}
+	if uuo.mutation.DateOfBirthCleared() {
+		_spec.ClearField(user.FieldDateOfBirth, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.Phone(); ok {
+		_spec.SetField(user.FieldPhone, field.TypeString, value)
+	}
+	if uuo.mutation.PhoneCleared() {
+		_spec.ClearField(user.FieldPhone, field.TypeString)
+	}
+	if value, ok := uuo.mutation.PhoneVerifiedAt(); ok {
+		_spec.SetField(user.FieldPhoneVerifiedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.PhoneVerifiedAtCleared() {
+		_spec.ClearField(user.FieldPhoneVerifiedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.PhoneCodeHash(); ok {
+		_spec.SetField(user.FieldPhoneCodeHash, field.TypeString, value)
+	}
+	if uuo.mutation.PhoneCodeHashCleared() {
+		_spec.ClearField(user.FieldPhoneCodeHash, field.TypeString)
+	}
+	if value, ok := uuo.mutation.PhoneCodeSentAt(); ok {
+		_spec.SetField(user.FieldPhoneCodeSentAt, field.TypeTime, value)
+	}
+	if uuo.mutation.PhoneCodeSentAtCleared() {
+		_spec.ClearField(user.FieldPhoneCodeSentAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.PhoneCodeAttempts(); ok {
+		_spec.SetField(user.FieldPhoneCodeAttempts, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedPhoneCodeAttempts(); ok {
+		_spec.AddField(user.FieldPhoneCodeAttempts, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.Address(); ok {
+		_spec.SetField(user.FieldAddress, field.TypeJSON, value)
+	}
+	if uuo.mutation.AddressCleared() {
+		_spec.ClearField(user.FieldAddress, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if uuo.mutation.LocaleCleared() {
+		_spec.ClearField(user.FieldLocale, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	}
+	if uuo.mutation.TimezoneCleared() {
+		_spec.ClearField(user.FieldTimezone, field.TypeString)
+	}
 	if value, ok := uuo.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := uuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.VerifiedAt(); ok {
+		_spec.SetField(user.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.VerifiedAtCleared() {
+		_spec.ClearField(user.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.VerificationSentAt(); ok {
+		_spec.SetField(user.FieldVerificationSentAt, field.TypeTime, value)
+	}
+	if uuo.mutation.VerificationSentAtCleared() {
+		_spec.ClearField(user.FieldVerificationSentAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.Roles(); ok {
+		_spec.SetField(user.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := uuo.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldRoles, value)
+		})
+	}
+	if uuo.mutation.RolesCleared() {
+		_spec.ClearField(user.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.ErasedAt(); ok {
+		_spec.SetField(user.FieldErasedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.ErasedAtCleared() {
+		_spec.ClearField(user.FieldErasedAt, field.TypeTime)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
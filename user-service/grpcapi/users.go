@@ -0,0 +1,76 @@
+package grpcapi
+
+import (
+	"context"
+	"shared/auth"
+	"user-service/ent"
+	userv1 "user-service/gen/user/v1"
+	"user-service/services"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UserServer implements userv1.UserServiceServer.
+type UserServer struct {
+	userv1.UnimplementedUserServiceServer
+	users *services.UsersService
+}
+
+func NewUserServer(users *services.UsersService) *UserServer {
+	return &UserServer{users: users}
+}
+
+func (s *UserServer) CreateUser(ctx context.Context, req *userv1.CreateUserRequest) (*userv1.CreateUserResponse, error) {
+	u, err := s.users.CreateUser(ctx, req.GetEmail(), "", req.GetReferralCode())
+	if err != nil {
+		return nil, err
+	}
+	return &userv1.CreateUserResponse{User: userMessage(u)}, nil
+}
+
+func (s *UserServer) GetUser(ctx context.Context, req *userv1.GetUserRequest) (*userv1.GetUserResponse, error) {
+	if err := auth.AuthorizeUser(ctx, int(req.GetId())); err != nil {
+		return nil, err
+	}
+
+	u, err := s.users.GetUser(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &userv1.GetUserResponse{User: userMessage(u)}, nil
+}
+
+func (s *UserServer) GetBalance(ctx context.Context, req *userv1.GetBalanceRequest) (*userv1.GetBalanceResponse, error) {
+	if err := auth.RequireScope(ctx, auth.ScopeWalletRead); err != nil {
+		return nil, err
+	}
+	if err := auth.AuthorizeEmail(ctx, req.GetEmail()); err != nil {
+		return nil, err
+	}
+
+	consistency := services.ConsistencyEventual
+	if req.GetConsistency() == userv1.Consistency_CONSISTENCY_STRONG {
+		consistency = services.ConsistencyStrong
+	}
+
+	balance, err := s.users.GetBalance(ctx, req.GetEmail(), consistency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.GetBalanceResponse{
+		Balance:  balance.Amount,
+		Currency: balance.Currency,
+		Source:   balance.Source,
+		Sequence: int64(balance.Sequence),
+		AsOf:     timestamppb.New(balance.AsOf),
+	}, nil
+}
+
+func userMessage(u *ent.User) *userv1.User {
+	return &userv1.User{
+		Id:        int64(u.ID),
+		Email:     u.Email,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+	}
+}
@@ -0,0 +1,63 @@
+// Package limitstore keeps the state of rate limits in the rate_limit_states
+// table, so that every replica enforces the same limits.
+package limitstore
+
+import (
+	"context"
+	"shared/ratelimit"
+	"time"
+	"user-service/ent"
+	"user-service/ent/ratelimitstate"
+)
+
+// New returns a store keeping the states in the table of client.
+func New(client *ent.Client) *ratelimit.DatabaseStore {
+	return ratelimit.NewDatabaseStore(table{client: client})
+}
+
+// table is the ratelimit.Table of the RateLimitState entity.
+type table struct {
+	client *ent.Client
+}
+
+func (t table) Get(ctx context.Context, key string) (ratelimit.State, int, bool, error) {
+	row, err := t.client.RateLimitState.Get(ctx, key)
+	if ent.IsNotFound(err) {
+		return ratelimit.State{}, 0, false, nil
+	}
+	if err != nil {
+		return ratelimit.State{}, 0, false, err
+	}
+	return ratelimit.State{Value: row.Value, UpdatedAt: row.UpdatedAt, ExpiresAt: row.ExpiresAt}, row.Version, true, nil
+}
+
+func (t table) Create(ctx context.Context, key string, state ratelimit.State) (bool, error) {
+	err := t.client.RateLimitState.Create().
+		SetID(key).
+		SetValue(state.Value).
+		SetUpdatedAt(state.UpdatedAt).
+		SetExpiresAt(state.ExpiresAt).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (t table) Replace(ctx context.Context, key string, version int, state ratelimit.State) (bool, error) {
+	n, err := t.client.RateLimitState.Update().
+		Where(ratelimitstate.ID(key), ratelimitstate.Version(version)).
+		SetValue(state.Value).
+		SetUpdatedAt(state.UpdatedAt).
+		SetExpiresAt(state.ExpiresAt).
+		AddVersion(1).
+		Save(ctx)
+	return n == 1, err
+}
+
+func (t table) DeleteExpired(ctx context.Context, now time.Time) error {
+	_, err := t.client.RateLimitState.Delete().
+		Where(ratelimitstate.ExpiresAtLT(now)).
+		Exec(ctx)
+	return err
+}
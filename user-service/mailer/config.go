@@ -0,0 +1,47 @@
+package mailer
+
+import (
+	"fmt"
+	"os"
+)
+
+// Senders selectable with MAILER.
+const (
+	SenderSMTP   = "smtp"
+	SenderFile   = "file"
+	SenderMemory = "memory"
+)
+
+const (
+	defaultFrom = "Digital Wallet <no-reply@digital-wallet.local>"
+	defaultDir  = "mail"
+)
+
+// FromEnv builds the mailer selected by MAILER: smtp (configured with
+// SMTP_ADDR, SMTP_USERNAME and SMTP_PASSWORD), file (writing to MAIL_DIR)
+// or memory. It defaults to file. Messages are sent from MAIL_FROM.
+func FromEnv() (Mailer, error) {
+	from := envOr("MAIL_FROM", defaultFrom)
+
+	switch sender := envOr("MAILER", SenderFile); sender {
+	case SenderSMTP:
+		addr := os.Getenv("SMTP_ADDR")
+		if addr == "" {
+			return nil, fmt.Errorf("SMTP_ADDR is required when MAILER is %s", SenderSMTP)
+		}
+		return NewSMTPMailer(addr, from, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD")), nil
+	case SenderFile:
+		return NewFileMailer(envOr("MAIL_DIR", defaultDir), from), nil
+	case SenderMemory:
+		return NewMemoryMailer(), nil
+	default:
+		return nil, fmt.Errorf("MAILER must be %s, %s or %s, got %q", SenderSMTP, SenderFile, SenderMemory, sender)
+	}
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
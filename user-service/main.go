@@ -4,15 +4,29 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"os"
+	"shared/auth"
+	"shared/ratelimit"
+	"time"
+	"user-service/apikeys"
 	"user-service/controllers"
+	"user-service/credentials"
 	"user-service/ent"
+	"user-service/grpcapi"
+	"user-service/limitstore"
+	"user-service/mailer"
+	"user-service/projections"
+	"user-service/services"
+	"user-service/sms"
+	"user-service/tokens"
 
 	"github.com/gin-gonic/gin"
 	_ "github.com/lib/pq"
 	"github.com/nats-io/nats.go"
 	"github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"google.golang.org/grpc"
 	_ "user-service/docs"
 )
 
@@ -21,7 +35,17 @@ import (
 // @description This is a sample server for a user service.
 
 // @host localhost:8080
-// @BasePath /api/v1
+// @BasePath /api
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Access token issued by POST /v2/users or /v2/auth/token, as "Bearer <token>"
+
+// @securityDefinitions.apikey APIKey
+// @in header
+// @name X-API-Key
+// @description API key created on POST /v2/users/{id}/api-keys
 func main() {
 	client, natsConn, err := initializeResources()
 	if err != nil {
@@ -30,7 +54,79 @@ func main() {
 	defer client.Close()
 	defer natsConn.Close()
 
-	r := setupRouter(client, natsConn)
+	balanceProjector := projections.NewBalanceProjector(client, natsConn)
+	if err := balanceProjector.Start(context.Background()); err != nil {
+		log.Fatalf("failed to start balance projection: %v", err)
+	}
+
+	tokenConfig, err := tokens.LoadConfig()
+	if err != nil {
+		log.Fatalf("invalid token configuration: %v", err)
+	}
+	keys := tokens.NewKeyStore(client, tokenConfig)
+	// Revoked sessions are denied by every replica of both services.
+	denied := auth.NewDenyList()
+	verifier := auth.NewVerifier(keys, denied)
+	issuer := tokens.NewIssuer(client, keys, verifier, denied, natsConn, tokenConfig)
+	if err := denied.Subscribe(natsConn); err != nil {
+		log.Fatalf("failed to subscribe to session revocations: %v", err)
+	}
+	if err := issuer.LoadDenyList(context.Background()); err != nil {
+		log.Fatalf("failed to load revoked sessions: %v", err)
+	}
+	if err := issuer.ServeRevokedSessions(natsConn, projections.QueueGroup); err != nil {
+		log.Fatalf("failed to serve revoked sessions: %v", err)
+	}
+
+	apiKeys := apikeys.NewService(client)
+	if err := apiKeys.Serve(natsConn, projections.QueueGroup); err != nil {
+		log.Fatalf("failed to serve API key verification: %v", err)
+	}
+
+	mail, err := mailer.FromEnv()
+	if err != nil {
+		log.Fatalf("invalid mailer configuration: %v", err)
+	}
+	texter, err := sms.FromEnv()
+	if err != nil {
+		log.Fatalf("invalid SMS configuration: %v", err)
+	}
+	verificationConfig, err := services.LoadVerificationConfig()
+	if err != nil {
+		log.Fatalf("invalid verification configuration: %v", err)
+	}
+	privacyConfig, err := services.LoadPrivacyConfig()
+	if err != nil {
+		log.Fatalf("invalid data request configuration: %v", err)
+	}
+	referralConfig, err := services.LoadReferralConfig()
+	if err != nil {
+		log.Fatalf("invalid referral configuration: %v", err)
+	}
+	usersService := services.NewUsersService(client, natsConn, issuer, mail, texter, verificationConfig, privacyConfig, referralConfig)
+	go usersService.RunDataRequests(context.Background())
+	if err := usersService.QualifyReferrals(natsConn, projections.QueueGroup); err != nil {
+		log.Fatalf("failed to subscribe to top-ups: %v", err)
+	}
+	go usersService.RunReferralPayouts(context.Background())
+	if err := usersService.ServeAliases(natsConn, projections.QueueGroup); err != nil {
+		log.Fatalf("failed to serve alias resolution: %v", err)
+	}
+
+	credentialsConfig, err := credentials.LoadConfig()
+	if err != nil {
+		log.Fatalf("invalid password configuration: %v", err)
+	}
+	credentialsService := credentials.NewService(client, issuer, mail, credentialsConfig)
+
+	limiter, err := newLimiter(client)
+	if err != nil {
+		log.Fatalf("invalid rate limit configuration: %v", err)
+	}
+
+	go serveGRPC(grpcapi.NewServer(usersService, verifier, apiKeys, limiter), ":9090")
+
+	r := setupRouter(client, usersService, credentialsService, balanceProjector, issuer, keys, verifier, apiKeys, limiter)
 
 	if err := r.Run(":8080"); err != nil {
 		log.Fatalf("failed to run server: %v", err)
@@ -63,18 +159,156 @@ func initializeResources() (*ent.Client, *nats.Conn, error) {
 	return client, natsConn, nil
 }
 
+// defaultRateLimits guards user creation, the token endpoint, email
+// verification and password resets, which need no credentials, per address,
+// slows down the guessing of step-up and phone verification codes, and caps
+// the data exports of every user and the calls of every user and key.
+var defaultRateLimits = ratelimit.Config{
+	Limits: []ratelimit.Limit{
+		{Route: ratelimit.AllRoutes, Subject: ratelimit.SubjectUser, Rate: 20, Period: time.Second, Burst: 40},
+		{Route: ratelimit.AllRoutes, Subject: ratelimit.SubjectAPIKey, Rate: 20, Period: time.Second, Burst: 40},
+		{Route: "POST /api/v1/createUser", Subject: ratelimit.SubjectIP, Rate: 5, Period: time.Minute, Burst: 10},
+		{Route: "POST /api/v2/users", Subject: ratelimit.SubjectIP, Rate: 5, Period: time.Minute, Burst: 10},
+		{Route: "/user.v1.UserService/CreateUser", Subject: ratelimit.SubjectIP, Rate: 5, Period: time.Minute, Burst: 10},
+		{Route: "POST /api/v2/auth/token", Subject: ratelimit.SubjectIP, Rate: 10, Period: time.Minute, Burst: 20},
+		{Route: "GET /api/v2/email-verification", Subject: ratelimit.SubjectIP, Rate: 10, Period: time.Minute, Burst: 20},
+		{Route: "POST /api/v2/email-verification", Subject: ratelimit.SubjectIP, Rate: 10, Period: time.Minute, Burst: 20},
+		{Route: "POST /api/v2/auth/password-reset", Subject: ratelimit.SubjectIP, Rate: 5, Period: time.Minute, Burst: 5},
+		{Route: "POST /api/v2/auth/password-reset/confirm", Subject: ratelimit.SubjectIP, Rate: 10, Period: time.Minute, Burst: 20},
+		{Route: "POST /api/v2/auth/step-up", Subject: ratelimit.SubjectUser, Rate: 5, Period: time.Minute, Burst: 10},
+		{Route: "POST /api/v2/users/:id/phone-verification/confirm", Subject: ratelimit.SubjectUser, Rate: 5, Period: time.Minute, Burst: 10},
+		{Route: "GET /api/v2/recipients", Subject: ratelimit.SubjectUser, Rate: 30, Period: time.Minute, Burst: 30},
+	},
+	Quotas: []ratelimit.Quota{
+		{Route: "POST /api/v1/createUser", Subject: ratelimit.SubjectIP, Limit: 50, Window: 24 * time.Hour},
+		{Route: "POST /api/v2/users", Subject: ratelimit.SubjectIP, Limit: 50, Window: 24 * time.Hour},
+		{Route: "/user.v1.UserService/CreateUser", Subject: ratelimit.SubjectIP, Limit: 50, Window: 24 * time.Hour},
+		{Route: "POST /api/v2/auth/password-reset", Subject: ratelimit.SubjectIP, Limit: 20, Window: 24 * time.Hour},
+		{Route: "POST /api/v2/users/:id/data-exports", Subject: ratelimit.SubjectUser, Limit: 5, Window: 24 * time.Hour},
+		{Route: "GET /api/v2/recipients", Subject: ratelimit.SubjectUser, Limit: 500, Window: 24 * time.Hour},
+	},
+}
+
+// newLimiter Create the rate limiter with the configured store
+func newLimiter(client *ent.Client) (*ratelimit.Limiter, error) {
+	config, err := ratelimit.LoadConfig(defaultRateLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	var store ratelimit.Store = ratelimit.NewMemoryStore()
+	if config.Store == ratelimit.StoreDatabase {
+		store = limitstore.New(client)
+	}
+	return ratelimit.New(config, store), nil
+}
+
+// serveGRPC Serve the gRPC API
+func serveGRPC(server *grpc.Server, addr string) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("failed to listen for gRPC: %v", err)
+	}
+	if err := server.Serve(lis); err != nil {
+		log.Fatalf("failed to serve gRPC: %v", err)
+	}
+}
+
 // setupRouter Routing
-func setupRouter(client *ent.Client, natsConn *nats.Conn) *gin.Engine {
+func setupRouter(client *ent.Client, usersService *services.UsersService, credentialsService *credentials.Service, balanceProjector *projections.BalanceProjector, issuer *tokens.Issuer, keys *tokens.KeyStore, verifier *auth.Verifier, apiKeys *apikeys.Service, limiter *ratelimit.Limiter) *gin.Engine {
 	r := gin.Default()
+	// The IP limits apply to the client address, which only trusted proxies
+	// may forward.
+	if err := r.SetTrustedProxies(limiter.TrustedProxies()); err != nil {
+		log.Fatalf("invalid trusted proxies: %v", err)
+	}
+
+	userController := controllers.NewUserController(usersService, issuer, credentialsService)
+	projectionsController := controllers.NewProjectionsController(client, balanceProjector)
+	authController := controllers.NewAuthController(issuer, keys, credentialsService)
+	mfaController := controllers.NewMFAController(credentialsService)
+	privacyController := controllers.NewPrivacyController(usersService)
+	aliasController := controllers.NewAliasController(usersService)
+	apiKeysController := controllers.NewAPIKeysController(apiKeys)
+	sessionsController := controllers.NewSessionsController(issuer)
+	referralsController := controllers.NewReferralsController(usersService)
+	adminController := controllers.NewAdminController(client, services.NewAdminService(client, issuer))
 
-	userController := controllers.NewUserController(client, natsConn)
+	authenticate := auth.Middleware(verifier, apiKeys)
+	// limit follows authenticate on authenticated routes, so that it knows the caller.
+	limit := ratelimit.Middleware(limiter)
+	walletRead := auth.Scope(auth.ScopeWalletRead)
 
 	v1 := r.Group("/api/v1")
 	{
-		v1.POST("/createUser", userController.CreateUser)
-		v1.GET("/balance/:email", userController.GetBalance)
+		v1.POST("/createUser", limit, userController.CreateUser)
+		v1.GET("/balance/:email", authenticate, limit, walletRead, userController.GetBalance)
+	}
+
+	admin := v1.Group("/admin", authenticate, limit, auth.Scope(auth.ScopeAdmin))
+	{
+		admin.GET("/projections/balances", projectionsController.GetBalanceProjectionStatus)
+		admin.POST("/projections/balances/rebuild", projectionsController.RebuildBalanceProjection)
 	}
 
+	v2 := r.Group("/api/v2")
+	{
+		v2.POST("/users", limit, userController.PostUser)
+		v2.GET("/users", authenticate, limit, auth.Permission(auth.PermUsersRead), userController.ListUsers)
+		v2.GET("/users/:id", authenticate, limit, userController.GetUser)
+		v2.PATCH("/users/:id", authenticate, limit, userController.UpdateUser)
+		v2.GET("/users/:id/profile-history", authenticate, limit, userController.ProfileHistory)
+		v2.GET("/users/:id/balance", authenticate, limit, walletRead, userController.GetUserBalance)
+		v2.POST("/users/:id/email-verification", authenticate, limit, userController.ResendVerification)
+		v2.POST("/users/:id/phone-verification", authenticate, limit, userController.SendPhoneVerification)
+		v2.POST("/users/:id/phone-verification/confirm", authenticate, limit, userController.VerifyPhone)
+		v2.PUT("/users/:id/password", authenticate, limit, authController.ChangePassword)
+		v2.POST("/users/:id/mfa/totp", authenticate, limit, mfaController.EnrollTOTP)
+		v2.POST("/users/:id/mfa/totp/confirm", authenticate, limit, mfaController.ConfirmTOTP)
+		v2.DELETE("/users/:id/mfa/totp", authenticate, limit, mfaController.DisableTOTP)
+		v2.POST("/users/:id/mfa/recovery-codes", authenticate, limit, mfaController.RegenerateRecoveryCodes)
+		v2.POST("/users/:id/data-exports", authenticate, limit, privacyController.RequestExport)
+		v2.POST("/users/:id/erasure", authenticate, limit, privacyController.RequestErasure)
+		v2.GET("/users/:id/data-requests/:requestId", authenticate, limit, privacyController.GetDataRequest)
+		v2.GET("/users/:id/data-requests/:requestId/archive", authenticate, limit, privacyController.DownloadExport)
+		v2.GET("/users/:id/aliases", authenticate, limit, aliasController.ListAliases)
+		v2.PUT("/users/:id/aliases/:type", authenticate, limit, aliasController.SetAlias)
+		v2.DELETE("/users/:id/aliases/:type", authenticate, limit, aliasController.RemoveAlias)
+		v2.GET("/recipients", authenticate, limit, aliasController.PreviewRecipient)
+		v2.GET("/email-verification", limit, userController.VerifyEmail)
+		v2.POST("/email-verification", limit, userController.VerifyEmail)
+		v2.POST("/users/:id/api-keys", authenticate, limit, apiKeysController.CreateAPIKey)
+		v2.GET("/users/:id/api-keys", authenticate, limit, apiKeysController.ListAPIKeys)
+		v2.GET("/users/:id/api-keys/:keyId", authenticate, limit, apiKeysController.GetAPIKey)
+		v2.POST("/users/:id/api-keys/:keyId/rotate", authenticate, limit, apiKeysController.RotateAPIKey)
+		v2.DELETE("/users/:id/api-keys/:keyId", authenticate, limit, apiKeysController.RevokeAPIKey)
+		v2.GET("/users/:id/sessions", authenticate, limit, sessionsController.ListSessions)
+		v2.DELETE("/users/:id/sessions", authenticate, limit, sessionsController.RevokeSessions)
+		v2.DELETE("/users/:id/sessions/:sessionId", authenticate, limit, sessionsController.RevokeSession)
+		v2.GET("/users/:id/referral-code", authenticate, limit, referralsController.GetReferralCode)
+		v2.GET("/users/:id/referrals", authenticate, limit, referralsController.ListReferrals)
+		v2.POST("/auth/token", limit, authController.Token)
+		v2.POST("/auth/revoke", limit, authController.Revoke)
+		v2.POST("/auth/step-up", authenticate, limit, mfaController.StepUp)
+		v2.POST("/auth/password-reset", limit, authController.RequestPasswordReset)
+		v2.POST("/auth/password-reset/confirm", limit, authController.ConfirmPasswordReset)
+	}
+
+	// Operators reach the back-office routes through the permissions of their roles.
+	backOffice := v2.Group("/admin", authenticate, limit)
+	{
+		backOffice.GET("/users", auth.Permission(auth.PermUsersRead), adminController.SearchUsers)
+		backOffice.GET("/users/:id", auth.Permission(auth.PermUsersRead), adminController.GetUser)
+		backOffice.PUT("/users/:id/roles", auth.Permission(auth.PermRolesManage), adminController.SetRoles)
+		backOffice.GET("/users/:id/sessions", auth.Permission(auth.PermUsersRead), adminController.ListSessions)
+		backOffice.DELETE("/users/:id/sessions/:sessionId", auth.Permission(auth.PermSessionsRevoke), adminController.RevokeSession)
+		backOffice.POST("/users/:id/sessions/revoke", auth.Permission(auth.PermSessionsRevoke), adminController.RevokeSessions)
+		backOffice.GET("/audit-log", auth.Permission(auth.PermAuditRead), adminController.ListAuditLog)
+		backOffice.GET("/referrals", auth.Permission(auth.PermLedgerRead), adminController.ListReferrals)
+	}
+
+	r.GET(auth.JWKSPath, limit, authController.JWKS)
+
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	return r
@@ -0,0 +1,166 @@
+// Package profiles validates and normalizes the contact data of user
+// profiles, which feeds KYC checks, statements and notifications.
+package profiles
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+
+	// The runtime image has no zoneinfo, so the time zone database is
+	// embedded for LoadLocation.
+	_ "time/tzdata"
+)
+
+// DateLayout is the ISO 8601 layout of dates of birth.
+const DateLayout = "2006-01-02"
+
+const (
+	maxNameLength       = 200
+	maxLineLength       = 200
+	maxPostalCode       = 16
+	maxAge              = 130
+	minPhoneDigits      = 8
+	maxPhoneDigits      = 15
+	internationalPrefix = "00"
+)
+
+var (
+	ErrInvalidLegalName   = errors.New("legal_name must be at most 200 printable characters")
+	ErrInvalidDateOfBirth = errors.New("date_of_birth must be a past date formatted as YYYY-MM-DD")
+	ErrInvalidPhone       = errors.New("phone must be an international number in E.164 format, such as +14155550123")
+	ErrInvalidAddress     = errors.New("address needs line1, city and a valid ISO 3166-1 alpha-2 country")
+	ErrInvalidLocale      = errors.New("locale must be a BCP 47 language tag, such as en-US")
+	ErrInvalidTimezone    = errors.New("timezone must be an IANA time zone, such as Europe/Berlin")
+)
+
+// Address is a postal address. Country is an ISO 3166-1 alpha-2 code.
+type Address struct {
+	Line1      string `json:"line1" example:"1 Main Street"`
+	Line2      string `json:"line2,omitempty" example:"Apt 4"`
+	City       string `json:"city" example:"Springfield"`
+	Region     string `json:"region,omitempty" example:"IL"`
+	PostalCode string `json:"postal_code,omitempty" example:"62701"`
+	Country    string `json:"country" example:"US"`
+}
+
+// IsZero reports whether every field of a is empty.
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
+
+// LegalName trims a legal name and collapses its inner whitespace.
+func LegalName(name string) (string, error) {
+	name = collapse(name)
+	if utf8.RuneCountInString(name) > maxNameLength || !printable(name) {
+		return "", ErrInvalidLegalName
+	}
+	return name, nil
+}
+
+// DateOfBirth parses a date of birth. It must lie in the past, but not more
+// than 130 years before now.
+func DateOfBirth(date string, now time.Time) (time.Time, error) {
+	dob, err := time.Parse(DateLayout, strings.TrimSpace(date))
+	if err != nil || !dob.Before(now) || dob.Before(now.AddDate(-maxAge, 0, 0)) {
+		return time.Time{}, ErrInvalidDateOfBirth
+	}
+	return dob, nil
+}
+
+// Phone normalizes a phone number to E.164. The number must carry its
+// country calling code, with a leading + or 00; spaces, dots, dashes and
+// parentheses are dropped.
+func Phone(phone string) (string, error) {
+	phone = strings.TrimSpace(phone)
+	switch {
+	case strings.HasPrefix(phone, "+"):
+		phone = phone[1:]
+	case strings.HasPrefix(phone, internationalPrefix):
+		phone = phone[len(internationalPrefix):]
+	default:
+		return "", ErrInvalidPhone
+	}
+
+	var digits strings.Builder
+	for _, r := range phone {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == ' ' || r == '.' || r == '-' || r == '(' || r == ')':
+		default:
+			return "", ErrInvalidPhone
+		}
+	}
+	number := digits.String()
+	if len(number) < minPhoneDigits || len(number) > maxPhoneDigits || number[0] == '0' {
+		return "", ErrInvalidPhone
+	}
+	return "+" + number, nil
+}
+
+// NormalizeAddress trims the lines of an address, upper-cases its country
+// and postal code, and checks that it can receive mail.
+func NormalizeAddress(a Address) (Address, error) {
+	a = Address{
+		Line1:      collapse(a.Line1),
+		Line2:      collapse(a.Line2),
+		City:       collapse(a.City),
+		Region:     collapse(a.Region),
+		PostalCode: strings.ToUpper(collapse(a.PostalCode)),
+		Country:    strings.ToUpper(strings.TrimSpace(a.Country)),
+	}
+	if a.Line1 == "" || a.City == "" || len(a.PostalCode) > maxPostalCode {
+		return Address{}, ErrInvalidAddress
+	}
+	for _, line := range []string{a.Line1, a.Line2, a.City, a.Region, a.PostalCode} {
+		if utf8.RuneCountInString(line) > maxLineLength || !printable(line) {
+			return Address{}, ErrInvalidAddress
+		}
+	}
+	if len(a.Country) != 2 {
+		return Address{}, ErrInvalidAddress
+	}
+	if region, err := language.ParseRegion(a.Country); err != nil || !region.IsCountry() {
+		return Address{}, ErrInvalidAddress
+	}
+	return a, nil
+}
+
+// Locale canonicalizes a BCP 47 language tag, e.g. en_us becomes en-US.
+func Locale(locale string) (string, error) {
+	tag, err := language.Parse(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if err != nil || tag == language.Und {
+		return "", ErrInvalidLocale
+	}
+	return tag.String(), nil
+}
+
+// Timezone checks that timezone names a zone of the IANA time zone database.
+func Timezone(timezone string) (string, error) {
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" || timezone == "Local" {
+		return "", ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return "", ErrInvalidTimezone
+	}
+	return timezone, nil
+}
+
+func collapse(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func printable(s string) bool {
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,35 @@
+package profiles
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPhone(t *testing.T) {
+	tests := []struct {
+		phone   string
+		want    string
+		wantErr error
+	}{
+		{phone: "+14155550123", want: "+14155550123"},
+		{phone: "  +1 (415) 555-0123 ", want: "+14155550123"},
+		{phone: "0044 20.7946.0958", want: "+442079460958"},
+		{phone: "+49 30 1234567", want: "+49301234567"},
+		{phone: "+1234567", wantErr: ErrInvalidPhone},          // too short
+		{phone: "+1234567890123456", wantErr: ErrInvalidPhone}, // too long
+		{phone: "4155550123", wantErr: ErrInvalidPhone},        // no country code
+		{phone: "+0155550123", wantErr: ErrInvalidPhone},       // country codes do not start with 0
+		{phone: "+1 415 555 0123 ext 4", wantErr: ErrInvalidPhone},
+		{phone: "+1/415/555/0123", wantErr: ErrInvalidPhone},
+		{phone: "", wantErr: ErrInvalidPhone},
+		{phone: "+", wantErr: ErrInvalidPhone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.phone, func(t *testing.T) {
+			got, err := Phone(tt.phone)
+			if !errors.Is(err, tt.wantErr) || got != tt.want {
+				t.Errorf("Phone(%q) = %q, %v; want %q, %v", tt.phone, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
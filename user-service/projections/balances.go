@@ -0,0 +1,318 @@
+package projections
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"shared/messages"
+	"sync/atomic"
+	"time"
+	"user-service/ent"
+	"user-service/ent/balanceprojection"
+
+	"github.com/nats-io/nats.go"
+)
+
+// QueueGroup is the queue group every replica of user-service joins, so that
+// each event is applied to the shared read model by one replica.
+const QueueGroup = "user-service"
+
+const (
+	snapshotRequestTimeout = 30 * time.Second
+
+	// catchUpInterval is how often the projection is rebuilt from a snapshot,
+	// which restores a wallet whose last event was lost.
+	catchUpInterval = 15 * time.Minute
+	// gapRebuildDelay is how long a rebuild waits after a missed event was
+	// noticed, so a burst of events that skipped ahead causes one rebuild, and
+	// events another replica is still applying do not cause one at all.
+	gapRebuildDelay = 10 * time.Second
+	// rebuildBatchSize bounds the rows inserted by one statement of a rebuild.
+	rebuildBatchSize = 500
+)
+
+// BalanceProjector keeps the balance_projections table in sync with the
+// balance-changed events published by transactions-service. Core NATS does not
+// keep events for subscribers that are away, so the projection is rebuilt from
+// a snapshot on start, after reconnecting to NATS, when an event shows that
+// the previous one of its wallet was missed, and every catchUpInterval.
+type BalanceProjector struct {
+	client   *ent.Client
+	natsConn *nats.Conn
+
+	// rebuilding is set while this replica rebuilds the projection, and
+	// rebuildScheduled while a rebuild waits for gapRebuildDelay.
+	rebuilding       atomic.Bool
+	rebuildScheduled atomic.Bool
+}
+
+// Status describes how fresh the projection is.
+type Status struct {
+	Wallets      int `json:"wallets"`
+	LastSequence int `json:"last_sequence"`
+	// LastEventAt is when transactions-service produced the newest balance
+	// in the projection, and LastAppliedAt when it was written here.
+	LastEventAt   *time.Time `json:"last_event_at,omitempty"`
+	LastAppliedAt *time.Time `json:"last_applied_at,omitempty"`
+	// LagSeconds is how long the newest balance took to reach the projection.
+	LagSeconds float64 `json:"lag_seconds"`
+}
+
+func NewBalanceProjector(client *ent.Client, natsConn *nats.Conn) *BalanceProjector {
+	return &BalanceProjector{client: client, natsConn: natsConn}
+}
+
+// Start subscribes to balance-changed events and keeps catching the
+// projection up in the background until ctx is done.
+func (p *BalanceProjector) Start(ctx context.Context) error {
+	_, err := p.natsConn.QueueSubscribe(messages.EventBalanceChanged, QueueGroup, func(m *nats.Msg) {
+		p.handleBalanceChanged(ctx, m)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Events published while the connection was down are lost.
+	p.natsConn.SetReconnectHandler(func(*nats.Conn) {
+		go p.rebuildNow(ctx, "reconnected to NATS")
+	})
+
+	go func() {
+		// Events published while no replica was running are lost too.
+		p.rebuildNow(ctx, "start")
+
+		ticker := time.NewTicker(catchUpInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.rebuildNow(ctx, "periodic catch-up")
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (p *BalanceProjector) handleBalanceChanged(ctx context.Context, m *nats.Msg) {
+	var event messages.Event
+	if err := json.Unmarshal(m.Data, &event); err != nil {
+		log.Printf("error unmarshalling balance-changed event: %v", err)
+		return
+	}
+
+	var data messages.BalanceChanged
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		log.Printf("error unmarshalling balance-changed event %d: %v", event.Sequence, err)
+		return
+	}
+
+	missed, err := p.missedPrevious(ctx, data)
+	if err != nil {
+		log.Printf("error checking the sequence of balance-changed event %d: %v", event.Sequence, err)
+	}
+	if missed {
+		p.scheduleRebuild(ctx, fmt.Sprintf("event %d of wallet %d follows event %d, which was not applied", event.Sequence, data.UserID, data.PreviousSequence))
+	}
+
+	if _, err := p.Apply(ctx, event.Sequence, event.OccurredAt, data); err != nil {
+		log.Printf("error applying balance-changed event %d: %v", event.Sequence, err)
+	}
+}
+
+// missedPrevious reports whether the projection of a wallet is older than the
+// event that precedes data. The balance of data replaces it all the same, but
+// the events of other wallets published at the time were probably missed too.
+func (p *BalanceProjector) missedPrevious(ctx context.Context, data messages.BalanceChanged) (bool, error) {
+	if data.PreviousSequence == 0 {
+		return false, nil
+	}
+	current, err := p.client.BalanceProjection.Get(ctx, data.UserID)
+	if ent.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return current.Sequence < data.PreviousSequence, nil
+}
+
+// Apply stores the balance carried by an event unless a newer event has already
+// been applied for the wallet. asOf is when the event occurred. It reports
+// whether the projection changed.
+func (p *BalanceProjector) Apply(ctx context.Context, sequence int, asOf time.Time, data messages.BalanceChanged) (bool, error) {
+	// The second attempt updates a row that a rebuild or another event
+	// inserted after the first update found none.
+	for attempt := 0; attempt < 2; attempt++ {
+		n, err := p.client.BalanceProjection.Update().
+			Where(
+				balanceprojection.IDEQ(data.UserID),
+				balanceprojection.SequenceLT(sequence),
+			).
+			SetEmail(data.Email).
+			SetBalance(data.Balance).
+			SetCurrency(data.Currency).
+			SetSequence(sequence).
+			SetAsOf(asOf).
+			Save(ctx)
+		if err != nil {
+			return false, err
+		}
+		if n > 0 {
+			return true, nil
+		}
+
+		err = p.client.BalanceProjection.Create().
+			SetID(data.UserID).
+			SetEmail(data.Email).
+			SetBalance(data.Balance).
+			SetCurrency(data.Currency).
+			SetSequence(sequence).
+			SetAsOf(asOf).
+			Exec(ctx)
+		if !ent.IsConstraintError(err) {
+			return err == nil, err
+		}
+	}
+	// The wallet is already projected at this or a later sequence.
+	return false, nil
+}
+
+// Rebuild replaces the projection with a snapshot of every wallet requested
+// from transactions-service, in one transaction, and returns the number of
+// wallets in the snapshot. Wallets that an event newer than the snapshot
+// already reached are kept; every other row is replaced, so wallets that no
+// longer exist are dropped and rows ahead of the snapshot are corrected.
+// Reads go on while it runs.
+func (p *BalanceProjector) Rebuild(ctx context.Context) (int, error) {
+	msg, err := p.natsConn.Request(messages.SubjectBalanceSnapshot, nil, snapshotRequestTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("requesting balance snapshot: %w", err)
+	}
+
+	var snapshot messages.BalanceSnapshot
+	if err := json.Unmarshal(msg.Data, &snapshot); err != nil {
+		return 0, fmt.Errorf("malformed balance snapshot: %w", err)
+	}
+	if !snapshot.IsSuccess() {
+		return 0, errors.New(snapshot.Message)
+	}
+
+	tx, err := p.client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("starting transaction: %w", err)
+	}
+	if err := replaceBalances(ctx, tx, snapshot); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("committing transaction: %w", err)
+	}
+	return len(snapshot.Balances), nil
+}
+
+func replaceBalances(ctx context.Context, tx *ent.Tx, snapshot messages.BalanceSnapshot) error {
+	newer, err := tx.BalanceProjection.Query().
+		Where(balanceprojection.SequenceGT(snapshot.LastSequence)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	kept := make(map[int]bool, len(newer))
+	for _, id := range newer {
+		kept[id] = true
+	}
+
+	_, err = tx.BalanceProjection.Delete().
+		Where(balanceprojection.SequenceLTE(snapshot.LastSequence)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	batch := make([]*ent.BalanceProjectionCreate, 0, rebuildBatchSize)
+	for _, b := range snapshot.Balances {
+		if kept[b.UserID] {
+			continue
+		}
+		batch = append(batch, tx.BalanceProjection.Create().
+			SetID(b.UserID).
+			SetEmail(b.Email).
+			SetBalance(b.Balance).
+			SetCurrency(b.Currency).
+			SetSequence(b.Sequence).
+			SetAsOf(snapshot.TakenAt))
+		if len(batch) == rebuildBatchSize {
+			if err := tx.BalanceProjection.CreateBulk(batch...).Exec(ctx); err != nil {
+				return fmt.Errorf("storing snapshot: %w", err)
+			}
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		if err := tx.BalanceProjection.CreateBulk(batch...).Exec(ctx); err != nil {
+			return fmt.Errorf("storing snapshot: %w", err)
+		}
+	}
+	return nil
+}
+
+// rebuildNow rebuilds the projection unless this replica is already doing so.
+func (p *BalanceProjector) rebuildNow(ctx context.Context, reason string) {
+	if !p.rebuilding.CompareAndSwap(false, true) {
+		return
+	}
+	defer p.rebuilding.Store(false)
+
+	n, err := p.Rebuild(ctx)
+	if err != nil {
+		log.Printf("error rebuilding balance projection (%s): %v", reason, err)
+		return
+	}
+	log.Printf("rebuilt balance projection with %d wallets (%s)", n, reason)
+}
+
+// scheduleRebuild rebuilds the projection after gapRebuildDelay, unless a
+// rebuild is already scheduled.
+func (p *BalanceProjector) scheduleRebuild(ctx context.Context, reason string) {
+	if !p.rebuildScheduled.CompareAndSwap(false, true) {
+		return
+	}
+	log.Printf("balance projection missed events, rebuilding in %s: %s", gapRebuildDelay, reason)
+	time.AfterFunc(gapRebuildDelay, func() {
+		p.rebuildScheduled.Store(false)
+		p.rebuildNow(ctx, "missed events")
+	})
+}
+
+// Status reports the size of the projection and how long its newest balance
+// took to arrive.
+func (p *BalanceProjector) Status(ctx context.Context) (Status, error) {
+	var status Status
+
+	wallets, err := p.client.BalanceProjection.Query().Count(ctx)
+	if err != nil || wallets == 0 {
+		return status, err
+	}
+	status.Wallets = wallets
+
+	last, err := p.client.BalanceProjection.Query().
+		Order(ent.Desc(balanceprojection.FieldSequence)).
+		First(ctx)
+	if err != nil {
+		return status, err
+	}
+	status.LastSequence = last.Sequence
+	status.LastAppliedAt = &last.UpdatedAt
+	if !last.AsOf.IsZero() {
+		status.LastEventAt = &last.AsOf
+		status.LagSeconds = last.UpdatedAt.Sub(last.AsOf).Seconds()
+	}
+
+	return status, nil
+}
@@ -0,0 +1,110 @@
+package projections
+
+import (
+	"context"
+	"shared/messages"
+	"testing"
+	"time"
+	"user-service/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestReplaceBalances(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	p := NewBalanceProjector(client, nil)
+
+	before := time.Now().Add(-time.Hour)
+	for _, row := range []struct {
+		id       int
+		balance  float64
+		sequence int
+	}{
+		{id: 1, balance: 10, sequence: 5},  // behind the snapshot
+		{id: 2, balance: 99, sequence: 50}, // reached by an event newer than the snapshot
+		{id: 3, balance: 30, sequence: 15}, // wallet no longer exists
+		{id: 4, balance: 40, sequence: 19}, // ahead of its snapshot entry
+	} {
+		client.BalanceProjection.Create().
+			SetID(row.id).
+			SetEmail("wallet@example.com").
+			SetBalance(row.balance).
+			SetCurrency("USD").
+			SetSequence(row.sequence).
+			SetAsOf(before).
+			ExecX(ctx)
+	}
+
+	takenAt := time.Now()
+	snapshot := messages.BalanceSnapshot{
+		LastSequence: 20,
+		TakenAt:      takenAt,
+		Balances: []messages.BalanceSnapshotEntry{
+			{BalanceChanged: messages.BalanceChanged{UserID: 1, Balance: 12, Currency: "USD"}, Sequence: 7},
+			{BalanceChanged: messages.BalanceChanged{UserID: 2, Balance: 80, Currency: "USD"}, Sequence: 18},
+			{BalanceChanged: messages.BalanceChanged{UserID: 4, Balance: 4, Currency: "USD"}, Sequence: 10},
+			{BalanceChanged: messages.BalanceChanged{UserID: 5, Balance: 5, Currency: "USD"}, Sequence: 0},
+		},
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := replaceBalances(ctx, tx, snapshot); err != nil {
+		tx.Rollback()
+		t.Fatalf("replaceBalances: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		id       int
+		balance  float64
+		sequence int
+		asOf     time.Time
+	}{
+		{id: 1, balance: 12, sequence: 7, asOf: takenAt},
+		{id: 2, balance: 99, sequence: 50, asOf: before},
+		{id: 4, balance: 4, sequence: 10, asOf: takenAt},
+		{id: 5, balance: 5, sequence: 0, asOf: takenAt},
+	}
+	for _, tt := range tests {
+		got, err := client.BalanceProjection.Get(ctx, tt.id)
+		if err != nil {
+			t.Errorf("wallet %d: %v", tt.id, err)
+			continue
+		}
+		if got.Balance != tt.balance || got.Sequence != tt.sequence || !got.AsOf.Equal(tt.asOf) {
+			t.Errorf("wallet %d = %v at %d as of %v, want %v at %d as of %v", tt.id, got.Balance, got.Sequence, got.AsOf, tt.balance, tt.sequence, tt.asOf)
+		}
+	}
+	if n := client.BalanceProjection.Query().CountX(ctx); n != len(tests) {
+		t.Errorf("projection has %d wallets, want %d", n, len(tests))
+	}
+
+	missedTests := []struct {
+		name     string
+		data     messages.BalanceChanged
+		wantMiss bool
+	}{
+		{name: "first event of a new wallet", data: messages.BalanceChanged{UserID: 6}},
+		{name: "event of an unknown wallet", data: messages.BalanceChanged{UserID: 6, PreviousSequence: 3}, wantMiss: true},
+		{name: "next event", data: messages.BalanceChanged{UserID: 1, PreviousSequence: 7}},
+		{name: "event reordered behind a newer one", data: messages.BalanceChanged{UserID: 2, PreviousSequence: 40}},
+		{name: "event after a missed one", data: messages.BalanceChanged{UserID: 1, PreviousSequence: 8}, wantMiss: true},
+	}
+	for _, tt := range missedTests {
+		t.Run(tt.name, func(t *testing.T) {
+			missed, err := p.missedPrevious(ctx, tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if missed != tt.wantMiss {
+				t.Errorf("missedPrevious = %v, want %v", missed, tt.wantMiss)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package proto
+
+//go:generate sh -c "cd .. && go run github.com/bufbuild/buf/cmd/buf@v1.34.0 generate"
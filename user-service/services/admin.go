@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"shared/auth"
+	"slices"
+	"strconv"
+	"time"
+	"user-service/audit"
+	"user-service/ent"
+	"user-service/ent/balanceprojection"
+	"user-service/ent/predicate"
+	"user-service/ent/referral"
+	"user-service/ent/user"
+	"user-service/tokens"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+)
+
+var (
+	ErrUnknownRole           = errors.New("unknown role")
+	ErrUnknownReferralStatus = errors.New("unknown referral status")
+)
+
+// UserSearch selects users on the admin API; zero fields match every user.
+type UserSearch struct {
+	// Email matches users whose email contains it, ignoring case.
+	Email string
+	Role  string
+}
+
+// AdminUser is a user together with the projected balance of its wallet, if
+// the projection has one.
+type AdminUser struct {
+	*ent.User
+	Balance *ent.BalanceProjection
+}
+
+// AdminService holds the back-office operations on users. Every change is
+// recorded in the audit log in the transaction that makes it.
+type AdminService struct {
+	client *ent.Client
+	issuer *tokens.Issuer
+}
+
+func NewAdminService(client *ent.Client, issuer *tokens.Issuer) *AdminService {
+	return &AdminService{client: client, issuer: issuer}
+}
+
+// SearchUsers returns a page of the users matching search, ordered by ID, and
+// the number of matching users.
+func (s *AdminService) SearchUsers(ctx context.Context, search UserSearch, limit, offset int) ([]AdminUser, int, error) {
+	query := s.client.User.Query()
+	if search.Email != "" {
+		query = query.Where(user.EmailContainsFold(search.Email))
+	}
+	if search.Role != "" {
+		query = query.Where(hasRole(search.Role))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users, err := query.
+		Order(ent.Asc(user.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	balances, err := s.client.BalanceProjection.Query().
+		Where(balanceprojection.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	byID := make(map[int]*ent.BalanceProjection, len(balances))
+	for _, b := range balances {
+		byID[b.ID] = b
+	}
+
+	result := make([]AdminUser, 0, len(users))
+	for _, u := range users {
+		result = append(result, AdminUser{User: u, Balance: byID[u.ID]})
+	}
+	return result, total, nil
+}
+
+// GetUser returns a user with its projected balance.
+func (s *AdminService) GetUser(ctx context.Context, id int) (AdminUser, error) {
+	u, err := s.client.User.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return AdminUser{}, fmt.Errorf("%w: id %d", ErrUserNotFound, id)
+	}
+	if err != nil {
+		return AdminUser{}, err
+	}
+
+	b, err := s.client.BalanceProjection.Get(ctx, id)
+	if err != nil && !ent.IsNotFound(err) {
+		return AdminUser{}, err
+	}
+	return AdminUser{User: u, Balance: b}, nil
+}
+
+// SetRoles replaces the roles of a user. They take effect in the access
+// tokens issued from then on.
+func (s *AdminService) SetRoles(ctx context.Context, id int, roles []string) (*ent.User, error) {
+	normalized := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if !auth.IsRole(role) {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownRole, role)
+		}
+		if !slices.Contains(normalized, role) {
+			normalized = append(normalized, role)
+		}
+	}
+	slices.Sort(normalized)
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	u, err := tx.User.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: id %d", ErrUserNotFound, id)
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	previous := u.Roles
+
+	u, err = tx.User.UpdateOne(u).SetRoles(normalized).Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = audit.Record(ctx, tx.Client(), audit.ActionUserRolesSet, audit.TargetUser, strconv.Itoa(id), map[string]any{
+		"previous": previous,
+		"roles":    normalized,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("recording audit log: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return u, nil
+}
+
+// ListSessions returns the active sessions of a user.
+func (s *AdminService) ListSessions(ctx context.Context, id int) ([]*ent.Session, error) {
+	exists, err := s.client.User.Query().Where(user.IDEQ(id)).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: id %d", ErrUserNotFound, id)
+	}
+	return s.issuer.ListSessions(ctx, id)
+}
+
+// RevokeSession revokes a session of a user, whose access tokens stop
+// working at once.
+func (s *AdminService) RevokeSession(ctx context.Context, id int, sessionID string) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	if err := tokens.RevokeUserSession(ctx, tx, id, sessionID, time.Now()); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = audit.Record(ctx, tx.Client(), audit.ActionUserSessionRevoke, audit.TargetUser, strconv.Itoa(id), map[string]any{
+		"session": sessionID,
+	})
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("recording audit log: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	s.issuer.DenySessions(sessionID)
+	return nil
+}
+
+// RevokeSessions revokes every session of a user, signing it out everywhere
+// at once. It returns how many sessions were revoked.
+func (s *AdminService) RevokeSessions(ctx context.Context, id int) (int, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	exists, err := tx.User.Query().Where(user.IDEQ(id)).Exist(ctx)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if !exists {
+		tx.Rollback()
+		return 0, fmt.Errorf("%w: id %d", ErrUserNotFound, id)
+	}
+
+	revoked, err := tokens.RevokeUserSessions(ctx, tx, id, "", time.Now())
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	err = audit.Record(ctx, tx.Client(), audit.ActionUserSessionsRevoke, audit.TargetUser, strconv.Itoa(id), map[string]any{
+		"revoked": len(revoked),
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("recording audit log: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("committing transaction: %w", err)
+	}
+	s.issuer.DenySessions(revoked...)
+	return len(revoked), nil
+}
+
+// ListReferrals returns a page of the referrals with the given status, or of
+// every referral when it is empty, the latest first, and the number of
+// matching referrals. Failed payouts are found with status failed.
+func (s *AdminService) ListReferrals(ctx context.Context, status string, limit, offset int) ([]*ent.Referral, int, error) {
+	query := s.client.Referral.Query()
+	if status != "" {
+		if err := referral.StatusValidator(referral.Status(status)); err != nil {
+			return nil, 0, fmt.Errorf("%w: %q", ErrUnknownReferralStatus, status)
+		}
+		query = query.Where(referral.StatusEQ(referral.Status(status)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	referrals, err := query.
+		Order(ent.Desc(referral.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return referrals, total, nil
+}
+
+// hasRole matches the users holding role.
+func hasRole(role string) predicate.User {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(user.FieldRoles), role))
+	}
+}
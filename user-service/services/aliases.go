@@ -0,0 +1,284 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"regexp"
+	"shared/messages"
+	"strings"
+	"unicode/utf8"
+	"user-service/ent"
+	"user-service/ent/alias"
+	"user-service/ent/user"
+	"user-service/profiles"
+
+	"github.com/nats-io/nats.go"
+)
+
+var (
+	ErrAliasNotFound     = errors.New("alias not found")
+	ErrAliasTaken        = errors.New("alias belongs to another user")
+	ErrInvalidAlias      = errors.New("alias must be a @handle, an email or an international phone number")
+	ErrInvalidHandle     = errors.New("handle must be 3 to 30 lower-case letters, digits or underscores, starting with a letter")
+	ErrUnknownAliasType  = errors.New("alias type must be handle, email or phone")
+	ErrEmailNotVerified  = errors.New("email must be verified before it can be used as an alias")
+	ErrPhoneNotInProfile = errors.New("phone alias must be the phone number of the user's profile")
+	ErrPhoneNotVerified  = errors.New("phone must be verified before it can be used as an alias")
+	ErrEmailNotOwned     = errors.New("email alias must be the email of the user")
+)
+
+var handlePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{2,29}$`)
+
+// ListAliases returns the payment aliases of a user.
+func (s *UsersService) ListAliases(ctx context.Context, userID int) ([]*ent.Alias, error) {
+	if _, err := s.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.client.Alias.Query().
+		Where(alias.UserID(userID)).
+		Order(ent.Asc(alias.FieldType)).
+		All(ctx)
+}
+
+// SetAlias registers the alias of the given type for a user, replacing the
+// one they had. Handles are chosen freely; email and phone aliases must be
+// the user's verified email and the verified phone of their profile, which
+// value defaults to.
+func (s *UsersService) SetAlias(ctx context.Context, userID int, aliasType, value string) (*ent.Alias, error) {
+	u, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.ErasedAt != nil {
+		return nil, ErrUserErased
+	}
+
+	switch alias.Type(aliasType) {
+	case alias.TypeHandle:
+		if value, err = normalizeHandle(value); err != nil {
+			return nil, err
+		}
+	case alias.TypeEmail:
+		if value != "" && !strings.EqualFold(strings.TrimSpace(value), u.Email) {
+			return nil, ErrEmailNotOwned
+		}
+		if u.VerifiedAt == nil {
+			return nil, ErrEmailNotVerified
+		}
+		value = strings.ToLower(u.Email)
+	case alias.TypePhone:
+		if value != "" {
+			if value, err = profiles.Phone(value); err != nil {
+				return nil, err
+			}
+		}
+		if u.Phone == "" || (value != "" && value != u.Phone) {
+			return nil, ErrPhoneNotInProfile
+		}
+		if u.PhoneVerifiedAt == nil {
+			return nil, ErrPhoneNotVerified
+		}
+		value = u.Phone
+	default:
+		return nil, ErrUnknownAliasType
+	}
+
+	taken, err := s.client.Alias.Query().
+		Where(alias.TypeEQ(alias.Type(aliasType)), alias.Value(value), alias.UserIDNEQ(userID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, ErrAliasTaken
+	}
+
+	a, err := s.client.Alias.Query().Where(alias.UserID(userID), alias.TypeEQ(alias.Type(aliasType))).Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		a, err = s.client.Alias.Create().
+			SetUserID(userID).
+			SetType(alias.Type(aliasType)).
+			SetValue(value).
+			Save(ctx)
+	case err == nil && a.Value != value:
+		a, err = s.client.Alias.UpdateOne(a).SetValue(value).Save(ctx)
+	}
+	if ent.IsConstraintError(err) {
+		return nil, ErrAliasTaken
+	}
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// RemoveAlias removes the alias of the given type from a user.
+func (s *UsersService) RemoveAlias(ctx context.Context, userID int, aliasType string) error {
+	if err := alias.TypeValidator(alias.Type(aliasType)); err != nil {
+		return ErrUnknownAliasType
+	}
+	n, err := s.client.Alias.Delete().
+		Where(alias.UserID(userID), alias.TypeEQ(alias.Type(aliasType))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrAliasNotFound
+	}
+	return nil
+}
+
+// ResolveAlias finds the user a handle, email or phone number belongs to.
+// A leading @ marks a handle, a leading + or digit a phone number, and any
+// other value with an @ an email; anything else is taken as a handle.
+func (s *UsersService) ResolveAlias(ctx context.Context, value string) (*messages.Recipient, error) {
+	aliasType, value, err := parseAlias(value)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.client.Alias.Query().Where(alias.TypeEQ(aliasType), alias.Value(value)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrAliasNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.GetUser(ctx, a.UserID)
+	if errors.Is(err, ErrUserNotFound) {
+		return nil, ErrAliasNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	name := u.LegalName
+	if name == "" {
+		name = u.Name
+	}
+	return &messages.Recipient{
+		UserID:     u.ID,
+		AliasType:  string(a.Type),
+		Alias:      DisplayAlias(a),
+		MaskedName: maskName(name),
+	}, nil
+}
+
+// ServeAliases answers resolve-alias requests, so transactions-service can
+// accept transfers to aliases.
+func (s *UsersService) ServeAliases(natsConn *nats.Conn, queueGroup string) error {
+	_, err := natsConn.QueueSubscribe(messages.SubjectResolveAlias, queueGroup, func(m *nats.Msg) {
+		reply := s.handleResolveAlias(context.Background(), m.Data)
+		data, err := json.Marshal(reply)
+		if err != nil {
+			log.Printf("error marshalling %s reply: %v", m.Subject, err)
+			return
+		}
+		if err := m.Respond(data); err != nil {
+			log.Printf("error responding to %s: %v", m.Subject, err)
+		}
+	})
+	return err
+}
+
+func (s *UsersService) handleResolveAlias(ctx context.Context, data []byte) messages.AliasResolution {
+	var req messages.ResolveAlias
+	if err := json.Unmarshal(data, &req); err != nil {
+		return messages.AliasResolution{Reply: messages.Error(messages.ErrorCodeInvalidRequest, "error unmarshalling resolve-alias message: "+err.Error())}
+	}
+
+	recipient, err := s.ResolveAlias(ctx, req.Alias)
+	switch {
+	case errors.Is(err, ErrAliasNotFound):
+		return messages.AliasResolution{Reply: messages.Error(messages.ErrorCodeAliasNotFound, err.Error())}
+	case errors.Is(err, ErrInvalidAlias):
+		return messages.AliasResolution{Reply: messages.Error(messages.ErrorCodeInvalidRequest, err.Error())}
+	case err != nil:
+		log.Printf("error resolving alias: %v", err)
+		return messages.AliasResolution{Reply: messages.Error(messages.ErrorCodeInternal, "error resolving alias")}
+	}
+	return messages.AliasResolution{Reply: messages.Success(""), Recipient: recipient}
+}
+
+// dropStaleAliases removes the email and phone aliases of a user whose email
+// or phone changed from previous to current profile values, as the new ones
+// are not verified or registered yet.
+func dropStaleAliases(ctx context.Context, tx *ent.Tx, userID int, previous, current map[string]string) error {
+	var stale []alias.Type
+	if previous[user.FieldEmail] != current[user.FieldEmail] {
+		stale = append(stale, alias.TypeEmail)
+	}
+	if previous[user.FieldPhone] != current[user.FieldPhone] {
+		stale = append(stale, alias.TypePhone)
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	_, err := tx.Alias.Delete().Where(alias.UserID(userID), alias.TypeIn(stale...)).Exec(ctx)
+	return err
+}
+
+// parseAlias tells the type of an alias entered by a payer and normalizes it.
+func parseAlias(value string) (alias.Type, string, error) {
+	value = strings.TrimSpace(value)
+	switch {
+	case value == "":
+		return "", "", ErrInvalidAlias
+	case strings.HasPrefix(value, "@"):
+		handle, err := normalizeHandle(value)
+		if err != nil {
+			return "", "", ErrInvalidAlias
+		}
+		return alias.TypeHandle, handle, nil
+	case strings.HasPrefix(value, "+") || (value[0] >= '0' && value[0] <= '9'):
+		phone, err := profiles.Phone(value)
+		if err != nil {
+			return "", "", ErrInvalidAlias
+		}
+		return alias.TypePhone, phone, nil
+	case strings.Contains(value, "@"):
+		email, err := normalizeEmail(value)
+		if err != nil {
+			return "", "", ErrInvalidAlias
+		}
+		return alias.TypeEmail, strings.ToLower(email), nil
+	default:
+		handle, err := normalizeHandle(value)
+		if err != nil {
+			return "", "", ErrInvalidAlias
+		}
+		return alias.TypeHandle, handle, nil
+	}
+}
+
+// normalizeHandle lower-cases a handle and drops its leading @.
+func normalizeHandle(handle string) (string, error) {
+	handle = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), "@"))
+	if !handlePattern.MatchString(handle) {
+		return "", ErrInvalidHandle
+	}
+	return handle, nil
+}
+
+// DisplayAlias is an alias as payers enter it: handles start with an @.
+func DisplayAlias(a *ent.Alias) string {
+	if a.Type == alias.TypeHandle {
+		return "@" + a.Value
+	}
+	return a.Value
+}
+
+// maskName keeps the first letter of each word of name and masks the rest,
+// e.g. Jane Doe becomes J*** D**.
+func maskName(name string) string {
+	words := strings.Fields(name)
+	for i, word := range words {
+		first, size := utf8.DecodeRuneInString(word)
+		words[i] = string(first) + strings.Repeat("*", utf8.RuneCountInString(word[size:]))
+	}
+	return strings.Join(words, " ")
+}
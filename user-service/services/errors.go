@@ -0,0 +1,78 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"user-service/common/problems"
+	"user-service/ent"
+	"user-service/profiles"
+)
+
+// problemCodes maps domain errors to the problem codes they are reported with.
+var problemCodes = []struct {
+	err  error
+	code problems.Code
+}{
+	{ErrUserNotFound, problems.CodeUserNotFound},
+	{ErrUserAlreadyExists, problems.CodeUserAlreadyExists},
+	{ErrInvalidConsistency, problems.CodeInvalidRequest},
+	{ErrUnknownRole, problems.CodeValidationFailed},
+	{ErrUnknownReferralStatus, problems.CodeValidationFailed},
+	{ErrEmailTaken, problems.CodeEmailTaken},
+	{ErrInvalidEmail, problems.CodeValidationFailed},
+	{profiles.ErrInvalidLegalName, problems.CodeValidationFailed},
+	{profiles.ErrInvalidDateOfBirth, problems.CodeValidationFailed},
+	{profiles.ErrInvalidPhone, problems.CodeValidationFailed},
+	{profiles.ErrInvalidAddress, problems.CodeValidationFailed},
+	{profiles.ErrInvalidLocale, problems.CodeValidationFailed},
+	{profiles.ErrInvalidTimezone, problems.CodeValidationFailed},
+	{ErrEmailAlreadyVerified, problems.CodeEmailAlreadyVerified},
+	{ErrVerificationThrottled, problems.CodeRateLimited},
+	{ErrInvalidVerification, problems.CodeInvalidVerification},
+	{ErrUserErased, problems.CodeUserErased},
+	{ErrDataRequestNotFound, problems.CodeDataRequestNotFound},
+	{ErrExportNotReady, problems.CodeExportNotReady},
+	{ErrExportExpired, problems.CodeExportExpired},
+	{ErrAliasNotFound, problems.CodeAliasNotFound},
+	{ErrAliasTaken, problems.CodeAliasTaken},
+	{ErrInvalidAlias, problems.CodeValidationFailed},
+	{ErrInvalidHandle, problems.CodeValidationFailed},
+	{ErrUnknownAliasType, problems.CodeValidationFailed},
+	{ErrEmailNotVerified, problems.CodeEmailNotVerified},
+	{ErrPhoneNotInProfile, problems.CodeValidationFailed},
+	{ErrPhoneNotVerified, problems.CodePhoneNotVerified},
+	{ErrNoPhone, problems.CodeValidationFailed},
+	{ErrPhoneAlreadyVerified, problems.CodePhoneAlreadyVerified},
+	{ErrPhoneCodeThrottled, problems.CodeRateLimited},
+	{ErrInvalidPhoneCode, problems.CodeInvalidOTP},
+	{ErrEmailNotOwned, problems.CodeValidationFailed},
+	{ErrInvalidReferralCode, problems.CodeInvalidReferralCode},
+}
+
+// ProblemFor converts an error returned by the business logic into a problem,
+// which every API reports in its own way. Errors reported by transactions-service
+// keep their code; other errors without a known code are logged and reported as
+// internal errors without their details.
+func ProblemFor(err error) *problems.Problem {
+	if p, ok := problems.As(err); ok {
+		return p
+	}
+	var remote *RemoteError
+	if errors.As(err, &remote) {
+		return problems.New(problems.Code(remote.Code), remote.Message)
+	}
+	for _, pc := range problemCodes {
+		if errors.Is(err, pc.err) {
+			return problems.New(pc.code, err.Error())
+		}
+	}
+	if ent.IsConstraintError(err) {
+		return problems.New(problems.CodeUserAlreadyExists, "")
+	}
+	if ent.IsValidationError(err) {
+		return problems.New(problems.CodeValidationFailed, err.Error())
+	}
+
+	log.Printf("internal error: %v", err)
+	return problems.New(problems.CodeInternal, "")
+}
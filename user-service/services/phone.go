@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strconv"
+	"time"
+	"user-service/ent"
+	"user-service/ent/user"
+	"user-service/sms"
+)
+
+// maxPhoneCodeAttempts is how many codes may be entered for a texted code
+// before another one has to be sent.
+const maxPhoneCodeAttempts = 5
+
+var (
+	ErrNoPhone              = errors.New("the profile has no phone number to verify")
+	ErrPhoneAlreadyVerified = errors.New("phone is already verified")
+	ErrPhoneCodeThrottled   = errors.New("a verification code was sent recently")
+	ErrInvalidPhoneCode     = errors.New("verification code is invalid or expired")
+)
+
+// SendPhoneVerification texts the phone of a user's profile a code that
+// verifies it, at most once every phone resend interval. A new code replaces
+// the previous one.
+func (s *UsersService) SendPhoneVerification(ctx context.Context, id int) error {
+	u, err := s.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if u.ErasedAt != nil {
+		return ErrUserErased
+	}
+	if u.Phone == "" {
+		return ErrNoPhone
+	}
+	if u.PhoneVerifiedAt != nil {
+		return ErrPhoneAlreadyVerified
+	}
+
+	code, err := newPhoneCode()
+	if err != nil {
+		return err
+	}
+
+	// Claim the send in the same statement that checks the interval, so
+	// concurrent requests text a single code.
+	now := time.Now()
+	n, err := s.client.User.Update().
+		Where(
+			user.IDEQ(id),
+			user.PhoneEQ(u.Phone),
+			user.PhoneVerifiedAtIsNil(),
+			user.Or(user.PhoneCodeSentAtIsNil(), user.PhoneCodeSentAtLTE(now.Add(-s.verification.PhoneResendInterval))),
+		).
+		SetPhoneCodeHash(phoneCodeHash(id, u.Phone, code)).
+		SetPhoneCodeSentAt(now).
+		SetPhoneCodeAttempts(0).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		u, err = s.GetUser(ctx, id)
+		if err != nil {
+			return err
+		}
+		if u.PhoneVerifiedAt != nil {
+			return ErrPhoneAlreadyVerified
+		}
+		if u.PhoneCodeSentAt == nil {
+			// The phone changed meanwhile; the user asks again for the new one.
+			return ErrPhoneCodeThrottled
+		}
+		wait := time.Until(u.PhoneCodeSentAt.Add(s.verification.PhoneResendInterval)).Round(time.Second)
+		return fmt.Errorf("%w, retry in %s", ErrPhoneCodeThrottled, max(wait, time.Second))
+	}
+
+	err = s.texter.Send(ctx, sms.Message{
+		To:   u.Phone,
+		Body: fmt.Sprintf("Your Digital Wallet verification code is %s. It expires in %s.", code, s.verification.PhoneCodeTTL),
+	})
+	if err != nil {
+		// Drop the code that was not sent, so the user can retry right away.
+		release := s.client.User.Update().
+			Where(user.IDEQ(id), user.PhoneCodeSentAtEQ(now)).
+			ClearPhoneCodeHash().
+			ClearPhoneCodeSentAt()
+		if releaseErr := release.Exec(ctx); releaseErr != nil {
+			log.Printf("error releasing phone verification of user %d: %v", id, releaseErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// VerifyPhone marks the phone of a user as verified with the code texted to
+// it. Every code entered uses up one of maxPhoneCodeAttempts attempts, after
+// which, or once the code expired, another code has to be sent. Verifying a
+// verified phone again changes nothing.
+func (s *UsersService) VerifyPhone(ctx context.Context, id int, code string) (*ent.User, error) {
+	u, err := s.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u.ErasedAt != nil {
+		return nil, ErrUserErased
+	}
+	if u.PhoneVerifiedAt != nil {
+		return u, nil
+	}
+	if u.PhoneCodeHash == "" || u.PhoneCodeSentAt == nil || time.Since(*u.PhoneCodeSentAt) > s.verification.PhoneCodeTTL {
+		return nil, ErrInvalidPhoneCode
+	}
+
+	// Use up an attempt before comparing, so that concurrent guesses cannot
+	// get past the limit.
+	n, err := s.client.User.Update().
+		Where(user.IDEQ(id), user.PhoneCodeHashEQ(u.PhoneCodeHash), user.PhoneCodeAttemptsLT(maxPhoneCodeAttempts)).
+		AddPhoneCodeAttempts(1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrInvalidPhoneCode
+	}
+	if subtle.ConstantTimeCompare([]byte(phoneCodeHash(id, u.Phone, code)), []byte(u.PhoneCodeHash)) != 1 {
+		return nil, ErrInvalidPhoneCode
+	}
+
+	// The code only verifies the phone it was sent to.
+	n, err = s.client.User.Update().
+		Where(user.IDEQ(id), user.PhoneEQ(u.Phone), user.PhoneCodeHashEQ(u.PhoneCodeHash)).
+		SetPhoneVerifiedAt(time.Now()).
+		ClearPhoneCodeHash().
+		ClearPhoneCodeSentAt().
+		SetPhoneCodeAttempts(0).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrInvalidPhoneCode
+	}
+	return s.GetUser(ctx, id)
+}
+
+// clearPhoneVerification drops the verification of a phone that changed,
+// together with any code sent to it.
+func clearPhoneVerification(mutation *ent.UserUpdateOne) *ent.UserUpdateOne {
+	return mutation.
+		ClearPhoneVerifiedAt().
+		ClearPhoneCodeHash().
+		ClearPhoneCodeSentAt().
+		SetPhoneCodeAttempts(0)
+}
+
+// newPhoneCode returns a random six-digit code.
+func newPhoneCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// phoneCodeHash returns the hash a code texted to phone is stored as. The
+// user and phone are part of it, so a code only matches where it was sent.
+func phoneCodeHash(userID int, phone, code string) string {
+	sum := sha256.Sum256([]byte(strconv.Itoa(userID) + ":" + phone + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
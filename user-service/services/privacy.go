@@ -0,0 +1,779 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"shared/auth"
+	"shared/messages"
+	"strconv"
+	"strings"
+	"time"
+	"user-service/audit"
+	"user-service/ent"
+	"user-service/ent/alias"
+	"user-service/ent/apikey"
+	"user-service/ent/balanceprojection"
+	"user-service/ent/credential"
+	"user-service/ent/datarequest"
+	"user-service/ent/predicate"
+	"user-service/ent/profilechange"
+	"user-service/ent/recoverycode"
+	"user-service/ent/referral"
+	"user-service/ent/referralcode"
+	"user-service/ent/session"
+	"user-service/profiles"
+	"user-service/tokens"
+
+	"github.com/google/uuid"
+)
+
+const (
+	dataRequestPollInterval = 10 * time.Second
+	// dataRequestStaleAfter is how long a request may run before it is taken
+	// to have been abandoned by a replica that stopped.
+	dataRequestStaleAfter = 10 * time.Minute
+	// maxDataRequestAttempts is how often a request is tried while
+	// transactions-service cannot be reached.
+	maxDataRequestAttempts = 5
+
+	exportPageSize            = 500
+	exportRequestTimeout      = 10 * time.Second
+	eraseUserRequestTimeout   = 10 * time.Second
+	erasedEmailDomain         = "erased.invalid"
+	dataExportArchiveFileName = "data-export.zip"
+)
+
+var (
+	ErrUserErased          = errors.New("user was erased")
+	ErrDataRequestNotFound = errors.New("data request not found")
+	ErrExportNotReady      = errors.New("data export is not ready yet")
+	ErrExportExpired       = errors.New("data export expired, request a new one")
+)
+
+// PrivacyConfig controls data exports.
+type PrivacyConfig struct {
+	// ExportTTL is how long the archive of an export can be downloaded.
+	ExportTTL time.Duration
+}
+
+var defaultPrivacyConfig = PrivacyConfig{
+	ExportTTL: 7 * 24 * time.Hour,
+}
+
+// LoadPrivacyConfig reads DATA_EXPORT_TTL.
+func LoadPrivacyConfig() (PrivacyConfig, error) {
+	config := defaultPrivacyConfig
+	if v := os.Getenv("DATA_EXPORT_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return PrivacyConfig{}, fmt.Errorf("DATA_EXPORT_TTL must be a positive duration")
+		}
+		config.ExportTTL = d
+	}
+	return config, nil
+}
+
+// ArchiveFileName is the file name data export archives are downloaded as.
+func ArchiveFileName(r *ent.DataRequest) string {
+	return "user-" + strconv.Itoa(r.UserID) + "-" + dataExportArchiveFileName
+}
+
+// RequestExport starts collecting the data of a user, from user-service and
+// transactions-service, into a zip archive of JSON and CSV files. ctx carries
+// the caller, who is recorded with the request. A pending export is returned
+// instead of starting another one.
+func (s *UsersService) RequestExport(ctx context.Context, userID int) (*ent.DataRequest, error) {
+	return s.createDataRequest(ctx, userID, datarequest.KindExport, audit.ActionUserDataExport)
+}
+
+// RequestErasure starts erasing a user: their personal data is pseudonymized
+// in both services, while the ledger of their wallet is kept. The wallet must
+// be empty. ctx carries the caller, who is recorded with the request. A
+// pending erasure is returned instead of starting another one.
+func (s *UsersService) RequestErasure(ctx context.Context, userID int) (*ent.DataRequest, error) {
+	return s.createDataRequest(ctx, userID, datarequest.KindErasure, audit.ActionUserErase)
+}
+
+// GetDataRequest returns a data request of a user.
+func (s *UsersService) GetDataRequest(ctx context.Context, userID int, id uuid.UUID) (*ent.DataRequest, error) {
+	r, err := s.client.DataRequest.Query().
+		Where(datarequest.IDEQ(id), datarequest.UserID(userID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrDataRequestNotFound
+	}
+	return r, err
+}
+
+// ExportArchive returns a completed export of a user, with its archive.
+func (s *UsersService) ExportArchive(ctx context.Context, userID int, id uuid.UUID) (*ent.DataRequest, error) {
+	r, err := s.GetDataRequest(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.Kind != datarequest.KindExport {
+		return nil, ErrDataRequestNotFound
+	}
+	if r.Status != datarequest.StatusCompleted {
+		return nil, fmt.Errorf("%w, it is %s", ErrExportNotReady, r.Status)
+	}
+	if !HasArchive(r) || (r.ExpiresAt != nil && time.Now().After(*r.ExpiresAt)) {
+		return nil, ErrExportExpired
+	}
+	return r, nil
+}
+
+// HasArchive reports whether r still holds its export archive. ent scans
+// a NULL archive into a non-nil pointer, so the length is what counts.
+func HasArchive(r *ent.DataRequest) bool {
+	return r.Archive != nil && len(*r.Archive) > 0
+}
+
+// RunDataRequests works off pending data requests and drops expired export
+// archives until ctx is done. Every replica may run it; requests are claimed
+// before they run.
+func (s *UsersService) RunDataRequests(ctx context.Context) {
+	ticker := time.NewTicker(dataRequestPollInterval)
+	defer ticker.Stop()
+	for {
+		s.workDataRequests(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *UsersService) workDataRequests(ctx context.Context) {
+	err := s.client.DataRequest.Update().
+		Where(datarequest.ArchiveNotNil(), datarequest.ExpiresAtLT(time.Now())).
+		ClearArchive().
+		Exec(ctx)
+	if err != nil {
+		log.Printf("error dropping expired data exports: %v", err)
+	}
+
+	ids, err := s.client.DataRequest.Query().
+		Where(runnable(time.Now())).
+		Order(ent.Asc(datarequest.FieldCreatedAt)).
+		IDs(ctx)
+	if err != nil {
+		log.Printf("error querying data requests: %v", err)
+		return
+	}
+	for _, id := range ids {
+		s.runDataRequest(ctx, id)
+	}
+}
+
+func (s *UsersService) createDataRequest(ctx context.Context, userID int, kind datarequest.Kind, action string) (*ent.DataRequest, error) {
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, audit.ErrNoActor
+	}
+	u, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.ErasedAt != nil {
+		return nil, ErrUserErased
+	}
+
+	pending, err := s.client.DataRequest.Query().
+		Where(
+			datarequest.UserID(userID),
+			datarequest.KindEQ(kind),
+			datarequest.StatusIn(datarequest.StatusPending, datarequest.StatusRunning),
+		).
+		First(ctx)
+	if err == nil {
+		return pending, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	r, err := tx.DataRequest.Create().
+		SetUserID(userID).
+		SetKind(kind).
+		SetRequestedBy(p.Subject).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if p.UserID != userID {
+		// Operators acting on a data-subject request leave a record.
+		err := audit.Record(ctx, tx.Client(), action, audit.TargetUser, strconv.Itoa(userID), map[string]any{
+			"request_id": r.ID.String(),
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("recording audit log: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	go s.runDataRequest(context.Background(), r.ID)
+	return r, nil
+}
+
+// runnable matches the requests waiting to run and those abandoned while running.
+func runnable(now time.Time) predicate.DataRequest {
+	return datarequest.Or(
+		datarequest.StatusEQ(datarequest.StatusPending),
+		datarequest.And(datarequest.StatusEQ(datarequest.StatusRunning), datarequest.StartedAtLT(now.Add(-dataRequestStaleAfter))),
+	)
+}
+
+// runDataRequest claims a request and runs it, unless another replica got it first.
+func (s *UsersService) runDataRequest(ctx context.Context, id uuid.UUID) {
+	now := time.Now()
+	n, err := s.client.DataRequest.Update().
+		Where(datarequest.IDEQ(id), runnable(now)).
+		SetStatus(datarequest.StatusRunning).
+		SetStartedAt(now).
+		AddAttempts(1).
+		Save(ctx)
+	if err != nil {
+		log.Printf("error claiming data request %s: %v", id, err)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	r, err := s.client.DataRequest.Get(ctx, id)
+	if err != nil {
+		log.Printf("error reading data request %s: %v", id, err)
+		return
+	}
+	switch r.Kind {
+	case datarequest.KindExport:
+		err = s.export(ctx, r)
+	case datarequest.KindErasure:
+		err = s.erase(ctx, r)
+	}
+	if err != nil {
+		s.failDataRequest(ctx, r, err)
+	}
+}
+
+// failDataRequest puts a request back to be retried when transactions-service
+// could not be reached, or marks it failed.
+func (s *UsersService) failDataRequest(ctx context.Context, r *ent.DataRequest, cause error) {
+	log.Printf("error running %s request %s of user %d: %v", r.Kind, r.ID, r.UserID, cause)
+
+	update := s.client.DataRequest.UpdateOne(r).SetError(cause.Error())
+	var remote *RemoteError
+	retry := errors.As(cause, &remote) && r.Attempts < maxDataRequestAttempts &&
+		(remote.Code == messages.ErrorCodeServiceTimeout ||
+			remote.Code == messages.ErrorCodeServiceUnavailable ||
+			remote.Code == messages.ErrorCodeServiceBusy)
+	if retry {
+		update = update.SetStatus(datarequest.StatusPending)
+	} else {
+		update = update.SetStatus(datarequest.StatusFailed).SetCompletedAt(time.Now())
+	}
+	if err := update.Exec(ctx); err != nil {
+		log.Printf("error recording failure of data request %s: %v", r.ID, err)
+	}
+}
+
+// Export archive contents. Times are RFC 3339 in the CSV files.
+type (
+	exportedProfile struct {
+		ID                int                   `json:"id"`
+		Email             string                `json:"email"`
+		Name              string                `json:"name,omitempty"`
+		LegalName         string                `json:"legal_name,omitempty"`
+		DateOfBirth       string                `json:"date_of_birth,omitempty"`
+		Phone             string                `json:"phone,omitempty"`
+		PhoneVerifiedAt   *time.Time            `json:"phone_verified_at,omitempty"`
+		Address           *profiles.Address     `json:"address,omitempty"`
+		Locale            string                `json:"locale,omitempty"`
+		Timezone          string                `json:"timezone,omitempty"`
+		CreatedAt         time.Time             `json:"created_at"`
+		UpdatedAt         time.Time             `json:"updated_at"`
+		VerifiedAt        *time.Time            `json:"verified_at,omitempty"`
+		Roles             []string              `json:"roles,omitempty"`
+		PasswordChangedAt *time.Time            `json:"password_changed_at,omitempty"`
+		TwoFactorEnabled  bool                  `json:"two_factor_enabled"`
+		APIKeys           []exportedAPIKey      `json:"api_keys"`
+		Sessions          []exportedSession     `json:"sessions"`
+		DataRequests      []exportedDataRequest `json:"data_requests"`
+		ProfileChanges    []exportedChange      `json:"profile_changes"`
+		Aliases           []exportedAlias       `json:"aliases"`
+		ReferralCode      string                `json:"referral_code,omitempty"`
+		Referrals         []exportedReferral    `json:"referrals"`
+	}
+	exportedReferral struct {
+		// Role is referrer or referred: the part the user had in the referral.
+		Role        string     `json:"role"`
+		Status      string     `json:"status"`
+		Bonus       float64    `json:"bonus"`
+		CreatedAt   time.Time  `json:"created_at"`
+		QualifiedAt *time.Time `json:"qualified_at,omitempty"`
+		PaidAt      *time.Time `json:"paid_at,omitempty"`
+	}
+	exportedAlias struct {
+		Type      string    `json:"type"`
+		Value     string    `json:"value"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+	exportedChange struct {
+		Field     string    `json:"field"`
+		OldValue  string    `json:"old_value,omitempty"`
+		NewValue  string    `json:"new_value,omitempty"`
+		ChangedBy string    `json:"changed_by"`
+		ChangedAt time.Time `json:"changed_at"`
+	}
+	exportedAPIKey struct {
+		Name       string     `json:"name"`
+		Prefix     string     `json:"prefix"`
+		Scopes     []string   `json:"scopes"`
+		CreatedAt  time.Time  `json:"created_at"`
+		ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+		LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+		RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	}
+	exportedSession struct {
+		Device     string     `json:"device"`
+		UserAgent  string     `json:"user_agent"`
+		IP         string     `json:"ip"`
+		CreatedAt  time.Time  `json:"created_at"`
+		LastSeenAt time.Time  `json:"last_seen_at"`
+		ExpiresAt  time.Time  `json:"expires_at"`
+		RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	}
+	exportedDataRequest struct {
+		Kind        string     `json:"kind"`
+		Status      string     `json:"status"`
+		CreatedAt   time.Time  `json:"created_at"`
+		CompletedAt *time.Time `json:"completed_at,omitempty"`
+	}
+	exportedWallet struct {
+		Wallet       *messages.WalletData          `json:"wallet"`
+		Payees       []messages.PayeeData          `json:"payees"`
+		Transactions []messages.TransactionCreated `json:"transactions"`
+	}
+)
+
+// export collects the data of a user into the archive of r.
+func (s *UsersService) export(ctx context.Context, r *ent.DataRequest) error {
+	u, err := s.GetUser(ctx, r.UserID)
+	if err != nil {
+		return err
+	}
+	if u.ErasedAt != nil {
+		return ErrUserErased
+	}
+
+	profile, err := s.exportProfile(ctx, u)
+	if err != nil {
+		return err
+	}
+	wallet, err := s.exportWallet(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+	archive, err := exportArchive(profile, wallet)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	return s.client.DataRequest.UpdateOne(r).
+		SetStatus(datarequest.StatusCompleted).
+		SetArchive(archive).
+		SetCompletedAt(now).
+		SetExpiresAt(now.Add(s.privacy.ExportTTL)).
+		ClearError().
+		Exec(ctx)
+}
+
+func (s *UsersService) exportProfile(ctx context.Context, u *ent.User) (*exportedProfile, error) {
+	profile := &exportedProfile{
+		ID:              u.ID,
+		Email:           u.Email,
+		Name:            u.Name,
+		LegalName:       u.LegalName,
+		Phone:           u.Phone,
+		Address:         u.Address,
+		PhoneVerifiedAt: u.PhoneVerifiedAt,
+		Locale:          u.Locale,
+		Timezone:        u.Timezone,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+		VerifiedAt:      u.VerifiedAt,
+		Roles:           u.Roles,
+	}
+	if u.DateOfBirth != nil {
+		profile.DateOfBirth = u.DateOfBirth.Format(profiles.DateLayout)
+	}
+
+	c, err := s.client.Credential.Query().Where(credential.UserID(u.ID)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if c != nil {
+		profile.PasswordChangedAt = c.PasswordChangedAt
+		profile.TwoFactorEnabled = c.TotpConfirmedAt != nil
+	}
+
+	keys, err := s.client.APIKey.Query().Where(apikey.UserID(u.ID)).Order(ent.Asc(apikey.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile.APIKeys = make([]exportedAPIKey, len(keys))
+	for i, k := range keys {
+		profile.APIKeys[i] = exportedAPIKey{
+			Name:       k.Name,
+			Prefix:     k.Prefix,
+			Scopes:     k.Scopes,
+			CreatedAt:  k.CreatedAt,
+			ExpiresAt:  k.ExpiresAt,
+			LastUsedAt: k.LastUsedAt,
+			RevokedAt:  k.RevokedAt,
+		}
+	}
+
+	sessions, err := s.client.Session.Query().Where(session.UserID(u.ID)).Order(ent.Asc(session.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile.Sessions = make([]exportedSession, len(sessions))
+	for i, t := range sessions {
+		profile.Sessions[i] = exportedSession{
+			Device:     t.Device,
+			UserAgent:  t.UserAgent,
+			IP:         t.IP,
+			CreatedAt:  t.CreatedAt,
+			LastSeenAt: t.LastSeenAt,
+			ExpiresAt:  t.ExpiresAt,
+			RevokedAt:  t.RevokedAt,
+		}
+	}
+
+	requests, err := s.client.DataRequest.Query().Where(datarequest.UserID(u.ID)).Order(ent.Asc(datarequest.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile.DataRequests = make([]exportedDataRequest, len(requests))
+	for i, r := range requests {
+		profile.DataRequests[i] = exportedDataRequest{Kind: string(r.Kind), Status: string(r.Status), CreatedAt: r.CreatedAt, CompletedAt: r.CompletedAt}
+	}
+
+	changes, err := s.client.ProfileChange.Query().Where(profilechange.UserID(u.ID)).Order(ent.Asc(profilechange.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile.ProfileChanges = make([]exportedChange, len(changes))
+	for i, c := range changes {
+		profile.ProfileChanges[i] = exportedChange{Field: c.Field, OldValue: c.OldValue, NewValue: c.NewValue, ChangedBy: c.ChangedBy, ChangedAt: c.ChangedAt}
+	}
+
+	aliases, err := s.client.Alias.Query().Where(alias.UserID(u.ID)).Order(ent.Asc(alias.FieldType)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile.Aliases = make([]exportedAlias, len(aliases))
+	for i, a := range aliases {
+		profile.Aliases[i] = exportedAlias{Type: string(a.Type), Value: a.Value, CreatedAt: a.CreatedAt}
+	}
+
+	code, err := s.client.ReferralCode.Query().Where(referralcode.UserID(u.ID)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if code != nil {
+		profile.ReferralCode = code.Code
+	}
+	referrals, err := s.client.Referral.Query().
+		Where(referral.Or(referral.ReferrerID(u.ID), referral.ReferredID(u.ID))).
+		Order(ent.Asc(referral.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile.Referrals = make([]exportedReferral, len(referrals))
+	for i, r := range referrals {
+		profile.Referrals[i] = exportedReferral{Role: "referrer", Status: string(r.Status), Bonus: r.ReferrerBonus, CreatedAt: r.CreatedAt, QualifiedAt: r.QualifiedAt, PaidAt: r.PaidAt}
+		if r.ReferredID == u.ID {
+			profile.Referrals[i].Role = "referred"
+			profile.Referrals[i].Bonus = r.ReferredBonus
+		}
+	}
+	return profile, nil
+}
+
+// exportWallet asks transactions-service for the wallet of a user and its
+// transactions, a page at a time.
+func (s *UsersService) exportWallet(ctx context.Context, userID int) (*exportedWallet, error) {
+	wallet := &exportedWallet{Payees: []messages.PayeeData{}, Transactions: []messages.TransactionCreated{}}
+	request := messages.ExportUserData{UserID: userID, Limit: exportPageSize}
+	for {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return nil, err
+		}
+		var page messages.UserDataExport
+		reply := s.requestInto(ctx, messages.SubjectExportUserData, data, exportRequestTimeout, &page)
+		if !reply.IsSuccess() {
+			return nil, &RemoteError{Code: reply.ErrorCode, Message: reply.Message}
+		}
+
+		wallet.Wallet = page.Wallet
+		if request.AfterID == 0 && page.Payees != nil {
+			wallet.Payees = page.Payees
+		}
+		wallet.Transactions = append(wallet.Transactions, page.Transactions...)
+		if len(page.Transactions) < exportPageSize {
+			return wallet, nil
+		}
+		request.AfterID = page.Transactions[len(page.Transactions)-1].ID
+	}
+}
+
+// exportArchive zips the exported data: everything as JSON, and the tables
+// as CSV as well.
+func exportArchive(profile *exportedProfile, wallet *exportedWallet) ([]byte, error) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	files := []struct {
+		name  string
+		write func(f *bytes.Buffer) error
+	}{
+		{"profile.json", jsonFile(profile)},
+		{"wallet.json", jsonFile(wallet)},
+		{"transactions.csv", csvFile(
+			[]string{"id", "type", "amount", "currency", "request_id", "created_at"},
+			len(wallet.Transactions),
+			func(i int) []string {
+				t := wallet.Transactions[i]
+				return []string{strconv.Itoa(t.ID), t.Type, strconv.FormatFloat(t.Amount, 'f', -1, 64), t.Currency, t.RequestID, csvTime(&t.CreatedAt)}
+			},
+		)},
+		{"api_keys.csv", csvFile(
+			[]string{"name", "prefix", "scopes", "created_at", "expires_at", "last_used_at", "revoked_at"},
+			len(profile.APIKeys),
+			func(i int) []string {
+				k := profile.APIKeys[i]
+				return []string{k.Name, k.Prefix, strings.Join(k.Scopes, " "), csvTime(&k.CreatedAt), csvTime(k.ExpiresAt), csvTime(k.LastUsedAt), csvTime(k.RevokedAt)}
+			},
+		)},
+		{"sessions.csv", csvFile(
+			[]string{"device", "user_agent", "ip", "created_at", "last_seen_at", "expires_at", "revoked_at"},
+			len(profile.Sessions),
+			func(i int) []string {
+				t := profile.Sessions[i]
+				return []string{t.Device, t.UserAgent, t.IP, csvTime(&t.CreatedAt), csvTime(&t.LastSeenAt), csvTime(&t.ExpiresAt), csvTime(t.RevokedAt)}
+			},
+		)},
+	}
+	for _, file := range files {
+		var content bytes.Buffer
+		if err := file.write(&content); err != nil {
+			return nil, fmt.Errorf("writing %s: %w", file.name, err)
+		}
+		f, err := w.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Write(content.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func jsonFile(v any) func(*bytes.Buffer) error {
+	return func(f *bytes.Buffer) error {
+		e := json.NewEncoder(f)
+		e.SetIndent("", "  ")
+		return e.Encode(v)
+	}
+}
+
+func csvFile(header []string, rows int, row func(int) []string) func(*bytes.Buffer) error {
+	return func(f *bytes.Buffer) error {
+		w := csv.NewWriter(f)
+		w.Write(header)
+		for i := 0; i < rows; i++ {
+			w.Write(row(i))
+		}
+		w.Flush()
+		return w.Error()
+	}
+}
+
+func csvTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// erase pseudonymizes a user, first in transactions-service, which refuses
+// wallets that still hold money, then here. Both steps can be repeated, so a
+// failed erasure is retried as a whole.
+func (s *UsersService) erase(ctx context.Context, r *ent.DataRequest) error {
+	u, err := s.GetUser(ctx, r.UserID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if u.ErasedAt != nil {
+		return s.client.DataRequest.UpdateOne(r).SetStatus(datarequest.StatusCompleted).SetCompletedAt(now).Exec(ctx)
+	}
+
+	pseudonym := ErasedEmail(u.ID)
+	data, err := json.Marshal(messages.EraseUser{UserID: u.ID, Email: pseudonym, ErasedAt: now})
+	if err != nil {
+		return err
+	}
+	if reply := s.request(ctx, messages.SubjectEraseUser, data, eraseUserRequestTimeout); !reply.IsSuccess() {
+		return &RemoteError{Code: reply.ErrorCode, Message: reply.Message}
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	revoked, err := eraseUser(ctx, tx, u.ID, pseudonym, now)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = tx.DataRequest.UpdateOne(r).
+		SetStatus(datarequest.StatusCompleted).
+		SetCompletedAt(now).
+		ClearError().
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	s.issuer.DenySessions(revoked...)
+	return nil
+}
+
+// eraseUser pseudonymizes the user with the given ID and removes their
+// profile and its history, payment aliases, referral code, credentials,
+// sessions, API keys and export archives. Their pending referrals expire.
+// The audit log is kept as the record of what operators did. It returns the
+// revoked sessions, to deny once tx commits; they are kept without their
+// device, user agent and IP until then.
+func eraseUser(ctx context.Context, tx *ent.Tx, id int, pseudonym string, now time.Time) ([]string, error) {
+	err := tx.User.UpdateOneID(id).
+		SetEmail(pseudonym).
+		SetName("").
+		SetLegalName("").
+		ClearDateOfBirth().
+		SetPhone("").
+		ClearPhoneVerifiedAt().
+		ClearPhoneCodeHash().
+		ClearPhoneCodeSentAt().
+		SetPhoneCodeAttempts(0).
+		ClearAddress().
+		SetLocale("").
+		SetTimezone("").
+		ClearVerifiedAt().
+		ClearVerificationSentAt().
+		ClearRoles().
+		SetErasedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Credential.Delete().Where(credential.UserID(id)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := tx.RecoveryCode.Delete().Where(recoverycode.UserID(id)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ProfileChange.Delete().Where(profilechange.UserID(id)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Alias.Delete().Where(alias.UserID(id)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ReferralCode.Delete().Where(referralcode.UserID(id)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	err = tx.Referral.Update().
+		Where(
+			referral.StatusEQ(referral.StatusPending),
+			referral.Or(referral.ReferrerID(id), referral.ReferredID(id)),
+		).
+		SetStatus(referral.StatusExpired).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := tokens.RevokeUserSessions(ctx, tx, id, "", now)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.Session.Update().
+		Where(session.UserID(id)).
+		SetDevice("erased").
+		SetUserAgent("").
+		SetIP("").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.APIKey.Update().
+		Where(apikey.UserID(id), apikey.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.APIKey.Update().Where(apikey.UserID(id)).SetName("erased").Save(ctx); err != nil {
+		return nil, err
+	}
+	err = tx.BalanceProjection.Update().
+		Where(balanceprojection.IDEQ(id)).
+		SetEmail(pseudonym).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.DataRequest.Update().
+		Where(datarequest.UserID(id), datarequest.ArchiveNotNil()).
+		ClearArchive().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return revoked, nil
+}
+
+// ErasedEmail is the pseudonym replacing the email of an erased user. The
+// .invalid domain cannot receive mail.
+func ErasedEmail(id int) string {
+	return "erased-" + strconv.Itoa(id) + "@" + erasedEmailDomain
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"shared/auth"
+	"strings"
+	"time"
+	"user-service/ent"
+	"user-service/ent/profilechange"
+	"user-service/ent/user"
+	"user-service/profiles"
+)
+
+// systemActor is recorded as the author of profile changes made without an
+// authenticated caller.
+const systemActor = "system"
+
+// profileFields are the fields whose changes are recorded, in the order
+// they are recorded in.
+var profileFields = []string{
+	user.FieldEmail,
+	user.FieldName,
+	user.FieldLegalName,
+	user.FieldDateOfBirth,
+	user.FieldPhone,
+	user.FieldAddress,
+	user.FieldLocale,
+	user.FieldTimezone,
+}
+
+// normalizeProfile validates the profile fields of update and replaces them
+// with their normalized form. Empty values are kept, as they remove a field.
+func normalizeProfile(update *UserUpdate, now time.Time) error {
+	normalize := func(value *string, normalize func(string) (string, error)) error {
+		if value == nil || strings.TrimSpace(*value) == "" {
+			return nil
+		}
+		normalized, err := normalize(*value)
+		if err != nil {
+			return err
+		}
+		*value = normalized
+		return nil
+	}
+
+	if err := normalize(update.LegalName, profiles.LegalName); err != nil {
+		return err
+	}
+	if err := normalize(update.Phone, profiles.Phone); err != nil {
+		return err
+	}
+	if err := normalize(update.Locale, profiles.Locale); err != nil {
+		return err
+	}
+	if err := normalize(update.Timezone, profiles.Timezone); err != nil {
+		return err
+	}
+	err := normalize(update.DateOfBirth, func(date string) (string, error) {
+		dob, err := profiles.DateOfBirth(date, now)
+		return dob.Format(profiles.DateLayout), err
+	})
+	if err != nil {
+		return err
+	}
+	if update.Address != nil && !update.Address.IsZero() {
+		address, err := profiles.NormalizeAddress(*update.Address)
+		if err != nil {
+			return err
+		}
+		update.Address = &address
+	}
+	return nil
+}
+
+// setProfile adds the profile fields of update to mutation.
+func setProfile(mutation *ent.UserUpdateOne, update UserUpdate) *ent.UserUpdateOne {
+	if update.Name != nil {
+		mutation = mutation.SetName(strings.TrimSpace(*update.Name))
+	}
+	if update.LegalName != nil {
+		mutation = mutation.SetLegalName(strings.TrimSpace(*update.LegalName))
+	}
+	if update.DateOfBirth != nil {
+		if strings.TrimSpace(*update.DateOfBirth) == "" {
+			mutation = mutation.ClearDateOfBirth()
+		} else {
+			// Normalized dates always parse.
+			dob, _ := time.Parse(profiles.DateLayout, *update.DateOfBirth)
+			mutation = mutation.SetDateOfBirth(dob)
+		}
+	}
+	if update.Phone != nil {
+		mutation = mutation.SetPhone(strings.TrimSpace(*update.Phone))
+	}
+	if update.Address != nil {
+		if update.Address.IsZero() {
+			mutation = mutation.ClearAddress()
+		} else {
+			mutation = mutation.SetAddress(update.Address)
+		}
+	}
+	if update.Locale != nil {
+		mutation = mutation.SetLocale(strings.TrimSpace(*update.Locale))
+	}
+	if update.Timezone != nil {
+		mutation = mutation.SetTimezone(strings.TrimSpace(*update.Timezone))
+	}
+	return mutation
+}
+
+// profileValues returns the recorded fields of u as they appear in the
+// profile history.
+func profileValues(u *ent.User) map[string]string {
+	values := map[string]string{
+		user.FieldEmail:     u.Email,
+		user.FieldName:      u.Name,
+		user.FieldLegalName: u.LegalName,
+		user.FieldPhone:     u.Phone,
+		user.FieldLocale:    u.Locale,
+		user.FieldTimezone:  u.Timezone,
+	}
+	if u.DateOfBirth != nil {
+		values[user.FieldDateOfBirth] = u.DateOfBirth.Format(profiles.DateLayout)
+	}
+	if u.Address != nil {
+		address, _ := json.Marshal(u.Address)
+		values[user.FieldAddress] = string(address)
+	}
+	return values
+}
+
+// recordProfileChanges stores a ProfileChange for every field that differs
+// between previous and current, authored by the caller of ctx.
+func recordProfileChanges(ctx context.Context, tx *ent.Tx, previous, current map[string]string, userID int, now time.Time) error {
+	actor := systemActor
+	if p, ok := auth.FromContext(ctx); ok {
+		actor = p.Subject
+	}
+
+	var changes []*ent.ProfileChangeCreate
+	for _, field := range profileFields {
+		if previous[field] == current[field] {
+			continue
+		}
+		changes = append(changes, tx.ProfileChange.Create().
+			SetUserID(userID).
+			SetField(field).
+			SetOldValue(previous[field]).
+			SetNewValue(current[field]).
+			SetChangedBy(actor).
+			SetChangedAt(now))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return tx.ProfileChange.CreateBulk(changes...).Exec(ctx)
+}
+
+// ListProfileChanges returns the profile history of a user, newest first,
+// and the number of recorded changes.
+func (s *UsersService) ListProfileChanges(ctx context.Context, userID int, limit, offset int) ([]*ent.ProfileChange, int, error) {
+	if _, err := s.GetUser(ctx, userID); err != nil {
+		return nil, 0, err
+	}
+
+	query := s.client.ProfileChange.Query().Where(profilechange.UserID(userID))
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	changes, err := query.
+		Order(ent.Desc(profilechange.FieldChangedAt), ent.Desc(profilechange.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return changes, total, nil
+}
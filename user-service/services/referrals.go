@@ -0,0 +1,502 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"shared/messages"
+	"strconv"
+	"strings"
+	"time"
+	"user-service/ent"
+	"user-service/ent/predicate"
+	"user-service/ent/referral"
+	"user-service/ent/referralcode"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	referralPollInterval = time.Minute
+	// referralPayoutStaleAfter is how long the payout of a referral may run
+	// before it is taken to have been abandoned by a replica that stopped.
+	referralPayoutStaleAfter       = 5 * time.Minute
+	payReferralBonusRequestTimeout = 10 * time.Second
+	// A failed payout is retried after referralPayoutBackoff, doubled after
+	// every further failure up to maxReferralPayoutBackoff. After
+	// maxReferralPayoutAttempts the referral is left failed for operators.
+	referralPayoutBackoff     = time.Minute
+	maxReferralPayoutBackoff  = 6 * time.Hour
+	maxReferralPayoutAttempts = 10
+	// referralCheckInterval is how often the deposits of the referred user
+	// of a pending referral are looked up, in case an event was missed.
+	referralCheckInterval      = time.Hour
+	listDepositsRequestTimeout = 10 * time.Second
+	// maxReferralChecks bounds the referrals checked in one poll.
+	maxReferralChecks = 100
+
+	referralCodeLength = 8
+	// referralCodeAlphabet leaves out the letters and digits that are easily
+	// confused when a code is read out or typed: 0, 1, I and O.
+	referralCodeAlphabet  = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	maxReferralCodeTries  = 3
+	maxReferralCodeLength = 32
+)
+
+var ErrInvalidReferralCode = errors.New("referral code is unknown")
+
+// ReferralConfig controls when referrals qualify and the bonuses they earn.
+type ReferralConfig struct {
+	// MinTopUp is the smallest top-up with which a referred user qualifies
+	// their referral.
+	MinTopUp float64
+	// QualifyWithin is how long after signing up a referred user has to
+	// qualify their referral.
+	QualifyWithin time.Duration
+	// ReferrerBonus and ReferredBonus are paid to the referrer and the
+	// referred user of a qualified referral.
+	ReferrerBonus float64
+	ReferredBonus float64
+	// MaxPerReferrer is the number of referrals of a user that earn bonuses,
+	// or 0 for no cap.
+	MaxPerReferrer int
+}
+
+var defaultReferralConfig = ReferralConfig{
+	MinTopUp:       20,
+	QualifyWithin:  30 * 24 * time.Hour,
+	ReferrerBonus:  10,
+	ReferredBonus:  10,
+	MaxPerReferrer: 20,
+}
+
+// LoadReferralConfig reads REFERRAL_MIN_TOP_UP, REFERRAL_QUALIFY_WITHIN,
+// REFERRAL_REFERRER_BONUS, REFERRAL_REFERRED_BONUS and
+// REFERRAL_MAX_PER_REFERRER.
+func LoadReferralConfig() (ReferralConfig, error) {
+	config := defaultReferralConfig
+	amounts := []struct {
+		key   string
+		value *float64
+	}{
+		{"REFERRAL_MIN_TOP_UP", &config.MinTopUp},
+		{"REFERRAL_REFERRER_BONUS", &config.ReferrerBonus},
+		{"REFERRAL_REFERRED_BONUS", &config.ReferredBonus},
+	}
+	for _, a := range amounts {
+		if v := os.Getenv(a.key); v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil || f < 0 {
+				return ReferralConfig{}, fmt.Errorf("%s must be an amount of at least 0", a.key)
+			}
+			*a.value = f
+		}
+	}
+	if v := os.Getenv("REFERRAL_QUALIFY_WITHIN"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return ReferralConfig{}, fmt.Errorf("REFERRAL_QUALIFY_WITHIN must be a positive duration")
+		}
+		config.QualifyWithin = d
+	}
+	if v := os.Getenv("REFERRAL_MAX_PER_REFERRER"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return ReferralConfig{}, fmt.Errorf("REFERRAL_MAX_PER_REFERRER must be an integer of at least 0")
+		}
+		config.MaxPerReferrer = n
+	}
+	return config, nil
+}
+
+// ReferralCode returns the referral code of a user, issuing one the first
+// time it is asked for.
+func (s *UsersService) ReferralCode(ctx context.Context, userID int) (*ent.ReferralCode, error) {
+	u, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.ErasedAt != nil {
+		return nil, ErrUserErased
+	}
+
+	for try := 0; ; try++ {
+		c, err := s.client.ReferralCode.Query().Where(referralcode.UserID(userID)).Only(ctx)
+		if !ent.IsNotFound(err) {
+			return c, err
+		}
+
+		code, err := newReferralCode()
+		if err != nil {
+			return nil, err
+		}
+		c, err = s.client.ReferralCode.Create().
+			SetUserID(userID).
+			SetCode(code).
+			Save(ctx)
+		// A constraint error means another request issued the code of the
+		// user first, or, rarely, that the code was taken: look again.
+		if !ent.IsConstraintError(err) || try == maxReferralCodeTries-1 {
+			return c, err
+		}
+	}
+}
+
+// ListReferrals returns the referrals made by a user, the latest first.
+func (s *UsersService) ListReferrals(ctx context.Context, userID int) ([]*ent.Referral, error) {
+	if _, err := s.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.client.Referral.Query().
+		Where(referral.ReferrerID(userID)).
+		Order(ent.Desc(referral.FieldCreatedAt), ent.Desc(referral.FieldID)).
+		All(ctx)
+}
+
+// RemainingReferralBonuses returns how many more referrals of a user can
+// earn bonuses, or nil when there is no cap.
+func (s *UsersService) RemainingReferralBonuses(c *ent.ReferralCode) *int {
+	if s.referrals.MaxPerReferrer == 0 {
+		return nil
+	}
+	remaining := max(s.referrals.MaxPerReferrer-c.Rewarded, 0)
+	return &remaining
+}
+
+// referUser records within tx that the user with the given ID signed up
+// with a referral code.
+func referUser(ctx context.Context, tx *ent.Tx, referredID int, code string) error {
+	code = normalizeReferralCode(code)
+	if code == "" || len(code) > maxReferralCodeLength {
+		return ErrInvalidReferralCode
+	}
+	c, err := tx.ReferralCode.Query().Where(referralcode.Code(code)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return ErrInvalidReferralCode
+	}
+	if err != nil {
+		return err
+	}
+	if c.UserID == referredID {
+		return ErrInvalidReferralCode
+	}
+	return tx.Referral.Create().
+		SetReferrerID(c.UserID).
+		SetReferredID(referredID).
+		SetCode(c.Code).
+		Exec(ctx)
+}
+
+// QualifyReferrals subscribes to the transaction-created events of
+// transactions-service, so that referrals qualify when their referred user
+// tops up their wallet. Each event is handled by one replica.
+func (s *UsersService) QualifyReferrals(natsConn *nats.Conn, queueGroup string) error {
+	_, err := natsConn.QueueSubscribe(messages.EventTransactionCreated, queueGroup, func(m *nats.Msg) {
+		var event messages.Event
+		if err := json.Unmarshal(m.Data, &event); err != nil {
+			log.Printf("error unmarshalling %s event: %v", m.Subject, err)
+			return
+		}
+		var data messages.TransactionCreated
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			log.Printf("error unmarshalling %s event %d: %v", m.Subject, event.Sequence, err)
+			return
+		}
+
+		ctx := context.Background()
+		id, err := s.qualifyReferral(ctx, data)
+		if err != nil {
+			log.Printf("error qualifying referral of user %d: %v", data.UserID, err)
+			return
+		}
+		if id != 0 {
+			s.payReferral(ctx, id)
+		}
+	})
+	return err
+}
+
+// RunReferralPayouts pays the bonuses of qualified referrals, retrying those
+// whose payout failed once their backoff is over, expires the referrals that
+// did not qualify in time and looks up the deposits of the others, until ctx
+// is done. Every replica may run it; payouts are claimed before they run.
+func (s *UsersService) RunReferralPayouts(ctx context.Context) {
+	ticker := time.NewTicker(referralPollInterval)
+	defer ticker.Stop()
+	for {
+		s.workReferrals(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *UsersService) workReferrals(ctx context.Context) {
+	err := s.client.Referral.Update().
+		Where(referral.StatusEQ(referral.StatusPending), referral.CreatedAtLT(time.Now().Add(-s.referrals.QualifyWithin))).
+		SetStatus(referral.StatusExpired).
+		Exec(ctx)
+	if err != nil {
+		log.Printf("error expiring referrals: %v", err)
+	}
+
+	s.checkReferrals(ctx)
+
+	ids, err := s.client.Referral.Query().
+		Where(referral.StatusEQ(referral.StatusQualified), payoutDue(time.Now())).
+		Order(ent.Asc(referral.FieldQualifiedAt)).
+		IDs(ctx)
+	if err != nil {
+		log.Printf("error querying qualified referrals: %v", err)
+		return
+	}
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return
+		}
+		s.payReferral(ctx, id)
+	}
+}
+
+// checkReferrals qualifies the pending referrals whose referred user made a
+// qualifying top-up that QualifyReferrals did not see, as events are not
+// redelivered. Each referral is checked at most once per
+// referralCheckInterval, by the replica that claims it.
+func (s *UsersService) checkReferrals(ctx context.Context) {
+	due := func(now time.Time) []predicate.Referral {
+		return []predicate.Referral{
+			referral.StatusEQ(referral.StatusPending),
+			referral.Or(referral.CheckedAtIsNil(), referral.CheckedAtLT(now.Add(-referralCheckInterval))),
+		}
+	}
+
+	now := time.Now()
+	referrals, err := s.client.Referral.Query().
+		Where(due(now)...).
+		Order(ent.Asc(referral.FieldID)).
+		Limit(maxReferralChecks).
+		All(ctx)
+	if err != nil {
+		log.Printf("error querying pending referrals: %v", err)
+		return
+	}
+	for _, r := range referrals {
+		if ctx.Err() != nil {
+			return
+		}
+		n, err := s.client.Referral.Update().
+			Where(referral.ID(r.ID)).
+			Where(due(now)...).
+			SetCheckedAt(now).
+			Save(ctx)
+		if err != nil {
+			log.Printf("error claiming check of referral %d: %v", r.ID, err)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+		if err := s.checkReferral(ctx, r); err != nil {
+			log.Printf("error checking deposits of referral %d: %v", r.ID, err)
+		}
+	}
+}
+
+// checkReferral looks up the qualifying deposits of the referred user of r
+// and qualifies it with the first one.
+func (s *UsersService) checkReferral(ctx context.Context, r *ent.Referral) error {
+	data, err := json.Marshal(messages.ListDeposits{UserID: r.ReferredID, Since: r.CreatedAt, MinAmount: s.referrals.MinTopUp})
+	if err != nil {
+		return err
+	}
+	var list messages.DepositList
+	if reply := s.requestInto(ctx, messages.SubjectListDeposits, data, listDepositsRequestTimeout, &list); !reply.IsSuccess() {
+		return errors.New(reply.Message)
+	}
+
+	for _, deposit := range list.Deposits {
+		id, err := s.qualifyReferral(ctx, deposit)
+		if err != nil {
+			return err
+		}
+		if id != 0 {
+			s.payReferral(ctx, id)
+			return nil
+		}
+	}
+	return nil
+}
+
+// qualifyReferral qualifies the pending referral of the user a transaction
+// credits when it is a large enough top-up made in time. A referrer past the
+// cap earns no more bonuses, so their referral is capped instead. It returns
+// the ID of the referral that qualified, if any.
+func (s *UsersService) qualifyReferral(ctx context.Context, data messages.TransactionCreated) (int, error) {
+	if !data.Deposit || data.Amount < s.referrals.MinTopUp {
+		return 0, nil
+	}
+	r, err := s.client.Referral.Query().
+		Where(referral.ReferredID(data.UserID), referral.StatusEQ(referral.StatusPending)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	if data.CreatedAt.After(r.CreatedAt.Add(s.referrals.QualifyWithin)) {
+		return 0, s.client.Referral.Update().
+			Where(referral.ID(r.ID), referral.StatusEQ(referral.StatusPending)).
+			SetStatus(referral.StatusExpired).
+			Exec(ctx)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("starting transaction: %w", err)
+	}
+	// The bonus is counted against the cap in the same statement that checks
+	// it, so referrals qualifying at once cannot exceed it together.
+	reserve := tx.ReferralCode.Update().Where(referralcode.UserID(r.ReferrerID))
+	if s.referrals.MaxPerReferrer > 0 {
+		reserve = reserve.Where(referralcode.RewardedLT(s.referrals.MaxPerReferrer))
+	}
+	n, err := reserve.AddRewarded(1).Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	update := tx.Referral.Update().
+		Where(referral.ID(r.ID), referral.StatusEQ(referral.StatusPending)).
+		SetQualifiedAt(now)
+	if n == 0 {
+		update = update.SetStatus(referral.StatusCapped)
+	} else {
+		update = update.
+			SetStatus(referral.StatusQualified).
+			SetReferrerBonus(s.referrals.ReferrerBonus).
+			SetReferredBonus(s.referrals.ReferredBonus)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil || updated == 0 {
+		// Another replica handled the referral first.
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("committing transaction: %w", err)
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	return r.ID, nil
+}
+
+// payReferral asks transactions-service to pay the bonuses of a qualified
+// referral. transactions-service pays each bonus once however often it is
+// asked, so a payout that failed midway or whose reply was lost is simply
+// retried.
+func (s *UsersService) payReferral(ctx context.Context, id int) {
+	now := time.Now()
+	n, err := s.client.Referral.Update().
+		Where(
+			referral.ID(id),
+			referral.StatusEQ(referral.StatusQualified),
+			payoutDue(now),
+			referral.Or(referral.PayoutClaimedAtIsNil(), referral.PayoutClaimedAtLT(now.Add(-referralPayoutStaleAfter))),
+		).
+		SetPayoutClaimedAt(now).
+		Save(ctx)
+	if err != nil {
+		log.Printf("error claiming payout of referral %d: %v", id, err)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	r, err := s.client.Referral.Get(ctx, id)
+	if err != nil {
+		log.Printf("error reading referral %d: %v", id, err)
+		return
+	}
+	data, err := json.Marshal(messages.PayReferralBonus{
+		ReferralID:    r.ID,
+		ReferrerID:    r.ReferrerID,
+		ReferredID:    r.ReferredID,
+		ReferrerBonus: r.ReferrerBonus,
+		ReferredBonus: r.ReferredBonus,
+	})
+	if err != nil {
+		log.Printf("error marshalling %s message: %v", messages.SubjectPayReferralBonus, err)
+		return
+	}
+
+	reply := s.request(ctx, messages.SubjectPayReferralBonus, data, payReferralBonusRequestTimeout)
+	if !reply.IsSuccess() {
+		log.Printf("error paying bonuses of referral %d: %s", id, reply.Message)
+	}
+	if err := s.recordPayout(ctx, r, reply, time.Now()); err != nil {
+		log.Printf("error recording payout of referral %d: %v", id, err)
+	}
+}
+
+// recordPayout records the outcome of a payout of r and releases its claim.
+// A failed payout is retried after a backoff, until the referral fails for
+// good after maxReferralPayoutAttempts.
+func (s *UsersService) recordPayout(ctx context.Context, r *ent.Referral, reply messages.Reply, now time.Time) error {
+	update := s.client.Referral.UpdateOneID(r.ID).ClearPayoutClaimedAt().ClearNextPayoutAt()
+	attempts := r.PayoutAttempts + 1
+	switch {
+	case reply.IsSuccess():
+		update = update.SetStatus(referral.StatusPaid).SetPaidAt(now).SetPayoutError("")
+	case attempts >= maxReferralPayoutAttempts:
+		update = update.SetStatus(referral.StatusFailed).SetPayoutAttempts(attempts).SetPayoutError(reply.Message)
+	default:
+		update = update.SetPayoutAttempts(attempts).SetPayoutError(reply.Message).SetNextPayoutAt(now.Add(referralPayoutDelay(attempts)))
+	}
+	return update.Exec(ctx)
+}
+
+// referralPayoutDelay returns how long to wait after the given number of
+// failed payouts.
+func referralPayoutDelay(attempts int) time.Duration {
+	delay := referralPayoutBackoff
+	for i := 1; i < attempts && delay < maxReferralPayoutBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxReferralPayoutBackoff)
+}
+
+// payoutDue selects the referrals whose payout is not waiting out a backoff.
+func payoutDue(now time.Time) predicate.Referral {
+	return referral.Or(referral.NextPayoutAtIsNil(), referral.NextPayoutAtLTE(now))
+}
+
+func newReferralCode() (string, error) {
+	b := make([]byte, referralCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		// 256 is a multiple of the alphabet size, so every character is
+		// equally likely.
+		b[i] = referralCodeAlphabet[int(b[i])%len(referralCodeAlphabet)]
+	}
+	return string(b), nil
+}
+
+// normalizeReferralCode strips what users type around codes: spaces, dashes
+// and case.
+func normalizeReferralCode(code string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(code)))
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"shared/messages"
+	"testing"
+	"time"
+	"user-service/ent/enttest"
+	"user-service/ent/referral"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRecordPayout(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	s := &UsersService{client: client}
+
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	failed := messages.Error(messages.ErrorCodeServiceTimeout, "pay-referral-bonus request timed out")
+	tests := []struct {
+		name         string
+		attempts     int
+		reply        messages.Reply
+		wantStatus   referral.Status
+		wantAttempts int
+		wantNext     time.Duration
+	}{
+		{name: "first failure backs off", reply: failed, wantStatus: referral.StatusQualified, wantAttempts: 1, wantNext: time.Minute},
+		{name: "backoff doubles", attempts: 3, reply: failed, wantStatus: referral.StatusQualified, wantAttempts: 4, wantNext: 8 * time.Minute},
+		{name: "last attempt fails the referral", attempts: maxReferralPayoutAttempts - 1, reply: failed, wantStatus: referral.StatusFailed, wantAttempts: maxReferralPayoutAttempts},
+		{name: "success pays", attempts: 2, reply: messages.Success("paid"), wantStatus: referral.StatusPaid, wantAttempts: 2},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := client.Referral.Create().
+				SetReferrerID(100).
+				SetReferredID(i + 1).
+				SetCode("K7QW2MXP").
+				SetStatus(referral.StatusQualified).
+				SetPayoutAttempts(tt.attempts).
+				SetPayoutClaimedAt(now).
+				SaveX(ctx)
+
+			if err := s.recordPayout(ctx, r, tt.reply, now); err != nil {
+				t.Fatal(err)
+			}
+
+			r = client.Referral.GetX(ctx, r.ID)
+			if r.Status != tt.wantStatus || r.PayoutAttempts != tt.wantAttempts || r.PayoutClaimedAt != nil {
+				t.Fatalf("got status %s, %d attempts, claimed at %v; want %s, %d, unclaimed",
+					r.Status, r.PayoutAttempts, r.PayoutClaimedAt, tt.wantStatus, tt.wantAttempts)
+			}
+			var next time.Duration
+			if r.NextPayoutAt != nil {
+				next = r.NextPayoutAt.Sub(now)
+			}
+			if next != tt.wantNext {
+				t.Fatalf("next payout in %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
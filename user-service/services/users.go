@@ -0,0 +1,482 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"shared/auth"
+	"shared/messages"
+	"strings"
+	"time"
+	"user-service/ent"
+	"user-service/ent/user"
+	"user-service/mailer"
+	"user-service/profiles"
+	"user-service/sms"
+	"user-service/tokens"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	balanceRequestTimeout     = 5 * time.Second
+	userCreatedRequestTimeout = 10 * time.Second
+	userUpdatedRequestTimeout = 10 * time.Second
+)
+
+// Balance read consistency levels and the sources a balance can come from.
+const (
+	ConsistencyEventual = "eventual"
+	ConsistencyStrong   = "strong"
+
+	BalanceSourceProjection   = "projection"
+	BalanceSourceTransactions = "transactions-service"
+)
+
+var (
+	ErrUserNotFound       = errors.New("user not found")
+	ErrUserAlreadyExists  = errors.New("user already exists")
+	ErrInvalidConsistency = errors.New("consistency must be eventual or strong")
+	ErrEmailTaken         = errors.New("email is already in use")
+	ErrInvalidEmail       = errors.New("invalid email address")
+)
+
+// RemoteError is a failure reported by transactions-service in a reply envelope.
+type RemoteError struct {
+	Code    string
+	Message string
+}
+
+func (e *RemoteError) Error() string {
+	return e.Message
+}
+
+// UserFilter selects users; zero fields match every user.
+type UserFilter struct {
+	// Query matches users whose email or name contains it, ignoring case.
+	Query         string
+	Email         string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// UserUpdate holds the fields of a user to change; nil fields are kept.
+// Empty profile fields are removed.
+type UserUpdate struct {
+	Email     *string
+	Name      *string
+	LegalName *string
+	// DateOfBirth is formatted as profiles.DateLayout.
+	DateOfBirth *string
+	Phone       *string
+	Address     *profiles.Address
+	Locale      *string
+	Timezone    *string
+	// PasswordConfirmed is set when the caller gave the current password,
+	// which lets the email change without a step-up.
+	PasswordConfirmed bool
+}
+
+// Balance is a wallet balance together with where it was read from.
+type Balance struct {
+	Amount   float64
+	Currency string
+	Source   string
+	Sequence int
+	AsOf     time.Time
+}
+
+// UsersService holds the business logic of user operations shared by every API.
+type UsersService struct {
+	client       *ent.Client
+	natsConn     *nats.Conn
+	issuer       *tokens.Issuer
+	mailer       mailer.Mailer
+	texter       sms.Sender
+	verification VerificationConfig
+	privacy      PrivacyConfig
+	referrals    ReferralConfig
+}
+
+func NewUsersService(client *ent.Client, natsConn *nats.Conn, issuer *tokens.Issuer, mailer mailer.Mailer, texter sms.Sender, verification VerificationConfig, privacy PrivacyConfig, referrals ReferralConfig) *UsersService {
+	return &UsersService{client: client, natsConn: natsConn, issuer: issuer, mailer: mailer, texter: texter, verification: verification, privacy: privacy, referrals: referrals}
+}
+
+// CreateUser creates the user and its wallet in transactions-service. The user
+// is only committed once transactions-service has confirmed the wallet, and is
+// then sent a link to verify the email. A user signing up with the referral
+// code of another, which may be empty, is recorded as referred by them. A
+// non-empty passwordHash is stored with the user, in the same transaction.
+func (s *UsersService) CreateUser(ctx context.Context, email, passwordHash, referralCode string) (*ent.User, error) {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	exists, err := tx.User.Query().Where(user.EmailEQ(email)).Exist(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if exists {
+		tx.Rollback()
+		return nil, ErrUserAlreadyExists
+	}
+
+	u, err := tx.User.Create().
+		SetEmail(email).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if passwordHash != "" {
+		err := tx.Credential.Create().
+			SetUserID(u.ID).
+			SetPasswordHash(passwordHash).
+			SetPasswordChangedAt(u.CreatedAt).
+			Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if referralCode != "" {
+		if err := referUser(ctx, tx, u.ID, referralCode); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	userData, err := json.Marshal(messages.UserCreated{ID: u.ID, Email: u.Email, CreatedAt: u.CreatedAt})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	reply := s.request(ctx, messages.SubjectUserCreated, userData, userCreatedRequestTimeout)
+	if !reply.IsSuccess() {
+		tx.Rollback()
+		return nil, &RemoteError{Code: reply.ErrorCode, Message: reply.Message}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	s.startVerification(ctx, u)
+	return u, nil
+}
+
+// GetUser returns the user with the given ID.
+func (s *UsersService) GetUser(ctx context.Context, id int) (*ent.User, error) {
+	u, err := s.client.User.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, ErrUserNotFound
+	}
+	return u, err
+}
+
+// ListUsers returns a page of the users matching filter, ordered by ID, and
+// the number of matching users.
+func (s *UsersService) ListUsers(ctx context.Context, filter UserFilter, limit, offset int) ([]*ent.User, int, error) {
+	query := s.client.User.Query()
+	if filter.Query != "" {
+		query = query.Where(user.Or(user.EmailContainsFold(filter.Query), user.NameContainsFold(filter.Query)))
+	}
+	if filter.Email != "" {
+		query = query.Where(user.EmailContainsFold(filter.Email))
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where(user.CreatedAtGTE(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where(user.CreatedAtLT(*filter.CreatedBefore))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users, err := query.
+		Order(ent.Asc(user.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+// UpdateUser changes the fields of a user and records every changed field
+// in the profile history, authored by the caller of ctx. A new email is also
+// set on the user's wallet in transactions-service, and the change is only
+// committed once transactions-service has confirmed it. An email must be
+// unused on both sides; it is unverified until the user follows the link
+// sent to it. Changing the email needs the current password or a step-up,
+// and the previous email is told of the change.
+func (s *UsersService) UpdateUser(ctx context.Context, id int, update UserUpdate) (*ent.User, error) {
+	if update.Email != nil {
+		email, err := normalizeEmail(*update.Email)
+		if err != nil {
+			return nil, err
+		}
+		update.Email = &email
+	}
+	now := time.Now()
+	if err := normalizeProfile(&update, now); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	u, err := tx.User.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		tx.Rollback()
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if u.ErasedAt != nil {
+		tx.Rollback()
+		return nil, ErrUserErased
+	}
+	previous := userUpdated(u)
+	previousProfile := profileValues(u)
+	emailChanged := update.Email != nil && *update.Email != u.Email
+	phoneChanged := update.Phone != nil && strings.TrimSpace(*update.Phone) != u.Phone
+
+	mutation := setProfile(tx.User.UpdateOne(u), update)
+	if phoneChanged {
+		mutation = clearPhoneVerification(mutation)
+	}
+	if emailChanged {
+		taken, err := tx.User.Query().Where(user.EmailEQ(*update.Email), user.IDNEQ(id)).Exist(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if taken {
+			tx.Rollback()
+			return nil, ErrEmailTaken
+		}
+		if !update.PasswordConfirmed {
+			if err := auth.RequireStepUp(ctx); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+		mutation = mutation.
+			SetEmail(*update.Email).
+			ClearVerifiedAt().
+			ClearVerificationSentAt()
+	}
+
+	u, err = mutation.Save(ctx)
+	if ent.IsConstraintError(err) {
+		tx.Rollback()
+		return nil, ErrEmailTaken
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	currentProfile := profileValues(u)
+	if err := recordProfileChanges(ctx, tx, previousProfile, currentProfile, id, now); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := dropStaleAliases(ctx, tx, id, previousProfile, currentProfile); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if emailChanged {
+		if err := s.syncUser(ctx, tx, u, previous); err != nil {
+			return nil, err
+		}
+		s.startVerification(ctx, u)
+		s.notifyEmailChanged(ctx, previous.Email, u)
+		return u, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return u, nil
+}
+
+// syncUser sets the email and verification of a user's wallet in
+// transactions-service and commits tx once it has confirmed them. tx is
+// rolled back when they could not be set.
+func (s *UsersService) syncUser(ctx context.Context, tx *ent.Tx, u *ent.User, previous messages.UserUpdated) error {
+	reply := s.sendUserUpdated(ctx, userUpdated(u))
+	if !reply.IsSuccess() {
+		tx.Rollback()
+		if reply.ErrorCode == messages.ErrorCodeServiceTimeout {
+			// The update may have been applied after all.
+			go s.revertUser(previous, time.Now())
+		}
+		return &RemoteError{Code: reply.ErrorCode, Message: reply.Message}
+	}
+
+	if err := tx.Commit(); err != nil {
+		go s.revertUser(previous, time.Now())
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *UsersService) sendUserUpdated(ctx context.Context, update messages.UserUpdated) messages.Reply {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return messages.Error(messages.ErrorCodeInternal, "error marshalling user-updated message: "+err.Error())
+	}
+	return s.request(ctx, messages.SubjectUserUpdated, data, userUpdatedRequestTimeout)
+}
+
+// revertUser restores a wallet after an update that may have reached
+// transactions-service was rolled back. The revert is dated when the rollback
+// happened, so it wins over the update even if that arrives late, but not
+// over the updates made since.
+func (s *UsersService) revertUser(previous messages.UserUpdated, at time.Time) {
+	previous.UpdatedAt = at
+	reply := s.sendUserUpdated(context.Background(), previous)
+	if !reply.IsSuccess() {
+		log.Printf("error reverting user %d in transactions-service: %s", previous.ID, reply.Message)
+	}
+}
+
+func userUpdated(u *ent.User) messages.UserUpdated {
+	return messages.UserUpdated{ID: u.ID, Email: u.Email, VerifiedAt: u.VerifiedAt, UpdatedAt: u.UpdatedAt}
+}
+
+// normalizeEmail trims email and checks that it is a bare address.
+func normalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return "", ErrInvalidEmail
+	}
+	return email, nil
+}
+
+// GetBalance returns the balance of the user with the given email.
+func (s *UsersService) GetBalance(ctx context.Context, email string, consistency string) (Balance, error) {
+	if consistency != ConsistencyEventual && consistency != ConsistencyStrong {
+		return Balance{}, ErrInvalidConsistency
+	}
+
+	u, err := s.client.User.Query().Where(user.EmailEQ(email)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return Balance{}, ErrUserNotFound
+	}
+	if err != nil {
+		return Balance{}, err
+	}
+
+	return s.balanceOf(ctx, u, consistency)
+}
+
+// GetUserBalance returns the balance of the user with the given ID.
+func (s *UsersService) GetUserBalance(ctx context.Context, id int, consistency string) (Balance, error) {
+	if consistency != ConsistencyEventual && consistency != ConsistencyStrong {
+		return Balance{}, ErrInvalidConsistency
+	}
+
+	u, err := s.GetUser(ctx, id)
+	if err != nil {
+		return Balance{}, err
+	}
+
+	return s.balanceOf(ctx, u, consistency)
+}
+
+// balanceOf reads the balance of u. Eventual reads come from the local
+// projection and fall back to transactions-service for wallets that are not
+// projected yet, or not since the time of their balance was recorded; strong
+// reads always ask it.
+func (s *UsersService) balanceOf(ctx context.Context, u *ent.User, consistency string) (Balance, error) {
+	if consistency == ConsistencyEventual {
+		p, err := s.client.BalanceProjection.Get(ctx, u.ID)
+		if err == nil && !p.AsOf.IsZero() {
+			return Balance{
+				Amount:   p.Balance,
+				Currency: p.Currency,
+				Source:   BalanceSourceProjection,
+				Sequence: p.Sequence,
+				AsOf:     p.AsOf,
+			}, nil
+		}
+		if !ent.IsNotFound(err) {
+			log.Printf("error reading balance projection of user %d: %v", u.ID, err)
+		}
+		// The wallet is not projected yet, fall back to asking transactions-service.
+	}
+
+	reply := s.request(ctx, messages.SubjectGetBalance, []byte(u.Email), balanceRequestTimeout)
+	if !reply.IsSuccess() {
+		return Balance{}, &RemoteError{Code: reply.ErrorCode, Message: reply.Message}
+	}
+	if reply.Balance == nil {
+		return Balance{}, &RemoteError{Code: messages.ErrorCodeInternal, Message: "malformed get-balance reply: balance is missing"}
+	}
+
+	return Balance{
+		Amount:   *reply.Balance,
+		Currency: reply.Currency,
+		Source:   BalanceSourceTransactions,
+		AsOf:     time.Now(),
+	}, nil
+}
+
+// request sends a NATS request and always returns a reply envelope, turning
+// transport failures into error replies. The request waits for at most timeout,
+// or less when ctx has an earlier deadline.
+func (s *UsersService) request(ctx context.Context, subject string, data []byte, timeout time.Duration) messages.Reply {
+	var reply messages.Reply
+	return s.requestInto(ctx, subject, data, timeout, &reply)
+}
+
+// requestInto is request for subjects whose replies carry more than the
+// envelope: the reply is also decoded into out, which embeds the envelope.
+func (s *UsersService) requestInto(ctx context.Context, subject string, data []byte, timeout time.Duration, out any) messages.Reply {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	msg, err := s.natsConn.RequestWithContext(ctx, subject, data)
+	switch {
+	case errors.Is(err, nats.ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return messages.Error(messages.ErrorCodeServiceTimeout, subject+" request timed out")
+	case errors.Is(err, nats.ErrNoResponders):
+		return messages.Error(messages.ErrorCodeServiceUnavailable, "no responders for "+subject)
+	case err != nil:
+		return messages.Error(messages.ErrorCodeServiceUnavailable, "NATS request error: "+err.Error())
+	}
+
+	reply, err := messages.Decode(msg.Data)
+	if err == nil {
+		err = json.Unmarshal(msg.Data, out)
+	}
+	if err != nil {
+		return messages.Error(messages.ErrorCodeInternal, "malformed "+subject+" reply: "+err.Error())
+	}
+	return reply
+}
@@ -0,0 +1,242 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+	"user-service/ent"
+	"user-service/ent/user"
+	"user-service/mailer"
+)
+
+var (
+	ErrEmailAlreadyVerified  = errors.New("email is already verified")
+	ErrVerificationThrottled = errors.New("a verification email was sent recently")
+	ErrInvalidVerification   = errors.New("verification token is invalid or expired")
+)
+
+// VerificationConfig controls the email verification links and the codes
+// texted to verify phones.
+type VerificationConfig struct {
+	// URL is the page of the verification link; the token is added as its
+	// token query parameter.
+	URL string
+	// ResendInterval is how long a user waits before another link is sent.
+	ResendInterval time.Duration
+	// PhoneCodeTTL is how long a texted code can be entered.
+	PhoneCodeTTL time.Duration
+	// PhoneResendInterval is how long a user waits before another code is sent.
+	PhoneResendInterval time.Duration
+}
+
+var defaultVerificationConfig = VerificationConfig{
+	URL:                 "http://localhost:8080/api/v2/email-verification",
+	ResendInterval:      time.Minute,
+	PhoneCodeTTL:        10 * time.Minute,
+	PhoneResendInterval: time.Minute,
+}
+
+// LoadVerificationConfig reads EMAIL_VERIFICATION_URL,
+// EMAIL_VERIFICATION_RESEND_INTERVAL, PHONE_VERIFICATION_TTL and
+// PHONE_VERIFICATION_RESEND_INTERVAL.
+func LoadVerificationConfig() (VerificationConfig, error) {
+	config := defaultVerificationConfig
+	if v := os.Getenv("EMAIL_VERIFICATION_URL"); v != "" {
+		if _, err := url.Parse(v); err != nil {
+			return VerificationConfig{}, fmt.Errorf("EMAIL_VERIFICATION_URL must be a URL: %w", err)
+		}
+		config.URL = v
+	}
+	if v := os.Getenv("EMAIL_VERIFICATION_RESEND_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return VerificationConfig{}, fmt.Errorf("EMAIL_VERIFICATION_RESEND_INTERVAL must be a non-negative duration")
+		}
+		config.ResendInterval = d
+	}
+	if v := os.Getenv("PHONE_VERIFICATION_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return VerificationConfig{}, fmt.Errorf("PHONE_VERIFICATION_TTL must be a positive duration")
+		}
+		config.PhoneCodeTTL = d
+	}
+	if v := os.Getenv("PHONE_VERIFICATION_RESEND_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return VerificationConfig{}, fmt.Errorf("PHONE_VERIFICATION_RESEND_INTERVAL must be a non-negative duration")
+		}
+		config.PhoneResendInterval = d
+	}
+	return config, nil
+}
+
+// ResendVerification sends the user another verification link, at most once
+// every resend interval.
+func (s *UsersService) ResendVerification(ctx context.Context, id int) error {
+	u, err := s.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if u.ErasedAt != nil {
+		return ErrUserErased
+	}
+	if u.VerifiedAt != nil {
+		return ErrEmailAlreadyVerified
+	}
+
+	// Claim the resend in the same statement that checks the interval, so
+	// concurrent requests send a single link.
+	now := time.Now()
+	n, err := s.client.User.Update().
+		Where(
+			user.IDEQ(id),
+			user.EmailEQ(u.Email),
+			user.VerifiedAtIsNil(),
+			user.Or(user.VerificationSentAtIsNil(), user.VerificationSentAtLTE(now.Add(-s.verification.ResendInterval))),
+		).
+		SetVerificationSentAt(now).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		u, err = s.GetUser(ctx, id)
+		if err != nil {
+			return err
+		}
+		if u.VerifiedAt != nil {
+			return ErrEmailAlreadyVerified
+		}
+		if u.VerificationSentAt == nil {
+			// The email changed meanwhile and a link to it is on its way.
+			return ErrVerificationThrottled
+		}
+		wait := time.Until(u.VerificationSentAt.Add(s.verification.ResendInterval)).Round(time.Second)
+		return fmt.Errorf("%w, retry in %s", ErrVerificationThrottled, max(wait, time.Second))
+	}
+
+	if err := s.sendVerification(ctx, u); err != nil {
+		// Give the claimed resend back, so the user can retry right away.
+		release := s.client.User.Update().Where(user.IDEQ(id), user.VerificationSentAtEQ(now))
+		if u.VerificationSentAt != nil {
+			release = release.SetVerificationSentAt(*u.VerificationSentAt)
+		} else {
+			release = release.ClearVerificationSentAt()
+		}
+		if releaseErr := release.Exec(ctx); releaseErr != nil {
+			log.Printf("error releasing verification resend of user %d: %v", id, releaseErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// VerifyEmail marks the email of the user a verification token was issued to
+// as verified, in user-service and on the user's wallet in transactions-service.
+// A token only verifies the email it was issued for. Verifying a verified
+// email again changes nothing.
+func (s *UsersService) VerifyEmail(ctx context.Context, token string) (*ent.User, error) {
+	claims, err := s.issuer.VerifyEmailVerification(ctx, token)
+	if err != nil {
+		return nil, ErrInvalidVerification
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, ErrInvalidVerification
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	u, err := tx.User.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		tx.Rollback()
+		return nil, ErrInvalidVerification
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if u.Email != claims.Email {
+		tx.Rollback()
+		return nil, ErrInvalidVerification
+	}
+	if u.VerifiedAt != nil {
+		tx.Rollback()
+		return u, nil
+	}
+	previous := userUpdated(u)
+
+	u, err = tx.User.UpdateOne(u).SetVerifiedAt(time.Now()).Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := s.syncUser(ctx, tx, u, previous); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// startVerification sends the first verification link to a new email. A
+// failure is only logged, as the user can ask for another link.
+func (s *UsersService) startVerification(ctx context.Context, u *ent.User) {
+	err := s.client.User.Update().
+		Where(user.IDEQ(u.ID), user.EmailEQ(u.Email), user.VerifiedAtIsNil()).
+		SetVerificationSentAt(time.Now()).
+		Exec(ctx)
+	if err == nil {
+		err = s.sendVerification(ctx, u)
+	}
+	if err != nil {
+		log.Printf("error sending verification email to user %d: %v", u.ID, err)
+	}
+}
+
+// sendVerification mails u a link verifying their current email.
+func (s *UsersService) sendVerification(ctx context.Context, u *ent.User) error {
+	token, err := s.issuer.IssueEmailVerification(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	link, err := url.Parse(s.verification.URL)
+	if err != nil {
+		return err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      u.Email,
+		Subject: "Verify your email address",
+		Body: "Please confirm that " + u.Email + " is your email address by opening the link below.\n\n" +
+			link.String() + "\n\n" +
+			"Until you do, your wallet can receive money but not send it. The link expires in " +
+			s.issuer.VerificationTTL().String() + ".\n",
+	})
+}
+
+// notifyEmailChanged mails the previous email of u that it was replaced, so
+// the owner notices a change they did not make.
+func (s *UsersService) notifyEmailChanged(ctx context.Context, previous string, u *ent.User) {
+	err := s.mailer.Send(ctx, mailer.Message{
+		To:      previous,
+		Subject: "Your email address was changed",
+		Body: "The email address of your Digital Wallet account was changed from " + previous + " to " + u.Email + ".\n\n" +
+			"If you did not make this change, contact support right away.\n",
+	})
+	if err != nil {
+		log.Printf("error notifying user %d of their email change: %v", u.ID, err)
+	}
+}
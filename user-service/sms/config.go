@@ -0,0 +1,42 @@
+package sms
+
+import (
+	"fmt"
+	"os"
+)
+
+// Senders selectable with SMS_SENDER.
+const (
+	SenderHTTP   = "http"
+	SenderFile   = "file"
+	SenderMemory = "memory"
+)
+
+const defaultDir = "sms"
+
+// FromEnv builds the sender selected by SMS_SENDER: http (posting to
+// SMS_GATEWAY_URL with SMS_GATEWAY_TOKEN), file (writing to SMS_DIR) or
+// memory. It defaults to file.
+func FromEnv() (Sender, error) {
+	switch sender := envOr("SMS_SENDER", SenderFile); sender {
+	case SenderHTTP:
+		url := os.Getenv("SMS_GATEWAY_URL")
+		if url == "" {
+			return nil, fmt.Errorf("SMS_GATEWAY_URL is required when SMS_SENDER is %s", SenderHTTP)
+		}
+		return NewHTTPSender(url, os.Getenv("SMS_GATEWAY_TOKEN")), nil
+	case SenderFile:
+		return NewFileSender(envOr("SMS_DIR", defaultDir)), nil
+	case SenderMemory:
+		return NewMemorySender(), nil
+	default:
+		return nil, fmt.Errorf("SMS_SENDER must be %s, %s or %s, got %q", SenderHTTP, SenderFile, SenderMemory, sender)
+	}
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
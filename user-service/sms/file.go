@@ -0,0 +1,30 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileSender writes every message to a .txt file in a directory instead of
+// sending it.
+type FileSender struct {
+	dir string
+}
+
+func NewFileSender(dir string) *FileSender {
+	return &FileSender{dir: dir}
+}
+
+func (s *FileSender) Send(ctx context.Context, msg Message) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+	now := time.Now()
+	recipient := strings.NewReplacer("/", "_", "\\", "_", "+", "").Replace(msg.To)
+	name := fmt.Sprintf("%s-%s.txt", now.UTC().Format("20060102T150405.000000000"), recipient)
+	return os.WriteFile(filepath.Join(s.dir, name), []byte("To: "+msg.To+"\n\n"+msg.Body+"\n"), 0o644)
+}
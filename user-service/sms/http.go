@@ -0,0 +1,52 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HTTPSender posts every message as JSON ({"to": ..., "body": ...}) to an
+// SMS gateway.
+type HTTPSender struct {
+	url        string
+	token      string
+	httpClient *http.Client
+}
+
+// NewHTTPSender posts messages to url, with token as bearer token when set.
+func NewHTTPSender(url, token string) *HTTPSender {
+	return &HTTPSender{url: url, token: token, httpClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(struct {
+		To   string `json:"to"`
+		Body string `json:"body"`
+	}{msg.To, msg.Body})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending text message: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("sending text message: status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+// Package sms sends the text messages of user-service through an HTTP
+// gateway, or to a directory or memory during development and tests.
+package sms
+
+import "context"
+
+// Message is a text message to a phone number in E.164 format.
+type Message struct {
+	To   string
+	Body string
+}
+
+// Sender delivers messages.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
@@ -0,0 +1,95 @@
+package tokens
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Config controls token lifetimes, signing key rotation and the service
+// clients allowed to obtain admin tokens.
+type Config struct {
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	// VerificationTTL is how long an email verification link works.
+	VerificationTTL time.Duration
+	// PasswordResetTTL is how long a password reset link works.
+	PasswordResetTTL time.Duration
+	// StepUpTTL is how long a step-up confirms sensitive requests.
+	StepUpTTL time.Duration
+	// KeyRotation is how long a signing key signs tokens before a new one replaces it.
+	KeyRotation time.Duration
+	// Clients maps the IDs of service clients to their secrets.
+	Clients map[string]string
+}
+
+var defaultConfig = Config{
+	AccessTTL:        15 * time.Minute,
+	RefreshTTL:       30 * 24 * time.Hour,
+	VerificationTTL:  24 * time.Hour,
+	PasswordResetTTL: time.Hour,
+	StepUpTTL:        5 * time.Minute,
+	KeyRotation:      24 * time.Hour,
+}
+
+// LoadConfig reads ACCESS_TOKEN_TTL, REFRESH_TOKEN_TTL, EMAIL_VERIFICATION_TTL,
+// PASSWORD_RESET_TTL, STEP_UP_TTL, SIGNING_KEY_ROTATION and AUTH_CLIENTS, a comma-separated
+// list of id:secret pairs.
+func LoadConfig() (Config, error) {
+	config := defaultConfig
+
+	var err error
+	if config.AccessTTL, err = durationFromEnv("ACCESS_TOKEN_TTL", config.AccessTTL); err != nil {
+		return Config{}, err
+	}
+	if config.RefreshTTL, err = durationFromEnv("REFRESH_TOKEN_TTL", config.RefreshTTL); err != nil {
+		return Config{}, err
+	}
+	if config.VerificationTTL, err = durationFromEnv("EMAIL_VERIFICATION_TTL", config.VerificationTTL); err != nil {
+		return Config{}, err
+	}
+	if config.VerificationTTL > config.RefreshTTL {
+		// Signing keys are only published for as long as refresh tokens live.
+		return Config{}, fmt.Errorf("EMAIL_VERIFICATION_TTL must not exceed REFRESH_TOKEN_TTL")
+	}
+	if config.PasswordResetTTL, err = durationFromEnv("PASSWORD_RESET_TTL", config.PasswordResetTTL); err != nil {
+		return Config{}, err
+	}
+	if config.PasswordResetTTL > config.RefreshTTL {
+		return Config{}, fmt.Errorf("PASSWORD_RESET_TTL must not exceed REFRESH_TOKEN_TTL")
+	}
+	if config.StepUpTTL, err = durationFromEnv("STEP_UP_TTL", config.StepUpTTL); err != nil {
+		return Config{}, err
+	}
+	if config.StepUpTTL > config.AccessTTL {
+		return Config{}, fmt.Errorf("STEP_UP_TTL must not exceed ACCESS_TOKEN_TTL")
+	}
+	if config.KeyRotation, err = durationFromEnv("SIGNING_KEY_ROTATION", config.KeyRotation); err != nil {
+		return Config{}, err
+	}
+
+	config.Clients = make(map[string]string)
+	if v := os.Getenv("AUTH_CLIENTS"); v != "" {
+		for _, pair := range strings.Split(v, ",") {
+			id, secret, ok := strings.Cut(strings.TrimSpace(pair), ":")
+			if !ok || id == "" || secret == "" {
+				return Config{}, fmt.Errorf("AUTH_CLIENTS must be a comma-separated list of id:secret pairs")
+			}
+			config.Clients[id] = secret
+		}
+	}
+	return config, nil
+}
+
+func durationFromEnv(key string, fallback time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%s must be a positive duration", key)
+	}
+	return d, nil
+}
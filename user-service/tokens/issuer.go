@@ -0,0 +1,301 @@
+// Package tokens issues the access and refresh tokens of user-service and
+// manages the keys they are signed with.
+package tokens
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/subtle"
+	"errors"
+	"shared/auth"
+	"strconv"
+	"strings"
+	"time"
+	"user-service/common/problems"
+	"user-service/ent"
+	"user-service/ent/refreshtoken"
+	"user-service/ent/session"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+// Pair is the result of a token grant. Tokens issued to service clients have
+// no refresh token.
+type Pair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration
+	Scope        string
+}
+
+// Issuer issues tokens to users and service clients, and manages the
+// sessions of users: the token families started by their logins.
+type Issuer struct {
+	client   *ent.Client
+	keys     *KeyStore
+	verifier *auth.Verifier
+	// denied is the deny-list verifier checks; revoked sessions are added to
+	// it and published to the other replicas on natsConn.
+	denied   *auth.DenyList
+	natsConn *nats.Conn
+	config   Config
+}
+
+func NewIssuer(client *ent.Client, keys *KeyStore, verifier *auth.Verifier, denied *auth.DenyList, natsConn *nats.Conn, config Config) *Issuer {
+	return &Issuer{client: client, keys: keys, verifier: verifier, denied: denied, natsConn: natsConn, config: config}
+}
+
+// IssueForUser starts a new session for a user on device, and the token
+// family of the session.
+func (i *Issuer) IssueForUser(ctx context.Context, u *ent.User, device Device) (*Pair, error) {
+	family := uuid.NewString()
+	if err := i.startSession(ctx, u.ID, family, device); err != nil {
+		return nil, err
+	}
+	return i.issueForUser(ctx, u, family)
+}
+
+// Refresh exchanges a refresh token for a new pair in the same family. Every
+// refresh token can be used once: presenting a used token means it leaked, so
+// its whole family is revoked. The session of the family is seen from device.
+func (i *Issuer) Refresh(ctx context.Context, token string, device Device) (*Pair, error) {
+	claims, err := i.verifier.Verify(ctx, token, auth.TokenTypeRefresh)
+	if err != nil {
+		return nil, problems.New(problems.CodeUnauthorized, err.Error())
+	}
+
+	rt, err := i.client.RefreshToken.Get(ctx, claims.ID)
+	if ent.IsNotFound(err) {
+		return nil, problems.New(problems.CodeUnauthorized, "unknown refresh token")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if rt.RevokedAt != nil {
+		return nil, problems.New(problems.CodeUnauthorized, "refresh token revoked")
+	}
+
+	// Mark the token used only if nobody did so first, so two concurrent
+	// refreshes cannot both succeed.
+	n, err := i.client.RefreshToken.Update().
+		Where(
+			refreshtoken.ID(rt.ID),
+			refreshtoken.UsedAtIsNil(),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		if err := i.revokeFamily(ctx, rt.Family); err != nil {
+			return nil, err
+		}
+		return nil, problems.New(problems.CodeUnauthorized, "refresh token reused; all tokens of the session were revoked")
+	}
+
+	u, err := i.client.User.Get(ctx, rt.UserID)
+	if ent.IsNotFound(err) {
+		return nil, problems.New(problems.CodeUnauthorized, "user no longer exists")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := i.touchSession(ctx, u.ID, rt.Family, device); err != nil {
+		return nil, err
+	}
+	return i.issueForUser(ctx, u, rt.Family)
+}
+
+// Revoke revokes the family of a refresh token. Invalid tokens are ignored, as
+// there is nothing left to revoke.
+func (i *Issuer) Revoke(ctx context.Context, token string) error {
+	claims, err := i.verifier.Verify(ctx, token, auth.TokenTypeRefresh)
+	if err != nil {
+		return nil
+	}
+	rt, err := i.client.RefreshToken.Get(ctx, claims.ID)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return i.revokeFamily(ctx, rt.Family)
+}
+
+// IssueForClient issues an admin access token to a service client
+// authenticated with its secret.
+func (i *Issuer) IssueForClient(ctx context.Context, id, secret string) (*Pair, error) {
+	expected, ok := i.config.Clients[id]
+	if !ok || subtle.ConstantTimeCompare([]byte(expected), []byte(secret)) != 1 {
+		return nil, problems.New(problems.CodeUnauthorized, "invalid client credentials")
+	}
+
+	scope := auth.ScopeAdmin
+	access, err := i.sign(ctx, auth.Claims{
+		RegisteredClaims: i.registeredClaims("client:"+id, i.config.AccessTTL),
+		Type:             auth.TokenTypeAccess,
+		Scope:            scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Pair{AccessToken: access, ExpiresIn: i.config.AccessTTL, Scope: scope}, nil
+}
+
+// IssueEmailVerification issues the token of a link proving that the user
+// owns their current email.
+func (i *Issuer) IssueEmailVerification(ctx context.Context, u *ent.User) (string, error) {
+	return i.sign(ctx, auth.Claims{
+		RegisteredClaims: i.registeredClaims(strconv.Itoa(u.ID), i.config.VerificationTTL),
+		Type:             auth.TokenTypeEmailVerification,
+		Email:            u.Email,
+	})
+}
+
+// VerificationTTL is how long an email verification token is valid.
+func (i *Issuer) VerificationTTL() time.Duration {
+	return i.config.VerificationTTL
+}
+
+// VerifyEmailVerification checks an email verification token and returns
+// its claims.
+func (i *Issuer) VerifyEmailVerification(ctx context.Context, token string) (*auth.Claims, error) {
+	return i.verifier.Verify(ctx, token, auth.TokenTypeEmailVerification)
+}
+
+// IssuePasswordReset issues the token of a password reset link and returns it
+// with its ID, which the caller records to make the token single-use.
+func (i *Issuer) IssuePasswordReset(ctx context.Context, u *ent.User) (string, string, error) {
+	claims := auth.Claims{
+		RegisteredClaims: i.registeredClaims(strconv.Itoa(u.ID), i.config.PasswordResetTTL),
+		Type:             auth.TokenTypePasswordReset,
+		Email:            u.Email,
+	}
+	token, err := i.sign(ctx, claims)
+	if err != nil {
+		return "", "", err
+	}
+	return token, claims.ID, nil
+}
+
+// PasswordResetTTL is how long a password reset token is valid.
+func (i *Issuer) PasswordResetTTL() time.Duration {
+	return i.config.PasswordResetTTL
+}
+
+// VerifyPasswordReset checks a password reset token and returns its claims.
+func (i *Issuer) VerifyPasswordReset(ctx context.Context, token string) (*auth.Claims, error) {
+	return i.verifier.Verify(ctx, token, auth.TokenTypePasswordReset)
+}
+
+// IssueStepUp issues a token proving that u just confirmed a second factor,
+// sent alongside the access token of sensitive requests.
+func (i *Issuer) IssueStepUp(ctx context.Context, u *ent.User) (string, error) {
+	return i.sign(ctx, auth.Claims{
+		RegisteredClaims: i.registeredClaims(strconv.Itoa(u.ID), i.config.StepUpTTL),
+		Type:             auth.TokenTypeStepUp,
+	})
+}
+
+// StepUpTTL is how long a step-up token is valid.
+func (i *Issuer) StepUpTTL() time.Duration {
+	return i.config.StepUpTTL
+}
+
+func (i *Issuer) issueForUser(ctx context.Context, u *ent.User, family string) (*Pair, error) {
+	subject := strconv.Itoa(u.ID)
+	scope := strings.Join(auth.UserScopes, " ")
+
+	access, err := i.sign(ctx, auth.Claims{
+		RegisteredClaims: i.registeredClaims(subject, i.config.AccessTTL),
+		Type:             auth.TokenTypeAccess,
+		Scope:            scope,
+		Email:            u.Email,
+		Roles:            u.Roles,
+		SessionID:        family,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refreshClaims := auth.Claims{
+		RegisteredClaims: i.registeredClaims(subject, i.config.RefreshTTL),
+		Type:             auth.TokenTypeRefresh,
+		Scope:            scope,
+	}
+	refresh, err := i.sign(ctx, refreshClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.client.RefreshToken.Create().
+		SetID(refreshClaims.ID).
+		SetUserID(u.ID).
+		SetFamily(family).
+		SetScope(scope).
+		SetExpiresAt(refreshClaims.ExpiresAt.Time).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Pair{
+		AccessToken:  access,
+		RefreshToken: refresh,
+		ExpiresIn:    i.config.AccessTTL,
+		Scope:        scope,
+	}, nil
+}
+
+func (i *Issuer) registeredClaims(subject string, ttl time.Duration) jwt.RegisteredClaims {
+	now := time.Now()
+	return jwt.RegisteredClaims{
+		Issuer:    auth.Issuer,
+		Subject:   subject,
+		Audience:  jwt.ClaimStrings{auth.Audience},
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ID:        uuid.NewString(),
+	}
+}
+
+// sign signs claims with the current key, naming it in the kid header.
+func (i *Issuer) sign(ctx context.Context, claims auth.Claims) (string, error) {
+	k, err := i.keys.signer(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(k.PrivateKey) != ed25519.PrivateKeySize {
+		return "", errors.New("malformed signing key " + k.ID)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = k.ID
+	return token.SignedString(ed25519.PrivateKey(k.PrivateKey))
+}
+
+// revokeFamily revokes the refresh tokens of a family and its session.
+func (i *Issuer) revokeFamily(ctx context.Context, family string) error {
+	now := time.Now()
+	err := i.client.RefreshToken.Update().
+		Where(refreshtoken.Family(family), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	err = i.client.Session.Update().
+		Where(session.ID(family), session.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	i.DenySessions(family)
+	return nil
+}
@@ -0,0 +1,65 @@
+package tokens
+
+import (
+	"context"
+	"shared/auth"
+	"testing"
+	"user-service/common/problems"
+	"user-service/ent/enttest"
+	"user-service/ent/session"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRefreshReuse(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	keys := NewKeyStore(client, defaultConfig)
+	denied := auth.NewDenyList()
+	// Without a NATS connection, revocations are only denied locally.
+	issuer := NewIssuer(client, keys, auth.NewVerifier(keys, denied), denied, nil, defaultConfig)
+
+	u := client.User.Create().SetID(1).SetEmail("ada@example.com").SaveX(ctx)
+	device := Device{Name: "laptop"}
+
+	first, err := issuer.IssueForUser(ctx, u, device)
+	if err != nil {
+		t.Fatalf("IssueForUser: %v", err)
+	}
+	second, err := issuer.Refresh(ctx, first.RefreshToken, device)
+	if err != nil {
+		t.Fatalf("first Refresh: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{"used token", first.RefreshToken, "refresh token reused; all tokens of the session were revoked"},
+		{"token of revoked family", second.RefreshToken, "refresh token revoked"},
+		{"used token again", first.RefreshToken, "refresh token revoked"},
+		{"malformed token", "not-a-token", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := issuer.Refresh(ctx, tt.token, device)
+			p, ok := problems.As(err)
+			if !ok || p.Code != problems.CodeUnauthorized {
+				t.Fatalf("Refresh error = %v, want an unauthorized problem", err)
+			}
+			if tt.want != "" && p.Detail != tt.want {
+				t.Errorf("Refresh detail = %q, want %q", p.Detail, tt.want)
+			}
+		})
+	}
+
+	if n := client.Session.Query().Where(session.RevokedAtIsNil()).CountX(ctx); n != 0 {
+		t.Errorf("%d sessions still active after reuse, want 0", n)
+	}
+	if _, err := issuer.verifier.Verify(ctx, second.AccessToken, auth.TokenTypeAccess); err == nil {
+		t.Error("access token of the revoked session still verifies")
+	}
+}
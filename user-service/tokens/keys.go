@@ -0,0 +1,148 @@
+package tokens
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"shared/auth"
+	"sync"
+	"time"
+	"user-service/ent"
+	"user-service/ent/signingkey"
+
+	"github.com/google/uuid"
+)
+
+// keyReloadInterval limits how often an unknown key ID reloads the keys, so
+// tokens signed by another replica's new key are accepted soon after rotation.
+const keyReloadInterval = 10 * time.Second
+
+// KeyStore holds the Ed25519 keys tokens are signed with. Keys are stored in
+// the database so every replica signs with and accepts the same keys.
+type KeyStore struct {
+	client *ent.Client
+	config Config
+
+	mu       sync.Mutex
+	keys     map[string]*ent.SigningKey
+	current  *ent.SigningKey
+	loadedAt time.Time
+}
+
+var _ auth.KeySource = (*KeyStore)(nil)
+
+func NewKeyStore(client *ent.Client, config Config) *KeyStore {
+	return &KeyStore{client: client, config: config}
+}
+
+// signer returns the key new tokens are signed with, rotating it in when the
+// newest key is older than the rotation interval.
+func (s *KeyStore) signer(ctx context.Context) (*ent.SigningKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current != nil && time.Since(s.current.CreatedAt) < s.config.KeyRotation {
+		return s.current, nil
+	}
+
+	if err := s.load(ctx); err != nil {
+		return nil, err
+	}
+	if s.current != nil && time.Since(s.current.CreatedAt) < s.config.KeyRotation {
+		return s.current, nil
+	}
+
+	if err := s.rotate(ctx); err != nil {
+		return nil, err
+	}
+	return s.current, nil
+}
+
+// PublicKey implements auth.KeySource.
+func (s *KeyStore) PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.keys[kid]
+	if !ok && time.Since(s.loadedAt) >= keyReloadInterval {
+		if err := s.load(ctx); err != nil {
+			return nil, err
+		}
+		k, ok = s.keys[kid]
+	}
+	if !ok || time.Now().After(k.ExpiresAt) {
+		return nil, auth.ErrUnknownKey
+	}
+	return ed25519.PublicKey(k.PublicKey), nil
+}
+
+// JWKS returns the keys that tokens may currently be signed with.
+func (s *KeyStore) JWKS(ctx context.Context) (auth.JWKS, error) {
+	if _, err := s.signer(ctx); err != nil {
+		return auth.JWKS{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set := auth.JWKS{Keys: make([]auth.JWK, 0, len(s.keys))}
+	for _, k := range s.keys {
+		if time.Now().Before(k.ExpiresAt) {
+			set.Keys = append(set.Keys, auth.PublicJWK(k.ID, ed25519.PublicKey(k.PublicKey)))
+		}
+	}
+	return set, nil
+}
+
+// load reads the unexpired keys. s.mu must be held.
+func (s *KeyStore) load(ctx context.Context) error {
+	keys, err := s.client.SigningKey.Query().
+		Where(signingkey.ExpiresAtGT(time.Now())).
+		Order(ent.Asc(signingkey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("loading signing keys: %w", err)
+	}
+
+	s.keys = make(map[string]*ent.SigningKey, len(keys))
+	s.current = nil
+	for _, k := range keys {
+		s.keys[k.ID] = k
+		s.current = k
+	}
+	s.loadedAt = time.Now()
+	return nil
+}
+
+// rotate creates a new signing key and deletes the expired ones. A key stays
+// published until the last refresh token it may have signed has expired.
+// s.mu must be held.
+func (s *KeyStore) rotate(ctx context.Context) error {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return fmt.Errorf("generating signing key: %w", err)
+	}
+
+	now := time.Now()
+	k, err := s.client.SigningKey.Create().
+		SetID(uuid.NewString()).
+		SetPrivateKey(private).
+		SetPublicKey(public).
+		SetCreatedAt(now).
+		SetExpiresAt(now.Add(s.config.KeyRotation + s.config.RefreshTTL)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("storing signing key: %w", err)
+	}
+	s.keys[k.ID] = k
+	s.current = k
+	log.Printf("rotated in signing key %s", k.ID)
+
+	if _, err := s.client.SigningKey.Delete().Where(signingkey.ExpiresAtLTE(now)).Exec(ctx); err != nil {
+		log.Printf("error deleting expired signing keys: %v", err)
+	}
+	return nil
+}
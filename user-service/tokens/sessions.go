@@ -0,0 +1,303 @@
+package tokens
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"shared/messages"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"user-service/ent"
+	"user-service/ent/refreshtoken"
+	"user-service/ent/session"
+
+	"github.com/nats-io/nats.go"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+const (
+	maxDeviceLength    = 100
+	maxUserAgentLength = 512
+)
+
+// Device describes the client a session is used from.
+type Device struct {
+	// Name is the name the client gave the device. When empty, one is derived
+	// from UserAgent.
+	Name      string
+	UserAgent string
+	IP        string
+}
+
+// ListSessions returns the active sessions of a user, the most recently seen
+// first.
+func (i *Issuer) ListSessions(ctx context.Context, userID int) ([]*ent.Session, error) {
+	return i.client.Session.Query().
+		Where(
+			session.UserID(userID),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(session.FieldLastSeenAt), ent.Asc(session.FieldID)).
+		All(ctx)
+}
+
+// RevokeSession revokes a session of a user: its refresh tokens can no longer
+// be used and its access tokens are denied at once.
+func (i *Issuer) RevokeSession(ctx context.Context, userID int, sessionID string) error {
+	tx, err := i.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	if err := RevokeUserSession(ctx, tx, userID, sessionID, time.Now()); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	i.DenySessions(sessionID)
+	return nil
+}
+
+// RevokeSessions revokes every session of a user except keep, which may be
+// empty, and returns how many were revoked.
+func (i *Issuer) RevokeSessions(ctx context.Context, userID int, keep string) (int, error) {
+	tx, err := i.client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("starting transaction: %w", err)
+	}
+	revoked, err := RevokeUserSessions(ctx, tx, userID, keep, time.Now())
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("committing transaction: %w", err)
+	}
+	i.DenySessions(revoked...)
+	return len(revoked), nil
+}
+
+// RevokeUserSession revokes within tx an active session of a user and its
+// refresh tokens. The caller passes it to DenySessions once tx is committed.
+func RevokeUserSession(ctx context.Context, tx *ent.Tx, userID int, sessionID string, now time.Time) error {
+	n, err := tx.Session.Update().
+		Where(session.ID(sessionID), session.UserID(userID), session.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: id %s", ErrSessionNotFound, sessionID)
+	}
+	return tx.RefreshToken.Update().
+		Where(refreshtoken.Family(sessionID), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Exec(ctx)
+}
+
+// RevokeUserSessions revokes within tx the sessions of a user except keep,
+// which may be empty, together with their refresh tokens. It returns the IDs
+// of the revoked sessions, which the caller passes to DenySessions once tx
+// is committed.
+func RevokeUserSessions(ctx context.Context, tx *ent.Tx, userID int, keep string, now time.Time) ([]string, error) {
+	ids, err := tx.Session.Query().
+		Where(session.UserID(userID), session.RevokedAtIsNil(), session.IDNEQ(keep)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 {
+		err = tx.Session.Update().
+			Where(session.IDIn(ids...)).
+			SetRevokedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Families issued before sessions were recorded have no session, and are
+	// revoked all the same.
+	err = tx.RefreshToken.Update().
+		Where(refreshtoken.UserID(userID), refreshtoken.RevokedAtIsNil(), refreshtoken.FamilyNEQ(keep)).
+		SetRevokedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// DenySessions rejects the access tokens of revoked sessions until they
+// expire, on this replica at once and on every other replica of both
+// services through NATS.
+func (i *Issuer) DenySessions(sessionIDs ...string) {
+	until := time.Now().Add(i.config.AccessTTL)
+	for _, id := range sessionIDs {
+		i.denied.Deny(id, until)
+
+		data, err := json.Marshal(messages.SessionRevoked{SessionID: id, Until: until})
+		if err != nil {
+			log.Printf("error marshalling %s message: %v", messages.SubjectSessionRevoked, err)
+			continue
+		}
+		if err := i.natsConn.Publish(messages.SubjectSessionRevoked, data); err != nil {
+			log.Printf("error publishing revocation of session %s: %v", id, err)
+		}
+	}
+}
+
+// LoadDenyList denies the sessions revoked while their access tokens may
+// still be valid, so that a restarted replica keeps rejecting them.
+func (i *Issuer) LoadDenyList(ctx context.Context) error {
+	revoked, err := i.revokedSessions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range revoked {
+		i.denied.Deny(r.SessionID, r.Until)
+	}
+	return nil
+}
+
+// ServeRevokedSessions answers revoked-sessions requests, with which
+// transactions-service loads its deny-list when it starts.
+func (i *Issuer) ServeRevokedSessions(natsConn *nats.Conn, queueGroup string) error {
+	_, err := natsConn.QueueSubscribe(messages.SubjectRevokedSessions, queueGroup, func(m *nats.Msg) {
+		reply := messages.RevokedSessions{Reply: messages.Success("")}
+		revoked, err := i.revokedSessions(context.Background())
+		if err != nil {
+			log.Printf("error querying revoked sessions: %v", err)
+			reply = messages.RevokedSessions{Reply: messages.Error(messages.ErrorCodeInternal, "error querying revoked sessions")}
+		}
+		reply.Sessions = revoked
+
+		data, err := json.Marshal(reply)
+		if err != nil {
+			log.Printf("error marshalling %s reply: %v", m.Subject, err)
+			return
+		}
+		if err := m.Respond(data); err != nil {
+			log.Printf("error responding to %s: %v", m.Subject, err)
+		}
+	})
+	return err
+}
+
+// revokedSessions returns the sessions revoked less than an access token
+// lifetime ago.
+func (i *Issuer) revokedSessions(ctx context.Context) ([]messages.SessionRevoked, error) {
+	sessions, err := i.client.Session.Query().
+		Where(session.RevokedAtGT(time.Now().Add(-i.config.AccessTTL))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revoked := make([]messages.SessionRevoked, len(sessions))
+	for n, s := range sessions {
+		revoked[n] = messages.SessionRevoked{SessionID: s.ID, Until: s.RevokedAt.Add(i.config.AccessTTL)}
+	}
+	return revoked, nil
+}
+
+// startSession records the session of a new token family.
+func (i *Issuer) startSession(ctx context.Context, userID int, family string, device Device) error {
+	now := time.Now()
+	return i.client.Session.Create().
+		SetID(family).
+		SetUserID(userID).
+		SetDevice(deviceName(device)).
+		SetUserAgent(truncate(device.UserAgent, maxUserAgentLength)).
+		SetIP(device.IP).
+		SetCreatedAt(now).
+		SetLastSeenAt(now).
+		SetExpiresAt(now.Add(i.config.RefreshTTL)).
+		Exec(ctx)
+}
+
+// touchSession records that the session of a token family refreshed its
+// tokens from device. Families issued before sessions were recorded get one.
+func (i *Issuer) touchSession(ctx context.Context, userID int, family string, device Device) error {
+	s, err := i.client.Session.Get(ctx, family)
+	if ent.IsNotFound(err) {
+		return i.startSession(ctx, userID, family, device)
+	}
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	update := s.Update().
+		SetLastSeenAt(now).
+		SetUserAgent(truncate(device.UserAgent, maxUserAgentLength)).
+		SetIP(device.IP).
+		SetExpiresAt(now.Add(i.config.RefreshTTL))
+	if device.Name != "" {
+		update = update.SetDevice(deviceName(device))
+	}
+	return update.Exec(ctx)
+}
+
+// deviceName returns the name of a device: the one the client gave, or a
+// description of its user agent such as "Firefox on Windows".
+func deviceName(device Device) string {
+	if name := strings.TrimSpace(device.Name); name != "" {
+		return truncate(name, maxDeviceLength)
+	}
+
+	ua := device.UserAgent
+	var client, platform string
+	switch {
+	case strings.Contains(ua, "Edg/"):
+		client = "Edge"
+	case strings.Contains(ua, "OPR/"):
+		client = "Opera"
+	case strings.Contains(ua, "Firefox/"):
+		client = "Firefox"
+	case strings.Contains(ua, "Chrome/"):
+		client = "Chrome"
+	case strings.Contains(ua, "Safari/"):
+		client = "Safari"
+	case strings.HasPrefix(ua, "curl/"):
+		client = "curl"
+	}
+	switch {
+	case strings.Contains(ua, "Android"):
+		platform = "Android"
+	case strings.Contains(ua, "iPhone"):
+		platform = "iOS"
+	case strings.Contains(ua, "iPad"):
+		platform = "iPadOS"
+	case strings.Contains(ua, "Windows"):
+		platform = "Windows"
+	case strings.Contains(ua, "Mac OS X"):
+		platform = "macOS"
+	case strings.Contains(ua, "Linux"):
+		platform = "Linux"
+	}
+
+	switch {
+	case client != "" && platform != "":
+		return client + " on " + platform
+	case client != "":
+		return client
+	case platform != "":
+		return platform
+	default:
+		return "Unknown device"
+	}
+}
+
+// truncate cuts s to at most n runes.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}